@@ -22,7 +22,8 @@ import (
 )
 
 // Handler is an HTTP handler for processing user deletion requests, supporting both soft and hard delete operations with
-// authentication and transaction handling.
+// authentication and transaction handling. The handler is reserved for user-service's deletion saga, which deletes the
+// user across every service; the route accepts only delegated token(s).
 var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	const name = "delete"
 
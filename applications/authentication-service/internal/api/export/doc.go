@@ -0,0 +1,3 @@
+// Package export provides an internal HTTP handler that returns the authenticated user's account and audit data as part
+// of a data-subject access request. The user-service export job is the handler's primary consumer.
+package export
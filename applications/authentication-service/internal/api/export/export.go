@@ -0,0 +1,114 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"authentication-service/internal/library/middleware"
+	"authentication-service/internal/library/middleware/authentication"
+
+	"authentication-service/internal/database"
+	"authentication-service/models/users"
+)
+
+// Event represents a single audit entry associated with the user's account.
+type Event struct {
+	Event     string    `json:"event"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Response represents the export handler's response body.
+type Response struct {
+	Account users.ExportRow `json:"account"`
+	Audit   []Event         `json:"audit"`
+}
+
+// audit derives the account's lifecycle event(s) from its database record.
+func audit(record users.ExportRow) []Event {
+	events := make([]Event, 0, 3)
+
+	for _, v := range []struct {
+		event     string
+		timestamp pgtype.Timestamptz
+	}{
+		{"account.created", record.Creation},
+		{"account.modified", record.Modification},
+		{"account.deleted", record.Deletion},
+	} {
+		if v.timestamp.Valid {
+			events = append(events, Event{Event: v.event, Timestamp: v.timestamp.Time.UTC()})
+		}
+	}
+
+	return events
+}
+
+// Handler returns the authenticated user's account record (excluding credentials) and audit trail.
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "export"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// Establish database connection.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer connection.Release()
+
+	record, e := users.New().Export(ctx, connection, email)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Active User Record Not Found", slog.String("email", email))
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Export User Database Record", slog.Any("error", e), slog.String("error-type", reflect.TypeOf(e).String()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, "Unable to Export User Database Record", http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Exported User Record", slog.Int64("id", record.ID))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Response{Account: record, Audit: audit(record)})
+
+	return
+})
@@ -0,0 +1,29 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"authentication-service/models/users"
+)
+
+func TestAudit(t *testing.T) {
+	creation := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	events := audit(users.ExportRow{Creation: pgtype.Timestamptz{Time: creation, Valid: true}})
+	if len(events) != 1 || events[0].Event != "account.created" || !(events[0].Timestamp.Equal(creation)) {
+		t.Fatalf("Unexpected Audit Event(s): %+v", events)
+	}
+
+	events = audit(users.ExportRow{
+		Creation:     pgtype.Timestamptz{Time: creation, Valid: true},
+		Modification: pgtype.Timestamptz{Time: creation.Add(time.Hour), Valid: true},
+		Deletion:     pgtype.Timestamptz{Time: creation.Add(2 * time.Hour), Valid: true},
+	})
+
+	if len(events) != 3 || events[2].Event != "account.deleted" {
+		t.Fatalf("Unexpected Audit Event(s): %+v", events)
+	}
+}
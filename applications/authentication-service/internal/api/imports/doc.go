@@ -0,0 +1,3 @@
+// Package imports provisions user(s) in bulk from an administrator-provided CSV or NDJSON document, streaming a per-row
+// result report. Provisioned user(s) receive an account invitation in lieu of a password.
+package imports
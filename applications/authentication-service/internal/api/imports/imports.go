@@ -0,0 +1,350 @@
+package imports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"authentication-service/internal/database"
+	"authentication-service/internal/library/mail"
+	"authentication-service/internal/library/middleware"
+	"authentication-service/internal/library/middleware/authentication"
+	"authentication-service/internal/library/middleware/telemetrics"
+	"authentication-service/internal/token"
+	"authentication-service/models/imports"
+	"authentication-service/models/users"
+)
+
+const (
+	limit  = 10 << 20        // limit represents the maximum size, in bytes, of an import request-body.
+	margin = 2 * time.Second // margin represents the time reserved ahead of the request's deadline to write the summary.
+	hours  = 72              // hours represents the invitation link's lifetime; see [token.Invitation].
+)
+
+// Row result status(es).
+const (
+	Valid       = "valid"       // Valid represents a dry-run row that would be provisioned.
+	Invalid     = "invalid"     // Invalid represents a row that failed decoding or validation.
+	Duplicate   = "duplicate"   // Duplicate represents a row whose email address appeared on a previous row.
+	Conflict    = "conflict"    // Conflict represents a row whose email address belongs to an existing user.
+	Skipped     = "skipped"     // Skipped represents a row already invited by a previous run of the import.
+	Invited     = "invited"     // Invited represents a provisioned row whose invitation was delivered.
+	Provisioned = "provisioned" // Provisioned represents a provisioned row whose invitation failed; re-running the import re-sends it.
+	Failed      = "failed"      // Failed represents a row that couldn't be provisioned; re-running the import retries it.
+)
+
+// Result represents a single row's streamed result record.
+type Result struct {
+	Row    int    `json:"row"`             // Row represents the row's 1-based position in the document, excluding a CSV header.
+	Email  string `json:"email,omitempty"` // Email represents the row's email address.
+	Status string `json:"status"`          // Status represents the row's outcome.
+	User   *int64 `json:"user,omitempty"`  // User represents the provisioned user's identifier.
+	Error  string `json:"error,omitempty"` // Error describes an invalid, conflicting, or failed row.
+}
+
+// Summary represents the final streamed record.
+type Summary struct {
+	Import   *int64         `json:"import,omitempty"` // Import represents the [imports.Import] identifier; absent during a dry-run.
+	Total    int            `json:"total"`            // Total represents the document's row count.
+	Rows     int            `json:"rows"`             // Rows represents the number of processed row(s).
+	Statuses map[string]int `json:"statuses"`         // Statuses counts the processed row(s) by status.
+	DryRun   bool           `json:"dry-run"`          // DryRun reports whether the import was a dry-run.
+	Complete bool           `json:"complete"`         // Complete is false when the request's deadline interrupted the import; re-run it with the same idempotency key.
+}
+
+// invite delivers an account invitation; overridden during unit-testing.
+var invite = mail.Invitation
+
+// session represents an import's shared, per-request state.
+type session struct {
+	connection *pgxpool.Conn
+	headers    map[string]string
+	record     *imports.Import // record is nil during a dry-run.
+	seen       map[string]int  // seen maps a lower-case email address to the row that first declared it.
+}
+
+// Handler is an administrative HTTP handler that provisions user(s) from a CSV or NDJSON request-body, streaming an
+// NDJSON report of one [Result] per row followed by a [Summary]. Provisioned user(s) receive an invitation instead of a
+// password.
+//
+// The "dry-run" query parameter validates the document without side effect(s). Otherwise, an Idempotency-Key header is
+// required: re-running an import with the same key skips invited row(s), re-sends failed invitation(s), and retries
+// failed row(s).
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "import"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	administrator, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	media, _, e := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if e != nil {
+		media = ""
+	}
+
+	dry, _ := strconv.ParseBool(r.URL.Query().Get("dry-run"))
+
+	key := strings.TrimSpace(r.Header.Get("Idempotency-Key"))
+	if !(dry) && (key == "" || len(key) > 255) {
+		slog.WarnContext(ctx, "Invalid Import Idempotency Key", slog.String("administrator", administrator))
+		http.Error(w, "An Idempotency-Key Header (1-255 Characters) is Required Unless dry-run=true", http.StatusBadRequest)
+		return
+	}
+
+	content, e := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Read Request Body", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	records, e := Parse(media, content)
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Parse Import Document", slog.String("content-type", media), slog.String("error", e.Error()))
+
+		switch {
+		case errors.Is(e, ErrUnsupportedMediaType):
+			w.Header().Set("Accept", strings.Join([]string{CSV, NDJSON}, ", "))
+			http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		case errors.Is(e, ErrTooManyRows):
+			http.Error(w, fmt.Sprintf("Import Exceeds Maximum of %d Rows", maximum), http.StatusRequestEntityTooLarge)
+		default:
+			http.Error(w, fmt.Sprintf("Invalid Import Document: %s", e.Error()), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	// Establish database connection.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	instance := &session{connection: connection, headers: telemetrics.New().Value(ctx).Headers, seen: make(map[string]int, len(records))}
+
+	summary := Summary{Total: len(records), Statuses: make(map[string]int), DryRun: dry, Complete: true}
+
+	if !(dry) {
+		record, e := imports.New().Establish(ctx, connection, &imports.EstablishParams{Key: key, Administrator: administrator})
+		if e != nil {
+			slog.ErrorContext(ctx, "Unable to Establish Import", slog.String("key", key), slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		instance.record = &record
+		summary.Import = &record.ID
+	}
+
+	slog.InfoContext(ctx, "Starting User Import", slog.String("administrator", administrator), slog.Int("rows", len(records)), slog.Bool("dry-run", dry), slog.String("key", key))
+
+	w.Header().Set("Content-Type", NDJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+
+	for _, record := range records {
+		// --> stop ahead of the request's deadline so the summary can be written; the import can then be re-run
+		if deadline, ok := ctx.Deadline(); (ok && time.Until(deadline) < margin) || ctx.Err() != nil {
+			summary.Complete = false
+			break
+		}
+
+		result := instance.process(ctx, record)
+
+		summary.Rows++
+		summary.Statuses[result.Status]++
+
+		encoder.Encode(result)
+		controller.Flush()
+	}
+
+	if summary.Statuses[Failed] > 0 || summary.Statuses[Provisioned] > 0 {
+		labeler.Add(attribute.Bool("error", true))
+	}
+
+	slog.InfoContext(ctx, "Completed User Import", slog.String("administrator", administrator), slog.Int("rows", summary.Rows), slog.Any("statuses", summary.Statuses), slog.Bool("complete", summary.Complete))
+
+	encoder.Encode(map[string]Summary{"summary": summary})
+
+	return
+})
+
+// process evaluates and, unless a dry-run, provisions a single row.
+func (s *session) process(ctx context.Context, record Record) Result {
+	row := record.Row
+
+	result := Result{Row: record.Number, Email: row.Email}
+
+	if record.Error != nil {
+		result.Status, result.Error = Invalid, record.Error.Error()
+		return result
+	} else if e := row.Validate(); e != nil {
+		result.Status, result.Error = Invalid, e.Error()
+		return result
+	}
+
+	if previous, ok := s.seen[strings.ToLower(row.Email)]; ok {
+		result.Status, result.Error = Duplicate, fmt.Sprintf("email address duplicates row %d", previous)
+		return result
+	}
+
+	s.seen[strings.ToLower(row.Email)] = record.Number
+
+	// --> a previous run of the import provisioned the row
+	if s.record != nil {
+		existing, e := imports.New().Row(ctx, s.connection, &imports.RowParams{Import: s.record.ID, Email: row.Email})
+		if e == nil {
+			result.User = &existing.User
+			if existing.Status == imports.Invited {
+				result.Status = Skipped
+				return result
+			}
+
+			return s.invite(ctx, existing, result)
+		} else if !(errors.Is(e, pgx.ErrNoRows)) {
+			slog.ErrorContext(ctx, "Unable to Query Import Row", slog.String("email", row.Email), slog.String("error", e.Error()))
+
+			result.Status, result.Error = Failed, "unable to query import row"
+			return result
+		}
+	}
+
+	count, e := users.New().Count(ctx, s.connection, row.Email)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Check User Count", slog.String("email", row.Email), slog.String("error", e.Error()))
+
+		result.Status, result.Error = Failed, "unable to check for an existing user"
+		return result
+	} else if count >= 1 {
+		result.Status, result.Error = Conflict, "user already exists"
+		return result
+	}
+
+	if s.record == nil {
+		result.Status = Valid
+		return result
+	}
+
+	provisioned, e := s.provision(ctx, row)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Provision Imported User", slog.String("email", row.Email), slog.String("error", e.Error()))
+
+		result.Status, result.Error = Failed, e.Error()
+		return result
+	}
+
+	result.User = &provisioned.User
+
+	return s.invite(ctx, provisioned, result)
+}
+
+// provision creates the row's user record(s) and its [imports.ImportRow] within a single transaction. The transaction
+// is rolled back should user-service fail.
+func (s *session) provision(ctx context.Context, row Row) (imports.ImportRow, error) {
+	tx, e := s.connection.Begin(ctx)
+	if e != nil {
+		return imports.ImportRow{}, fmt.Errorf("unable to establish database transaction: %w", e)
+	}
+
+	defer tx.Rollback(ctx)
+
+	user, e := users.New().Create(ctx, tx, &users.CreateParams{Email: row.Email, Password: users.Placeholder()})
+	if e != nil {
+		return imports.ImportRow{}, fmt.Errorf("unable to create user: %w", e)
+	}
+
+	if _, e := register(ctx, s.headers, row); e != nil {
+		return imports.ImportRow{}, e
+	}
+
+	record, e := imports.New().Provision(ctx, tx, &imports.ProvisionParams{Import: s.record.ID, Email: row.Email, UserID: user.ID})
+	if e != nil {
+		return imports.ImportRow{}, fmt.Errorf("unable to record import row: %w", e)
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		return imports.ImportRow{}, fmt.Errorf("unable to commit transaction: %w", e)
+	}
+
+	return record, nil
+}
+
+// invite delivers the provisioned row's invitation, recording the outcome against its [imports.ImportRow].
+func (s *session) invite(ctx context.Context, record imports.ImportRow, result Result) Result {
+	failure := func(e error) Result {
+		slog.WarnContext(ctx, "Unable to Deliver Import Invitation", slog.String("email", record.Email), slog.String("error", e.Error()))
+
+		message := e.Error()
+		if e := imports.New().Fail(ctx, s.connection, &imports.FailParams{ID: record.ID, Error: &message}); e != nil {
+			slog.ErrorContext(ctx, "Unable to Record Import Invitation Failure", slog.Int64("row", record.ID), slog.String("error", e.Error()))
+		}
+
+		result.Status, result.Error = Provisioned, fmt.Sprintf("unable to deliver invitation: %s", message)
+		return result
+	}
+
+	jwtstring, e := token.Invitation(ctx, record.Email)
+	if e != nil {
+		return failure(e)
+	}
+
+	frontend := os.Getenv("FRONTEND_URL")
+	if frontend == "" {
+		frontend = "http://localhost:3000"
+	}
+
+	if e := invite(ctx, record.Email, fmt.Sprintf("%s/invitation/%s", frontend, jwtstring), hours); e != nil {
+		return failure(e)
+	}
+
+	if e := imports.New().Invited(ctx, s.connection, record.ID); e != nil {
+		slog.ErrorContext(ctx, "Unable to Record Import Invitation", slog.Int64("row", record.ID), slog.String("error", e.Error()))
+	}
+
+	result.Status = Invited
+
+	return result
+}
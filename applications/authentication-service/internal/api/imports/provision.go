@@ -0,0 +1,130 @@
+package imports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+
+	"authentication-service/internal/library/server/telemetry"
+	"authentication-service/internal/token"
+)
+
+// register establishes the user's user-service record, returning the record's identifier. A user-service record left
+// behind by a previously failed attempt is reused. The row's optional profile attribute(s) are then applied.
+func register(ctx context.Context, headers map[string]string, row Row) (int64, error) {
+	jwtstring, e := token.Create(ctx, row.Email)
+	if e != nil {
+		return 0, fmt.Errorf("unable to create user-service token: %w", e)
+	}
+
+	headers = maps.Clone(headers)
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	headers["Authorization"] = fmt.Sprintf("Bearer %s", jwtstring)
+
+	client := telemetry.Client(headers)
+
+	var body bytes.Buffer
+	if e := json.NewEncoder(&body).Encode(map[string]string{"email": row.Email}); e != nil {
+		return 0, fmt.Errorf("unable to encode user-service registration: %w", e)
+	}
+
+	url := fmt.Sprintf("%s://%s:%d/register", "http", "user-service", 8080)
+	if override, ok := ctx.Value("user-service-registration-endpoint").(string); ok {
+		url = override // currently used for overriding the user-service endpoint during unit-testing
+	}
+
+	var user struct {
+		ID int64 `json:"id"`
+	}
+
+	status, e := call(ctx, client, http.MethodPost, url, &body, &user)
+	if e != nil {
+		return 0, e
+	}
+
+	if status == http.StatusConflict { // --> the user-service record exists; retrieve its identifier
+		url = fmt.Sprintf("%s://%s:%d/@me", "http", "user-service", 8080)
+		if override, ok := ctx.Value("user-service-me-endpoint").(string); ok {
+			url = override
+		}
+
+		if status, e = call(ctx, client, http.MethodGet, url, nil, &user); e != nil {
+			return 0, e
+		}
+	}
+
+	if status != http.StatusOK && status != http.StatusCreated {
+		return 0, fmt.Errorf("user-service registration returned an unexpected status (%d)", status)
+	}
+
+	if row.Name == nil && row.DisplayName == nil {
+		return user.ID, nil
+	}
+
+	// --> apply the optional profile attribute(s); absent attribute(s) are omitted from the merge-patch document
+	patch := make(map[string]string, 2)
+	if row.Name != nil {
+		patch["name"] = *row.Name
+	}
+
+	if row.DisplayName != nil {
+		patch["display-name"] = *row.DisplayName
+	}
+
+	body.Reset()
+	if e := json.NewEncoder(&body).Encode(patch); e != nil {
+		return 0, fmt.Errorf("unable to encode user-service profile: %w", e)
+	}
+
+	url = fmt.Sprintf("%s://%s:%d/users/%d", "http", "user-service", 8080, user.ID)
+	if override, ok := ctx.Value("user-service-profile-endpoint").(string); ok {
+		url = override
+	}
+
+	if status, e = call(ctx, client, http.MethodPatch, url, &body, nil); e != nil {
+		return 0, e
+	} else if status != http.StatusOK && status != http.StatusNoContent {
+		return 0, fmt.Errorf("user-service profile update returned an unexpected status (%d)", status)
+	}
+
+	return user.ID, nil
+}
+
+// call sends a JSON request to a user-service endpoint, decoding a successful response into the optional target.
+func call(ctx context.Context, client *telemetry.Instance, method, url string, body io.Reader, target any) (int, error) {
+	request, e := http.NewRequestWithContext(ctx, method, url, body)
+	if e != nil {
+		return 0, e
+	}
+
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, e := client.Do(request)
+	if e != nil {
+		return 0, fmt.Errorf("unable to send user-service request: %w", e)
+	}
+
+	defer response.Body.Close()
+
+	content, e := io.ReadAll(response.Body)
+	if e != nil {
+		return 0, fmt.Errorf("unable to read user-service response: %w", e)
+	}
+
+	if target != nil && response.StatusCode == http.StatusOK {
+		if e := json.Unmarshal(content, target); e != nil {
+			return 0, fmt.Errorf("invalid user-service response: %w", e)
+		}
+	}
+
+	return response.StatusCode, nil
+}
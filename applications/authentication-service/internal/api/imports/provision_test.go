@@ -0,0 +1,61 @@
+package imports
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"authentication-service/internal/library/middleware/keystore"
+)
+
+func TestRegister(t *testing.T) {
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "authentication-service")
+
+	var patched map[string]string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /register", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Account With Email Address Already Exists", http.StatusConflict)
+	})
+	mux.HandleFunc("GET /@me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"id": 42, "email": "user@x-ethr.gg"})
+	})
+	mux.HandleFunc("PATCH /users/42", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&patched)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx = context.WithValue(ctx, "user-service-registration-endpoint", server.URL+"/register")
+	ctx = context.WithValue(ctx, "user-service-me-endpoint", server.URL+"/@me")
+	ctx = context.WithValue(ctx, "user-service-profile-endpoint", server.URL+"/users/42")
+
+	display := "user"
+
+	id, e := register(ctx, nil, Row{Email: "user@x-ethr.gg", DisplayName: &display})
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	if id != 42 {
+		t.Errorf("Unexpected User-Service Identifier: %d", id)
+	}
+
+	if patched["display-name"] != "user" {
+		t.Errorf("Unexpected Profile Patch: %v", patched)
+	}
+
+	if _, ok := patched["name"]; ok {
+		t.Errorf("Absent Attribute(s) Shouldn't be Patched: %v", patched)
+	}
+}
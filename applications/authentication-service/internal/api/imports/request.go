@@ -0,0 +1,209 @@
+package imports
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	CSV    = "text/csv"             // CSV represents the comma-separated request-body media type. The first record must be a header.
+	NDJSON = "application/x-ndjson" // NDJSON represents the newline-delimited JSON request-body media type.
+)
+
+var (
+	ErrUnsupportedMediaType = errors.New("unsupported media type")           // ErrUnsupportedMediaType is returned when the request-body is neither CSV nor NDJSON.
+	ErrInvalidHeader        = errors.New("invalid csv header")               // ErrInvalidHeader is returned when a CSV document's header is missing the "email" column, or contains an unknown or duplicate column.
+	ErrTooManyRows          = errors.New("import exceeds maximum row count") // ErrTooManyRows is returned when the document exceeds [maximum] row(s).
+)
+
+// maximum represents the maximum number of row(s) in a single import.
+const maximum = 10000
+
+// columns represents the set of accepted CSV header column(s).
+var columns = []string{"email", "name", "display-name"}
+
+// Row represents a single user to provision.
+type Row struct {
+	Email       string  `json:"email" validate:"required,email,max=255"`                 // Email represents the user's required email address.
+	Name        *string `json:"name,omitempty" validate:"omitnil,min=1,max=255"`         // Name represents the user's optional full name.
+	DisplayName *string `json:"display-name,omitempty" validate:"omitnil,min=1,max=255"` // DisplayName represents the user's optional public display name.
+}
+
+// Record represents a parsed [Row] alongside its 1-based position in the document. Error is non-nil when the row
+// couldn't be decoded.
+type Record struct {
+	Number int
+	Row    Row
+	Error  error
+}
+
+// v represents the row struct validator.
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// Validate reports the first validation failure of the row, if any.
+func (r *Row) Validate() error {
+	e := v.Struct(r)
+
+	var failures validator.ValidationErrors
+	if errors.As(e, &failures) && len(failures) > 0 {
+		failure := failures[0]
+
+		field := map[string]string{"Email": "email", "Name": "name", "DisplayName": "display-name"}[failure.StructField()]
+
+		switch failure.Tag() {
+		case "required":
+			return fmt.Errorf("%s is required", field)
+		case "email":
+			return fmt.Errorf("%s must be a valid email address", field)
+		default:
+			return fmt.Errorf("%s must be between 1 and 255 characters in length", field)
+		}
+	}
+
+	return e
+}
+
+// normalize trims surrounding whitespace from the row's attribute(s), treating empty optional attribute(s) as absent.
+func (r *Row) normalize() {
+	r.Email = strings.TrimSpace(r.Email)
+
+	for _, attribute := range []**string{&r.Name, &r.DisplayName} {
+		if *attribute == nil {
+			continue
+		}
+
+		if value := strings.TrimSpace(**attribute); value != "" {
+			*attribute = &value
+		} else {
+			*attribute = nil
+		}
+	}
+}
+
+// Parse decodes the document according to its media type. Row-level decoding failure(s) are reported via
+// [Record.Error]; Parse only returns an error for a structurally invalid document.
+func Parse(media string, content []byte) ([]Record, error) {
+	switch media {
+	case CSV:
+		return comma(content)
+	case NDJSON, "application/ndjson", "application/jsonl":
+		return lines(content)
+	default:
+		return nil, ErrUnsupportedMediaType
+	}
+}
+
+func comma(content []byte) ([]Record, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, e := reader.Read()
+	if errors.Is(e, io.EOF) {
+		return nil, fmt.Errorf("%w: empty document", ErrInvalidHeader)
+	} else if e != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHeader, e)
+	}
+
+	indexes := make(map[string]int, len(header))
+	for index, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if !(slices.Contains(columns, column)) {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidHeader, column)
+		} else if _, exists := indexes[column]; exists {
+			return nil, fmt.Errorf("%w: duplicate column %q", ErrInvalidHeader, column)
+		}
+
+		indexes[column] = index
+	}
+
+	if _, ok := indexes["email"]; !(ok) {
+		return nil, fmt.Errorf("%w: missing column \"email\"", ErrInvalidHeader)
+	}
+
+	var records []Record
+	for number := 1; ; number++ {
+		fields, e := reader.Read()
+		if errors.Is(e, io.EOF) {
+			break
+		} else if len(records) == maximum {
+			return nil, ErrTooManyRows
+		}
+
+		record := Record{Number: number}
+		if e != nil {
+			var failure *csv.ParseError
+			if errors.As(e, &failure) { // --> malformed record(s) are reported, and parsing resumes with the next record
+				record.Error = e
+				records = append(records, record)
+
+				continue
+			}
+
+			return nil, e
+		}
+
+		value := func(column string) *string {
+			index, ok := indexes[column]
+			if !(ok) || index >= len(fields) {
+				return nil
+			}
+
+			return &fields[index]
+		}
+
+		if email := value("email"); email != nil {
+			record.Row.Email = *email
+		}
+
+		record.Row.Name = value("name")
+		record.Row.DisplayName = value("display-name")
+		record.Row.normalize()
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func lines(content []byte) ([]Record, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+
+	var records []Record
+	for number := 0; scanner.Scan(); {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue // --> blank line(s), including a trailing newline, aren't row(s)
+		}
+
+		number++
+
+		if len(records) == maximum {
+			return nil, ErrTooManyRows
+		}
+
+		record := Record{Number: number}
+
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.DisallowUnknownFields()
+		if e := decoder.Decode(&record.Row); e != nil {
+			record.Error = fmt.Errorf("invalid json object: %w", e)
+		}
+
+		record.Row.normalize()
+
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
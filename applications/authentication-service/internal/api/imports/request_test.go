@@ -0,0 +1,109 @@
+package imports
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("CSV", func(t *testing.T) {
+		content := "Email,name,display-name\n" +
+			"one@x-ethr.gg,One,\n" +
+			"  two@x-ethr.gg , Two Name , two\n" +
+			"invalid-email\n"
+
+		records, e := Parse(CSV, []byte(content))
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if len(records) != 3 {
+			t.Fatalf("Unexpected Record Count: %d", len(records))
+		}
+
+		first := records[0]
+		if first.Number != 1 || first.Row.Email != "one@x-ethr.gg" || first.Row.Name == nil || *first.Row.Name != "One" || first.Row.DisplayName != nil {
+			t.Errorf("Unexpected First Record: %+v", first)
+		}
+
+		second := records[1]
+		if second.Row.Email != "two@x-ethr.gg" || *second.Row.Name != "Two Name" || *second.Row.DisplayName != "two" {
+			t.Errorf("Unexpected Second Record: %+v", second)
+		}
+
+		if e := records[2].Row.Validate(); e == nil {
+			t.Errorf("Expected Validation Error for Invalid Email")
+		}
+	})
+
+	t.Run("CSV-Header", func(t *testing.T) {
+		for _, content := range []string{"", "name\nOne\n", "email,unknown\n", "email,email\n"} {
+			if _, e := Parse(CSV, []byte(content)); !(errors.Is(e, ErrInvalidHeader)) {
+				t.Errorf("Expected ErrInvalidHeader for %q, Received: %v", content, e)
+			}
+		}
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		content := `{"email": "one@x-ethr.gg", "display-name": "one"}` + "\n\n" +
+			`{"email": "two@x-ethr.gg", "unknown": true}` + "\n" +
+			`not-json` + "\n"
+
+		records, e := Parse(NDJSON, []byte(content))
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if len(records) != 3 {
+			t.Fatalf("Unexpected Record Count: %d", len(records))
+		}
+
+		if records[0].Error != nil || records[0].Row.DisplayName == nil || records[0].Row.Validate() != nil {
+			t.Errorf("Unexpected First Record: %+v", records[0])
+		}
+
+		if records[1].Error == nil || records[2].Error == nil {
+			t.Errorf("Expected Decoding Error(s) for Unknown Member and Invalid JSON")
+		}
+
+		if records[2].Number != 3 {
+			t.Errorf("Blank Line(s) Shouldn't Count as Row(s): %d", records[2].Number)
+		}
+	})
+
+	t.Run("Maximum", func(t *testing.T) {
+		content := "email\n" + strings.Repeat("user@x-ethr.gg\n", maximum+1)
+		if _, e := Parse(CSV, []byte(content)); !(errors.Is(e, ErrTooManyRows)) {
+			t.Errorf("Expected ErrTooManyRows, Received: %v", e)
+		}
+	})
+
+	t.Run("Unsupported-Media-Type", func(t *testing.T) {
+		if _, e := Parse("application/json", []byte("{}")); !(errors.Is(e, ErrUnsupportedMediaType)) {
+			t.Errorf("Expected ErrUnsupportedMediaType, Received: %v", e)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	long := strings.Repeat("x", 256)
+
+	tests := map[string]struct {
+		row   Row
+		valid bool
+	}{
+		"Valid":         {row: Row{Email: "user@x-ethr.gg"}, valid: true},
+		"Missing-Email": {row: Row{}, valid: false},
+		"Invalid-Email": {row: Row{Email: "user"}, valid: false},
+		"Long-Name":     {row: Row{Email: "user@x-ethr.gg", Name: &long}, valid: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if e := test.row.Validate(); (e == nil) != test.valid {
+				t.Errorf("Validate() = %v, Expected Valid: %v", e, test.valid)
+			}
+		})
+	}
+}
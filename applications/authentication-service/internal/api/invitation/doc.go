@@ -0,0 +1,2 @@
+// Package invitation exchanges an account invitation for the invited user's password and an authenticated session.
+package invitation
@@ -0,0 +1,111 @@
+package invitation
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"authentication-service/internal/database"
+	"authentication-service/internal/library/middleware"
+	"authentication-service/internal/library/server"
+	"authentication-service/internal/library/server/cookies"
+	"authentication-service/internal/token"
+	"authentication-service/models/users"
+)
+
+// Handler is an HTTP handler that assigns an invited user's password and establishes an authenticated session. An
+// invitation can only be accepted once: a user whose password has already been assigned receives a 409 response.
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "invitation"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	email, e := token.Invited(ctx, input.Token)
+	if e != nil || email == "" {
+		const message = "Invalid or Expired Invitation"
+
+		slog.WarnContext(ctx, message)
+		http.Error(w, message, http.StatusUnauthorized)
+		return
+	}
+
+	password, e := users.Hash(input.Password)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unknown Exception - Unable to Hash User's Password", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer connection.Release()
+
+	rows, e := users.New().Accept(ctx, connection, &users.AcceptParams{Email: email, Password: password})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Accept Invitation", slog.String("email", email), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if rows == 0 {
+		const message = "Invitation Already Accepted"
+
+		slog.WarnContext(ctx, message, slog.String("email", email))
+		http.Error(w, message, http.StatusConflict)
+		return
+	}
+
+	jwtstring, e := token.Create(ctx, email)
+	if e != nil {
+		const message = "Unable to Generate JWT Token"
+
+		slog.WarnContext(ctx, message, slog.String("email", email))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Accepted Invitation", slog.String("email", email))
+
+	cookies.Secure(w, "token", jwtstring)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(jwtstring))
+
+	return
+})
@@ -0,0 +1,36 @@
+package invitation
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"authentication-service/internal/library/server"
+)
+
+// Body represents the handler's structured request-body.
+type Body struct {
+	server.Helper `json:"-"`
+
+	Token    string `json:"token" validate:"required"`                 // Token represents the required invitation token delivered via email.
+	Password string `json:"password" validate:"required,min=8,max=72"` // Password represents the user's required password.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"token": {
+			Valid:   b.Token != "",
+			Message: "(Required) The invitation token delivered via email.",
+		},
+		"password": {
+			Valid:   len(b.Password) >= 8 && len(b.Password) <= 72,
+			Message: "(Required) The user's password. Password must be between 8 and 72 characters in length.",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator.
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body satisfies the server.Helper interface.
+var _ server.Helper = (*Body)(nil)
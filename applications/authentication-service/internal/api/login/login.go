@@ -2,10 +2,12 @@ package login
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -76,23 +78,28 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 	defer connection.Release()
 
-	count, e := users.New().Count(ctx, connection, input.Email)
-	if e != nil {
-		labeler.Add(attribute.Bool("error", true))
-		slog.ErrorContext(ctx, "Unable to Check User Count", slog.String("error", e.Error()))
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
-	} else if count == 0 {
-		slog.WarnContext(ctx, "User Not Found", slog.String("email", input.Email))
-		http.Error(w, "User Not Found", http.StatusNotFound)
-		return
+	// --> resolve the user by email address or, absent an "@", by username
+	identifier := input.Subject()
+
+	var user users.GetRow
+	if input.Username() {
+		var row users.GetByUsernameRow
+
+		row, e = users.New().GetByUsername(ctx, connection, identifier)
+		user = users.GetRow(row)
+	} else {
+		user, e = users.New().Get(ctx, connection, identifier)
 	}
 
-	user, e := users.New().Get(ctx, connection, input.Email)
-	if e != nil {
+	if errors.Is(e, pgx.ErrNoRows) {
+		slog.WarnContext(ctx, "User Not Found", slog.String("identifier", identifier))
+		http.Error(w, "User Not Found", http.StatusNotFound)
+		return
+	} else if e != nil {
 		const message = "Unable to Retrieve User Record"
 
-		slog.WarnContext(ctx, message, slog.String("email", input.Email))
+		labeler.Add(attribute.Bool("error", true))
+		slog.ErrorContext(ctx, message, slog.String("identifier", identifier), slog.String("error", e.Error()))
 		http.Error(w, message, http.StatusInternalServerError)
 		return
 	}
@@ -100,7 +107,7 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	if e := users.Verify(user.Password, input.Password); e != nil {
 		const message = "Invalid Authentication Attempt"
 
-		slog.WarnContext(ctx, message, slog.String("email", input.Email))
+		slog.WarnContext(ctx, message, slog.String("identifier", identifier))
 		http.Error(w, message, http.StatusUnauthorized)
 		return
 	}
@@ -109,7 +116,7 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	if e != nil {
 		const message = "Unable to Generate JWT Token"
 
-		slog.WarnContext(ctx, message, slog.String("email", input.Email))
+		slog.WarnContext(ctx, message, slog.String("email", user.Email))
 		http.Error(w, message, http.StatusInternalServerError)
 		return
 	}
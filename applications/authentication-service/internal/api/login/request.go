@@ -1,6 +1,8 @@
 package login
 
 import (
+	"strings"
+
 	"github.com/go-playground/validator/v10"
 
 	"authentication-service/internal/library/server"
@@ -10,16 +12,36 @@ import (
 type Body struct {
 	server.Helper `json:"-"`
 
-	Email    string `json:"email" validate:"required,email"`           // Email represents the user's required email address.
-	Password string `json:"password" validate:"required,min=8,max=72"` // Password represents the user's required password.
+	Identifier string `json:"identifier" validate:"required_without=Email,omitempty,max=255"` // Identifier represents the user's email address or username. Either Identifier or Email is required.
+	Email      string `json:"email" validate:"required_without=Identifier,omitempty,email"`   // Email represents the user's email address. Superseded by Identifier.
+	Password   string `json:"password" validate:"required,min=8,max=72"`                      // Password represents the user's required password.
+}
+
+// Subject returns the login identifier: the provided Identifier, or else the Email.
+func (b *Body) Subject() string {
+	if identifier := strings.TrimSpace(b.Identifier); identifier != "" {
+		return identifier
+	}
+
+	return b.Email
+}
+
+// Username reports whether the login identifier is a username rather than an email address.
+func (b *Body) Username() bool {
+	return !(strings.Contains(b.Subject(), "@"))
 }
 
 func (b *Body) Help() server.Validators {
 	var mapping = server.Validators{
+		"identifier": {
+			Value:   b.Identifier,
+			Valid:   b.Subject() != "",
+			Message: "(Required) The user's email address or username. Alternatively, provide the email attribute.",
+		},
 		"email": {
 			Value:   b.Email,
-			Valid:   b.Email != "",
-			Message: "(Required) A valid, unique email address.",
+			Valid:   b.Subject() != "",
+			Message: "(Optional) The user's email address. Required if an identifier isn't provided.",
 		},
 		"password": {
 			Valid:   len(b.Password) >= 8 && len(b.Password) <= 72,
@@ -0,0 +1,40 @@
+package login
+
+import "testing"
+
+func TestBody(t *testing.T) {
+	tests := map[string]struct {
+		body     Body
+		valid    bool
+		subject  string
+		username bool
+	}{
+		"Email":                 {body: Body{Email: "user@x-ethr.gg", Password: "password"}, valid: true, subject: "user@x-ethr.gg"},
+		"Identifier-Email":      {body: Body{Identifier: "user@x-ethr.gg", Password: "password"}, valid: true, subject: "user@x-ethr.gg"},
+		"Identifier-Username":   {body: Body{Identifier: " Segmentational ", Password: "password"}, valid: true, subject: "Segmentational", username: true},
+		"Identifier-Precedence": {body: Body{Identifier: "segmentational", Email: "user@x-ethr.gg", Password: "password"}, valid: true, subject: "segmentational", username: true},
+		"Missing-Identifier":    {body: Body{Password: "password"}, valid: false},
+		"Invalid-Email":         {body: Body{Email: "user", Password: "password"}, valid: false},
+		"Missing-Password":      {body: Body{Identifier: "segmentational"}, valid: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if e := v.Struct(&test.body); (e == nil) != test.valid {
+				t.Fatalf("Validation = %v, Expected Valid: %v", e, test.valid)
+			}
+
+			if !(test.valid) {
+				return
+			}
+
+			if v := test.body.Subject(); v != test.subject {
+				t.Errorf("Subject() = %q, Expected %q", v, test.subject)
+			}
+
+			if v := test.body.Username(); v != test.username {
+				t.Errorf("Username() = %v, Expected %v", v, test.username)
+			}
+		})
+	}
+}
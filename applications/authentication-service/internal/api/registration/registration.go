@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"authentication-service/internal/database"
+	"authentication-service/internal/library/emails"
 	"authentication-service/internal/library/middleware"
 	"authentication-service/internal/library/middleware/telemetrics"
 	"authentication-service/internal/library/server"
@@ -25,6 +26,9 @@ import (
 	"authentication-service/models/users"
 )
 
+// addresses evaluates the registering email address's domain; overridable during unit-testing.
+var addresses = emails.New()
+
 var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	const name = "registration"
 
@@ -70,6 +74,26 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 	slog.InfoContext(ctx, "Input", slog.Any("body", input))
 
+	// Evaluate the email address beyond its syntax: disposable domain(s), deliverability, and typo(s).
+	evaluation, e := addresses.Validate(ctx, input.Email)
+	switch {
+	case errors.Is(e, emails.ErrLookup): // --> a resolver failure mustn't prevent registration
+		slog.WarnContext(ctx, "Unable to Evaluate Email Domain - Continuing", slog.String("email", input.Email), slog.String("error", e.Error()))
+	case e != nil, evaluation.Suggestion != "" && !(input.Acknowledge):
+		validator := input.Reject(evaluation, e)
+		if e != nil {
+			slog.WarnContext(ctx, "Email Address Rejected", slog.String("email", input.Email), slog.String("error", e.Error()))
+		} else {
+			slog.WarnContext(ctx, "Email Address Correction Suggested", slog.String("email", input.Email), slog.String("suggestion", evaluation.Suggestion))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(validator)
+
+		return
+	}
+
 	// Establish database connection.
 	connection, e := database.Connection(ctx)
 	if e != nil {
@@ -1,8 +1,12 @@
 package registration
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/go-playground/validator/v10"
 
+	"authentication-service/internal/library/emails"
 	"authentication-service/internal/library/server"
 )
 
@@ -10,6 +14,8 @@ import (
 type Body struct {
 	Email    string `json:"email" validate:"required,email"`           // Email represents the user's required email address.
 	Password string `json:"password" validate:"required,min=8,max=72"` // Password represents the user's required password.
+
+	Acknowledge bool `json:"acknowledge"` // Acknowledge registers the email address as entered despite a suggested correction of its domain.
 }
 
 // Help returns a [server.Validators] mapping that intends to be json-encoded to display helpful request-body context requirements.
@@ -24,6 +30,38 @@ func (b *Body) Help() server.Validators {
 			Valid:   len(b.Password) >= 8 && len(b.Password) <= 72,
 			Message: "(Required) The user's password. Password must be between 8 and 72 characters in length.",
 		},
+		"acknowledge": {
+			Value:   b.Acknowledge,
+			Valid:   true,
+			Message: "(Optional) Register the email address as entered, despite a suggested correction of its domain.",
+		},
+	}
+
+	return mapping
+}
+
+// Reject returns the [Body.Help] mapping, its "email" entry describing why the [emails.Validator] rejected the address
+// -- or, absent an error, the unacknowledged suggested correction -- alongside a "suggestion" entry when applicable.
+func (b *Body) Reject(result emails.Result, e error) server.Validators {
+	mapping := b.Help()
+
+	var message string
+
+	switch {
+	case errors.Is(e, emails.ErrDisposable):
+		message = "Disposable (temporary) email addresses aren't accepted. A permanent email address is required."
+	case errors.Is(e, emails.ErrUndeliverable):
+		message = "The email address's domain can't receive mail."
+	case e != nil:
+		message = "(Required) A valid, unique email address."
+	default:
+		message = "The email address's domain resembles a common provider's. Correct the address, or resubmit with \"acknowledge\" to register it as entered."
+	}
+
+	mapping["email"] = server.Validator{Value: b.Email, Valid: false, Message: message}
+
+	if result.Suggestion != "" {
+		mapping["suggestion"] = server.Validator{Value: result.Suggestion, Valid: false, Message: fmt.Sprintf("Did you mean %s?", result.Suggestion)}
 	}
 
 	return mapping
@@ -0,0 +1,48 @@
+package registration
+
+import (
+	"testing"
+
+	"authentication-service/internal/library/emails"
+)
+
+func TestReject(t *testing.T) {
+	body := Body{Email: "user@gmial.com", Password: "P@ssw0rd!"}
+
+	tests := map[string]struct {
+		result     emails.Result
+		e          error
+		suggestion bool
+	}{
+		"Disposable":    {e: emails.ErrDisposable},
+		"Undeliverable": {result: emails.Result{Suggestion: "user@gmail.com"}, e: emails.ErrUndeliverable, suggestion: true},
+		"Invalid":       {e: emails.ErrInvalid},
+		"Suggestion":    {result: emails.Result{Suggestion: "user@gmail.com"}, suggestion: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mapping := body.Reject(test.result, test.e)
+
+			email, ok := mapping["email"]
+			if !(ok) || email.Valid || email.Message == "" {
+				t.Errorf("Unexpected Email Validator: %+v", email)
+			}
+
+			if _, ok := mapping["password"]; !(ok) {
+				t.Error("Expected Password Validator")
+			}
+
+			suggestion, ok := mapping["suggestion"]
+			if ok != test.suggestion {
+				t.Fatalf("Suggestion Present = %v, Expected %v", ok, test.suggestion)
+			} else if ok && suggestion.Value != "user@gmail.com" {
+				t.Errorf("Unexpected Suggestion: %v", suggestion.Value)
+			}
+		})
+	}
+
+	if a, b := body.Reject(emails.Result{}, emails.ErrDisposable)["email"].Message, body.Reject(emails.Result{}, emails.ErrInvalid)["email"].Message; a == b {
+		t.Errorf("Expected Distinct Message(s): %q", a)
+	}
+}
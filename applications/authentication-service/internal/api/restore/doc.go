@@ -0,0 +1,2 @@
+// Package restore reverts a soft-deleted user database record.
+package restore
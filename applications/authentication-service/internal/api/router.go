@@ -6,21 +6,40 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"authentication-service/internal/api/delete"
+	"authentication-service/internal/api/export"
+	"authentication-service/internal/api/imports"
+	"authentication-service/internal/api/invitation"
 	"authentication-service/internal/api/login"
 	"authentication-service/internal/api/logout"
 	"authentication-service/internal/api/refresh"
 	"authentication-service/internal/api/registration"
+	"authentication-service/internal/api/restore"
 	"authentication-service/internal/api/session"
+	"authentication-service/internal/api/username"
+	"authentication-service/internal/middleware/administrator"
 	"authentication-service/internal/middleware/authentication"
+	"authentication-service/internal/middleware/delegation"
 )
 
 func Router(parent *http.ServeMux) {
 	{ // --> authentication endpoints
 		parent.Handle("POST /refresh", authentication.Middleware(otelhttp.WithRouteTag("/refresh", refresh.Handler)))
 		parent.Handle("GET /session", authentication.Middleware(otelhttp.WithRouteTag("/session", session.Handler)))
-		parent.Handle("DELETE /users/{id}", authentication.Middleware(otelhttp.WithRouteTag("/", delete.Handler)))
+		parent.Handle("GET /export", authentication.Middleware(otelhttp.WithRouteTag("/export", export.Handler)))
 	}
 
+	{ // --> internal endpoints; user-service owns username history and the deletion saga across the service(s)
+		parent.Handle("PUT /username", delegation.Middleware(otelhttp.WithRouteTag("/username", username.Handler)))
+		parent.Handle("DELETE /users/{id}", delegation.Middleware(otelhttp.WithRouteTag("/", delete.Handler)))
+		parent.Handle("POST /users/{id}/restore", delegation.Middleware(otelhttp.WithRouteTag("/restore", restore.Handler)))
+	}
+
+	{ // --> administrative endpoints
+		parent.Handle("POST /admin/users/import", administrator.Middleware(otelhttp.WithRouteTag("/admin/users/import", imports.Handler)))
+	}
+
+	parent.Handle("POST /invitations/accept", otelhttp.WithRouteTag("/invitations/accept", invitation.Handler))
+
 	parent.Handle("POST /login", otelhttp.WithRouteTag("/login", login.Handler))
 
 	parent.Handle("GET /logout", otelhttp.WithRouteTag("/logout", logout.Handler))
@@ -2,12 +2,14 @@ package session
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"reflect"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -62,7 +64,11 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	if force == "true" {
 		// Extract the full user record.
 		record, e := users.New().GetForce(ctx, connection, email)
-		if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "User Database Record Not Found", slog.String("force", force))
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		} else if e != nil {
 			slog.ErrorContext(ctx, "Unable to Extract User Database Record", slog.String("force", force), slog.Any("error", e), slog.String("error-type", reflect.TypeOf(e).String()))
 
 			labeler.Add(attribute.Bool("error", true))
@@ -78,7 +84,11 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	} else {
 		// Extract the full user record.
 		record, e := users.New().Get(ctx, connection, email)
-		if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "User Database Record Not Found", slog.String("force", force))
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		} else if e != nil {
 			slog.ErrorContext(ctx, "Unable to Extract User Database Record", slog.String("force", force), slog.Any("error", e), slog.String("error-type", reflect.TypeOf(e).String()))
 
 			labeler.Add(attribute.Bool("error", true))
@@ -0,0 +1,2 @@
+// Package username assigns the authenticated user's login username, as synchronized from user-service.
+package username
@@ -0,0 +1,39 @@
+package username
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"authentication-service/internal/library/server"
+	"authentication-service/internal/library/usernames"
+)
+
+// Body represents the handler's structured request-body
+type Body struct {
+	server.Helper `json:"-"`
+
+	Username string `json:"username" validate:"required,username"` // Username represents the user's username.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"username": {
+			Value:   b.Username,
+			Valid:   usernames.Validate(b.Username) == nil,
+			Message: "(Required) A valid username. Usernames are claimed via user-service.",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+func init() {
+	v.RegisterValidation("username", func(fl validator.FieldLevel) bool {
+		return usernames.Validate(fl.Field().String()) == nil
+	})
+}
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
@@ -0,0 +1,99 @@
+package username
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"authentication-service/internal/database"
+	"authentication-service/internal/library/middleware"
+	"authentication-service/internal/library/middleware/authentication"
+	"authentication-service/internal/library/server"
+	"authentication-service/models/users"
+)
+
+// Handler is an internal HTTP handler that assigns the authenticated user's username, enabling login by username.
+// User-service owns username availability, reservation, and history; it calls the handler when a user claims a
+// username. The case-insensitive unique index is enforced here as well, responding 409 on conflict. The route accepts only
+// user-service's delegated token(s), such that a user can't bypass its cooldown & redirect reservation(s).
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "username"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer connection.Release()
+
+	rows, e := users.New().Rename(ctx, connection, &users.RenameParams{Email: email, Username: input.Username})
+	if e != nil {
+		var exception *pgconn.PgError
+		if errors.As(e, &exception) && exception.Code == "23505" { // --> unique_violation
+			slog.WarnContext(ctx, "Username Already Exists", slog.String("email", email), slog.String("username", input.Username))
+			http.Error(w, "Username Already Exists", http.StatusConflict)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Assign Username", slog.String("email", email), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if rows == 0 {
+		slog.WarnContext(ctx, "Active User Record Not Found", slog.String("email", email))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Assigned Username", slog.String("email", email), slog.String("username", input.Username))
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return
+})
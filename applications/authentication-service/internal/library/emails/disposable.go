@@ -0,0 +1,75 @@
+package emails
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// bundled represents the disposable domain list compiled into the binary.
+//
+//go:embed disposable.txt
+var bundled string
+
+// domains lazily loads, once, the disposable domain list (see [load]).
+var domains = sync.OnceValue(load)
+
+// load returns the bundled disposable domain list, merged with the optional EMAIL_DISPOSABLE_DOMAINS_FILE.
+func load() map[string]struct{} {
+	mapping := make(map[string]struct{})
+
+	parse(strings.NewReader(bundled), mapping)
+
+	if path := os.Getenv("EMAIL_DISPOSABLE_DOMAINS_FILE"); path != "" {
+		file, e := os.Open(path)
+		if e != nil {
+			slog.Warn("Unable to Open EMAIL_DISPOSABLE_DOMAINS_FILE - Using Bundled List", slog.String("path", path), slog.String("error", e.Error()))
+
+			return mapping
+		}
+
+		defer file.Close()
+
+		parse(file, mapping)
+	}
+
+	return mapping
+}
+
+// parse adds each domain of the reader -- one per line, ignoring blank line(s) and "#" comment(s) -- to the mapping.
+func parse(reader io.Reader, mapping map[string]struct{}) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if domain := strings.ToLower(strings.TrimSpace(line)); domain != "" {
+			mapping[domain] = struct{}{}
+		}
+	}
+}
+
+// Disposable reports whether the domain, or any of its parent domain(s), is a known disposable email provider. The
+// bundled list is extended at runtime via the EMAIL_DISPOSABLE_DOMAINS_FILE environment variable, a path to a file of
+// the same format as the bundled disposable.txt; the file is read once, upon first use.
+func Disposable(domain string) bool {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+
+	mapping := domains()
+	for domain != "" {
+		if _, ok := mapping[domain]; ok {
+			return true
+		}
+
+		_, parent, found := strings.Cut(domain, ".")
+		if !(found) || !(strings.Contains(parent, ".")) { // --> stop prior to evaluating a bare top-level domain
+			break
+		}
+
+		domain = parent
+	}
+
+	return false
+}
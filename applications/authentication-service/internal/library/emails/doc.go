@@ -0,0 +1,4 @@
+// Package emails evaluates whether an email address can plausibly receive mail beyond its syntax: the domain must not
+// be a known disposable (throwaway) provider, and must publish an MX record -- else an A/AAAA record, the implicit MX
+// of RFC 5321. A domain resembling a popular provider's is reported alongside a suggested correction (see [Suggest]).
+package emails
@@ -0,0 +1,141 @@
+package emails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/mail"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalid       = errors.New("invalid email address")           // ErrInvalid is returned when an address can't be parsed, or carries a display name.
+	ErrDisposable    = errors.New("disposable email domain")         // ErrDisposable is returned when an address's domain is a known disposable provider (see [Disposable]).
+	ErrUndeliverable = errors.New("email domain can't receive mail") // ErrUndeliverable is returned when an address's domain publishes neither a MX nor an A/AAAA record, or a null MX.
+	ErrLookup        = errors.New("email domain lookup failure")     // ErrLookup wraps a resolver's error other than a nonexistent record -- e.g. a timeout.
+)
+
+// Resolver performs the DNS lookup(s) evaluating a domain's deliverability; satisfied by [net.Resolver], and
+// overridable for unit-testing or a dedicated DNS server.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// --> ensure net.Resolver satisfies the Resolver interface.
+var _ Resolver = (*net.Resolver)(nil)
+
+// Result represents an evaluated address.
+type Result struct {
+	Address    string `json:"address"`              // Address represents the evaluated address, its domain lower-cased.
+	Domain     string `json:"domain"`               // Domain represents the address's lower-cased domain.
+	Suggestion string `json:"suggestion,omitempty"` // Suggestion represents the corrected address when its domain resembles a popular provider's (see [Suggest]).
+}
+
+// Validator evaluates address(es) via its [Resolver].
+type Validator struct {
+	Resolver Resolver      // Resolver performs the domain's DNS lookup(s).
+	Timeout  time.Duration // Timeout bounds the domain's DNS lookup(s), combined.
+}
+
+// New returns a [Validator] using [net.DefaultResolver] and the [Timeout].
+func New() *Validator {
+	return &Validator{Resolver: net.DefaultResolver, Timeout: Timeout()}
+}
+
+// Validate parses the address and evaluates its domain, returning an error wrapping [ErrInvalid], [ErrDisposable],
+// [ErrUndeliverable] or [ErrLookup]. The [Result] is returned alongside any error other than [ErrInvalid], such that
+// a caller may surface its [Result.Suggestion].
+func (v *Validator) Validate(ctx context.Context, address string) (Result, error) {
+	parsed, e := mail.ParseAddress(address)
+	if e != nil {
+		return Result{}, fmt.Errorf("%w: %w", ErrInvalid, e)
+	} else if parsed.Name != "" || parsed.Address != strings.TrimSpace(address) {
+		return Result{}, fmt.Errorf("%w: address can't contain a display name", ErrInvalid)
+	}
+
+	index := strings.LastIndex(parsed.Address, "@")
+	local, domain := parsed.Address[:index], strings.ToLower(parsed.Address[index+1:])
+	if !(strings.Contains(domain, ".")) || strings.HasPrefix(domain, "[") {
+		return Result{}, fmt.Errorf("%w: domain %q must be a fully-qualified domain name", ErrInvalid, domain)
+	}
+
+	result := Result{Address: local + "@" + domain, Domain: domain}
+	if suggestion := Suggest(domain); suggestion != "" {
+		result.Suggestion = local + "@" + suggestion
+	}
+
+	if Disposable(domain) {
+		return result, fmt.Errorf("%w: %q", ErrDisposable, domain)
+	}
+
+	if e := v.deliverable(ctx, domain); e != nil {
+		return result, e
+	}
+
+	return result, nil
+}
+
+// deliverable evaluates whether the domain can receive mail: a MX record -- other than a null MX (RFC 7505) -- else,
+// absent any MX record(s), an A/AAAA record as the implicit MX (RFC 5321, Section 5.1).
+func (v *Validator) deliverable(ctx context.Context, domain string) error {
+	if v.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, v.Timeout)
+
+		defer cancel()
+	}
+
+	records, e := v.Resolver.LookupMX(ctx, domain)
+	if e != nil && !(missing(e)) {
+		return fmt.Errorf("%w: mx: %w", ErrLookup, e)
+	}
+
+	switch {
+	case len(records) == 1 && (records[0].Host == "." || records[0].Host == ""):
+		return fmt.Errorf("%w: %q publishes a null mx record", ErrUndeliverable, domain)
+	case len(records) > 0:
+		return nil
+	}
+
+	hosts, e := v.Resolver.LookupHost(ctx, domain)
+	if e != nil && !(missing(e)) {
+		return fmt.Errorf("%w: host: %w", ErrLookup, e)
+	} else if len(hosts) == 0 {
+		return fmt.Errorf("%w: %q publishes neither mx nor address record(s)", ErrUndeliverable, domain)
+	}
+
+	return nil
+}
+
+// missing reports whether the resolver's error represents a nonexistent domain or record, as opposed to a failure.
+func missing(e error) bool {
+	var exception *net.DNSError
+
+	return errors.As(e, &exception) && exception.IsNotFound
+}
+
+// Timeout returns the duration bounding a domain's DNS lookup(s). Configured via the EMAIL_LOOKUP_TIMEOUT environment
+// variable (a [time.ParseDuration] string); defaults to 3 seconds.
+func Timeout() time.Duration {
+	const fallback = 3 * time.Second
+
+	value := os.Getenv("EMAIL_LOOKUP_TIMEOUT")
+	if value == "" {
+		return fallback
+	}
+
+	duration, e := time.ParseDuration(value)
+	if e != nil || duration <= 0 {
+		slog.Warn("Invalid EMAIL_LOOKUP_TIMEOUT Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", fallback))
+
+		return fallback
+	}
+
+	return duration
+}
@@ -0,0 +1,168 @@
+package emails
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// resolver is a static [Resolver]; a domain absent from a mapping is reported as not found.
+type resolver struct {
+	mx    map[string][]*net.MX
+	hosts map[string][]string
+	e     error
+}
+
+func (r resolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if r.e != nil {
+		return nil, r.e
+	}
+
+	if records, ok := r.mx[name]; ok {
+		return records, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r resolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if hosts, ok := r.hosts[host]; ok {
+		return hosts, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestValidate(t *testing.T) {
+	v := &Validator{
+		Resolver: resolver{
+			mx: map[string][]*net.MX{
+				"gmail.com":   {{Host: "gmail-smtp-in.l.google.com.", Pref: 5}},
+				"gmial.com":   {{Host: "mx.gmial.com.", Pref: 10}},
+				"example.com": {{Host: ".", Pref: 0}},
+			},
+			hosts: map[string][]string{
+				"implicit.dev": {"192.0.2.1"},
+			},
+		},
+		Timeout: time.Second,
+	}
+
+	tests := map[string]error{
+		"segmentational@gmail.com":  nil,
+		"segmentational@GMAIL.com":  nil,
+		"segmentational@gmial.com":  nil,
+		"user@implicit.dev":         nil,
+		"user@example.com":          ErrUndeliverable,
+		"user@nonexistent.invalid":  ErrUndeliverable,
+		"user@mailinator.com":       ErrDisposable,
+		"user@inbox.mailinator.com": ErrDisposable,
+		"not-an-address":            ErrInvalid,
+		"Jake <jake@gmail.com>":     ErrInvalid,
+		"user@localhost":            ErrInvalid,
+	}
+
+	for address, expectation := range tests {
+		t.Run(address, func(t *testing.T) {
+			_, e := v.Validate(context.Background(), address)
+			if expectation == nil && e != nil {
+				t.Errorf("Validate(%q) = %v, Expected nil", address, e)
+			} else if expectation != nil && !(errors.Is(e, expectation)) {
+				t.Errorf("Validate(%q) = %v, Expected %v", address, e, expectation)
+			}
+		})
+	}
+
+	t.Run("Suggestion", func(t *testing.T) {
+		result, e := v.Validate(context.Background(), "Jake.Sanders@Gmial.com")
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if result.Address != "Jake.Sanders@gmial.com" || result.Suggestion != "Jake.Sanders@gmail.com" {
+			t.Errorf("Unexpected Result: %+v", result)
+		}
+	})
+
+	t.Run("Lookup-Failure", func(t *testing.T) {
+		v := &Validator{Resolver: resolver{e: &net.DNSError{Err: "i/o timeout", IsTimeout: true}}}
+
+		if _, e := v.Validate(context.Background(), "user@gmail.com"); !(errors.Is(e, ErrLookup)) {
+			t.Errorf("Validate() = %v, Expected %v", e, ErrLookup)
+		}
+	})
+}
+
+func TestSuggest(t *testing.T) {
+	tests := map[string]string{
+		"gmial.com":    "gmail.com",
+		"gmail.con":    "gmail.com",
+		"gmal.com":     "gmail.com",
+		"hotmial.com":  "hotmail.com",
+		"yaho.com":     "yahoo.com",
+		"outlok.com":   "outlook.com",
+		"iclod.com":    "icloud.com",
+		"gmail.com":    "",
+		"mail.com":     "",
+		"example.com":  "",
+		"polygun.dev":  "",
+		"company.test": "",
+	}
+
+	for domain, expectation := range tests {
+		if v := Suggest(domain); v != expectation {
+			t.Errorf("Suggest(%q) = %q, Expected %q", domain, v, expectation)
+		}
+	}
+}
+
+func TestDisposable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disposable.txt")
+	if e := os.WriteFile(path, []byte("# additional\nthrowaway.example # trailing comment\n\n"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	t.Setenv("EMAIL_DISPOSABLE_DOMAINS_FILE", path)
+
+	domains = sync.OnceValue(load) // --> the list may have been loaded prior to the environment variable's assignment
+	t.Cleanup(func() { domains = sync.OnceValue(load) })
+
+	tests := map[string]bool{
+		"mailinator.com":          true,
+		"MAILINATOR.com.":         true,
+		"eu.yopmail.com":          true,
+		"throwaway.example":       true,
+		"gmail.com":               false,
+		"com":                     false,
+		"notmailinator.com":       false,
+		"mailinator.com.evil.net": false,
+	}
+
+	for domain, expectation := range tests {
+		if v := Disposable(domain); v != expectation {
+			t.Errorf("Disposable(%q) = %t, Expected %t", domain, v, expectation)
+		}
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	t.Setenv("EMAIL_LOOKUP_TIMEOUT", "")
+	if v := Timeout(); v != 3*time.Second {
+		t.Errorf("Unexpected Default Timeout: %s", v)
+	}
+
+	t.Setenv("EMAIL_LOOKUP_TIMEOUT", "500ms")
+	if v := Timeout(); v != 500*time.Millisecond {
+		t.Errorf("Unexpected Timeout: %s", v)
+	}
+
+	t.Setenv("EMAIL_LOOKUP_TIMEOUT", "invalid")
+	if v := Timeout(); v != 3*time.Second {
+		t.Errorf("Unexpected Fallback Timeout: %s", v)
+	}
+}
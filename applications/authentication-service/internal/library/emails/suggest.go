@@ -0,0 +1,79 @@
+package emails
+
+import (
+	"slices"
+	"strings"
+)
+
+// providers represents popular email provider domain(s) that a mistyped domain is compared against. Legitimate
+// domain(s) merely resembling a popular provider (e.g. "mail.com" and "gmail.com") are listed such that an exact match
+// never yields a suggestion.
+var providers = []string{
+	"aol.com", "comcast.net", "gmail.com", "gmx.com", "gmx.de", "gmx.net", "googlemail.com", "hey.com", "hotmail.co.uk",
+	"hotmail.com", "hotmail.fr", "icloud.com", "live.com", "mac.com", "mail.com", "mail.ru", "me.com", "msn.com",
+	"outlook.com", "proton.me", "protonmail.com", "qq.com", "yahoo.co.uk", "yahoo.com", "yahoo.fr", "yandex.com",
+	"ymail.com", "zoho.com",
+}
+
+// Suggest returns the popular provider domain the domain most likely is a typo of -- e.g. "gmail.com" for
+// "gmial.com" -- else an empty string, including when the domain already is a listed provider.
+func Suggest(domain string) string {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if domain == "" || slices.Contains(providers, domain) {
+		return ""
+	}
+
+	var suggestion string
+
+	closest := threshold(domain) + 1
+	for _, provider := range providers {
+		if d := distance(domain, provider); d < closest {
+			closest, suggestion = d, provider
+		}
+	}
+
+	return suggestion
+}
+
+// threshold returns the maximum edit distance considered a typo; short domain(s) tolerate a single edit such that
+// unrelated, legitimate short domain(s) aren't corrected.
+func threshold(domain string) int {
+	if len(domain) >= 10 {
+		return 2
+	}
+
+	return 1
+}
+
+// distance returns the optimal string alignment distance between a and b: the Levenshtein distance, additionally
+// counting the transposition of two adjacent characters (the most common typo, e.g. "gmial") as a single edit.
+func distance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+
+	matrix := make([][]int, len(x)+1)
+	for i := range matrix {
+		matrix[i] = make([]int, len(y)+1)
+		matrix[i][0] = i
+	}
+
+	for j := range matrix[0] {
+		matrix[0][j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+
+			matrix[i][j] = min(matrix[i-1][j]+1, matrix[i][j-1]+1, matrix[i-1][j-1]+cost)
+
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				matrix[i][j] = min(matrix[i][j], matrix[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return matrix[len(x)][len(y)]
+}
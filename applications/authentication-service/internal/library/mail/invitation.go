@@ -0,0 +1,60 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"authentication-service/internal/library/mail/internal/configuration"
+)
+
+// Invitation sends an account invitation to the recipient. The url represents the invitation acceptance link, which
+// expires after the specified number of hours.
+func Invitation(ctx context.Context, recipient string, url string, hours int) error {
+	const (
+		sender  = "no-reply@polygun.com"
+		subject = "Polygun - Account Invitation"
+		set     = "polygun-email-verification-configuration-set"
+	)
+
+	var html, text bytes.Buffer
+
+	settings := configuration.Region(ctx, "us-east-2")
+
+	timestamp := strconv.FormatInt(time.Now().UTC().Unix(), 10)
+
+	slog.DebugContext(ctx, "Invitation Email Metadata", slog.Group("input",
+		slog.String("sender", sender),
+		slog.String("subject", subject),
+		slog.String("timestamp", timestamp),
+		slog.String("recipient", recipient),
+		slog.String("region", settings.Region),
+	))
+
+	metadata := Metadata{hours, "hours", url}
+
+	if e := InvitationHTML.Execute(&html, metadata); e != nil {
+		slog.ErrorContext(ctx, "Unable to Generate HTML Invitation Template", slog.String("error", e.Error()))
+
+		return e
+	}
+
+	if e := InvitationText.Execute(&text, metadata); e != nil {
+		slog.ErrorContext(ctx, "Unable to Generate Text Invitation Template", slog.String("error", e.Error()))
+
+		return e
+	}
+
+	return deliver(ctx, settings, message{
+		sender:    sender,
+		recipient: recipient,
+		subject:   subject,
+		set:       set,
+		tag:       "User-Invitation",
+		timestamp: timestamp,
+		html:      html.String(),
+		text:      text.String(),
+	})
+}
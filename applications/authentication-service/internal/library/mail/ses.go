@@ -0,0 +1,89 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+	"github.com/aws/smithy-go"
+)
+
+// message represents a rendered email pending delivery.
+type message struct {
+	sender    string
+	recipient string
+	subject   string
+	set       string // set represents the SES configuration set.
+	tag       string // tag represents the SES "Type" message tag.
+	timestamp string
+
+	html string
+	text string
+}
+
+// deliver submits the rendered message via SES.
+func deliver(ctx context.Context, settings aws.Config, m message) error {
+	// Create the send email input
+	input := &ses.SendEmailInput{
+		Source: aws.String(m.sender),
+		Destination: &types.Destination{
+			ToAddresses: []string{m.recipient},
+		},
+		ReplyToAddresses:     []string{},
+		ReturnPath:           nil,
+		ConfigurationSetName: aws.String(m.set),
+		Message: &types.Message{
+			Body: &types.Body{
+				Html: &types.Content{
+					Charset: aws.String("UTF-8"),
+					Data:    aws.String(m.html),
+				},
+				Text: &types.Content{
+					Charset: aws.String("UTF-8"),
+					Data:    aws.String(m.text),
+				},
+			},
+			Subject: &types.Content{
+				Charset: aws.String("UTF-8"),
+				Data:    aws.String(m.subject),
+			},
+		},
+		Tags: []types.MessageTag{
+			{
+				Name:  aws.String("Type"),
+				Value: aws.String(m.tag),
+			},
+			{
+				Name:  aws.String("Timestamp"),
+				Value: aws.String(m.timestamp),
+			},
+		},
+	}
+
+	client := ses.NewFromConfig(settings)
+
+	result, e := client.SendEmail(ctx, input)
+	if e != nil {
+		var ae smithy.APIError
+		var oe *smithy.OperationError
+
+		switch {
+		case errors.As(e, &ae):
+			slog.ErrorContext(ctx, "Failed Submitting Email (AE)", slog.String("type", m.tag), slog.String("code", ae.ErrorCode()), slog.Any("fault", ae.ErrorFault()), slog.String("message", ae.ErrorMessage()), slog.String("error", ae.Error()))
+			return e
+		case errors.As(e, &oe):
+			slog.ErrorContext(ctx, "Failed Submitting Email (OE)", slog.String("type", m.tag), slog.String("operation", oe.Operation()), slog.String("service", oe.Service()), slog.String("error", oe.Error()), slog.Any("unwrap", oe.Unwrap()))
+			return e
+		default:
+			slog.ErrorContext(ctx, "Failed Submitting Email (Unknown)", slog.String("type", m.tag), slog.String("error", e.Error()))
+			return e
+		}
+	}
+
+	slog.InfoContext(ctx, "Email Successfully Submitted", slog.String("type", m.tag), slog.String("message-id", aws.ToString(result.MessageId)))
+
+	return nil
+}
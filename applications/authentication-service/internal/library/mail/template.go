@@ -67,32 +67,48 @@ var (
 		functions: text.FuncMap{},
 		template:  &html.Template{},
 	}
-)
 
-func init() {
-	{
-		buffer, e := directive.ReadFile(Text.name)
-		if e != nil {
-			panic(e)
-		}
+	// InvitationText represents the plain-text account invitation template.
+	InvitationText = Template[*text.Template]{
+		t:         "text",
+		name:      "invitation.text.go.template",
+		buffer:    bytes.Buffer{},
+		functions: text.FuncMap{},
+		template:  &text.Template{},
+	}
 
-		if _, e := Text.buffer.Write(buffer); e != nil {
-			panic(e)
-		}
+	// InvitationHTML represents the HTML account invitation template.
+	InvitationHTML = Template[*html.Template]{
+		t:         "html",
+		name:      "invitation.html.go.template",
+		buffer:    bytes.Buffer{},
+		functions: text.FuncMap{},
+		template:  &html.Template{},
+	}
+)
 
-		Text.template = text.Must(text.New(Text.name).Option("missingkey=error").Parse(Text.buffer.String()))
+// read buffers the embedded template's source.
+func read(name string, buffer *bytes.Buffer) {
+	content, e := directive.ReadFile(name)
+	if e != nil {
+		panic(e)
 	}
 
-	{
-		buffer, e := directive.ReadFile(HTML.name)
-		if e != nil {
-			panic(e)
-		}
+	if _, e := buffer.Write(content); e != nil {
+		panic(e)
+	}
+}
+
+func init() {
+	for _, t := range []*Template[*text.Template]{&Text, &InvitationText} {
+		read(t.name, &t.buffer)
+
+		t.template = text.Must(text.New(t.name).Option("missingkey=error").Parse(t.buffer.String()))
+	}
 
-		if _, e := HTML.buffer.Write(buffer); e != nil {
-			panic(e)
-		}
+	for _, t := range []*Template[*html.Template]{&HTML, &InvitationHTML} {
+		read(t.name, &t.buffer)
 
-		HTML.template = html.Must(html.New(HTML.name).Option("missingkey=error").Parse(HTML.buffer.String()))
+		t.template = html.Must(html.New(t.name).Option("missingkey=error").Parse(t.buffer.String()))
 	}
 }
@@ -61,3 +61,29 @@ func TestTemplate(t *testing.T) {
 		})
 	})
 }
+
+func TestInvitationTemplate(t *testing.T) {
+	metadata := Metadata{Expiration: 72, Duration: "hours", URL: "https://testing.ethr.gg/invitation/token"}
+
+	t.Run("Text", func(t *testing.T) {
+		var buffer bytes.Buffer
+		if e := InvitationText.Execute(&buffer, metadata); e != nil {
+			t.Fatalf("Unable to Render Text Invitation Template: %v", e)
+		}
+
+		if !(bytes.Contains(buffer.Bytes(), []byte(metadata.URL))) {
+			t.Errorf("Rendered Text Invitation Template Doesn't Contain URL")
+		}
+	})
+
+	t.Run("HTML", func(t *testing.T) {
+		var buffer bytes.Buffer
+		if e := InvitationHTML.Execute(&buffer, metadata); e != nil {
+			t.Fatalf("Unable to Render HTML Invitation Template: %v", e)
+		}
+
+		if !(bytes.Contains(buffer.Bytes(), []byte(metadata.URL))) {
+			t.Errorf("Rendered HTML Invitation Template Doesn't Contain URL")
+		}
+	})
+}
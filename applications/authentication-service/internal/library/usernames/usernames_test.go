@@ -0,0 +1,61 @@
+package usernames
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]error{
+		"segmentational":                    nil,
+		"Jake.Sanders":                      nil,
+		"user_01":                           nil,
+		"a-b":                               nil,
+		"ab":                                ErrInvalid,
+		"abcdefghijklmnopqrstuvwxyz1234567": ErrInvalid,
+		"_leading":                          ErrInvalid,
+		"trailing-":                         ErrInvalid,
+		"double..separator":                 ErrInvalid,
+		"white space":                       ErrInvalid,
+		" padded":                           ErrInvalid,
+		"ünicode":                           ErrInvalid,
+		"Admin":                             ErrReserved,
+		"ROOT":                              ErrReserved,
+		"user-service":                      ErrReserved,
+	}
+
+	for username, expectation := range tests {
+		t.Run(username, func(t *testing.T) {
+			e := Validate(username)
+			if expectation == nil && e != nil {
+				t.Errorf("Validate(%q) = %v, Expected nil", username, e)
+			} else if expectation != nil && !(errors.Is(e, expectation)) {
+				t.Errorf("Validate(%q) = %v, Expected %v", username, e, expectation)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if v := Normalize("  Jake.Sanders "); v != "jake.sanders" {
+		t.Errorf("Unexpected Normalized Username: %q", v)
+	}
+}
+
+func TestCooldown(t *testing.T) {
+	t.Setenv("USERNAME_COOLDOWN", "")
+	if v := Cooldown(); v != 30*24*time.Hour {
+		t.Errorf("Unexpected Default Cooldown: %s", v)
+	}
+
+	t.Setenv("USERNAME_COOLDOWN", "72h")
+	if v := Cooldown(); v != 72*time.Hour {
+		t.Errorf("Unexpected Configured Cooldown: %s", v)
+	}
+
+	t.Setenv("USERNAME_COOLDOWN", "invalid")
+	if v := Cooldown(); v != 30*24*time.Hour {
+		t.Errorf("Unexpected Fallback Cooldown: %s", v)
+	}
+}
@@ -0,0 +1,59 @@
+// Package administrator restricts an authenticated endpoint to the service's administrator(s).
+package administrator
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"authentication-service/internal/library/middleware/authentication"
+
+	internal "authentication-service/internal/middleware/authentication"
+)
+
+// Administrators returns the administrator email address(es) configured via the comma-separated ADMINISTRATORS
+// environment variable. Address(es) are compared case-insensitively.
+func Administrators() []string {
+	var addresses []string
+	for _, address := range strings.Split(os.Getenv("ADMINISTRATORS"), ",") {
+		if address = strings.ToLower(strings.TrimSpace(address)); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+// Administrator reports whether the email address belongs to a configured administrator.
+func Administrator(email string) bool {
+	return email != "" && slices.Contains(Administrators(), strings.ToLower(email))
+}
+
+// Middleware authenticates the request (see [internal.Middleware]) and then ensures the JWT subject is a configured
+// administrator, responding with 403 otherwise.
+func Middleware(next http.Handler) http.Handler {
+	return internal.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+		email, e := claims.GetSubject()
+		if e != nil {
+			slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		if !(Administrator(email)) {
+			slog.WarnContext(ctx, "Non-Administrator Attempted Administrative Request", slog.String("email", email), slog.String("path", r.URL.Path))
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}))
+}
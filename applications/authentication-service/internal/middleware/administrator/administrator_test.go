@@ -0,0 +1,25 @@
+package administrator
+
+import "testing"
+
+func TestAdministrator(t *testing.T) {
+	t.Setenv("ADMINISTRATORS", " admin@x-ethr.gg, Operator@x-ethr.gg ,,")
+
+	tests := map[string]bool{
+		"admin@x-ethr.gg":    true,
+		"ADMIN@x-ethr.gg":    true,
+		"operator@x-ethr.gg": true,
+		"user@x-ethr.gg":     false,
+		"":                   false,
+	}
+
+	for email, expectation := range tests {
+		if v := Administrator(email); v != expectation {
+			t.Errorf("Administrator(%q) = %v, expected %v", email, v, expectation)
+		}
+	}
+
+	if v := len(Administrators()); v != 2 {
+		t.Errorf("Unexpected Administrator Count: %d", v)
+	}
+}
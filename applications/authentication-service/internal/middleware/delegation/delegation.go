@@ -0,0 +1,29 @@
+// Package delegation restricts an authenticated endpoint to token(s) user-service delegates for its background work,
+// such as the deletion saga's step(s) & compensation(s).
+package delegation
+
+import (
+	"log/slog"
+	"net/http"
+
+	"authentication-service/internal/library/middleware/authentication"
+	"authentication-service/internal/token"
+
+	internal "authentication-service/internal/middleware/authentication"
+)
+
+// Middleware authenticates the request (see [internal.Middleware]) and then ensures the JWT was delegated by
+// user-service (see [token.Delegated]), responding with 403 otherwise -- e.g. for a user's session token.
+func Middleware(next http.Handler) http.Handler {
+	return internal.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if !(token.Delegated(authentication.New().Value(ctx).Token)) {
+			slog.WarnContext(ctx, "Non-Delegated Token Attempted Internal Request", slog.String("path", r.URL.Path))
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}))
+}
@@ -126,3 +126,74 @@ func Verify(ctx context.Context, t string) (*jwt.Token, error) {
 
 	return nil, e
 }
+
+// delegated represents the audience of a token user-service mints on behalf of a user for its background work (e.g. the
+// deletion saga), alongside the service audience(s); see [Delegated].
+const delegated = "user-service/delegated"
+
+// Delegated reports whether the verified token was delegated by user-service -- i.e. carries user-service's issuer and
+// the delegated audience -- as opposed to a session token generated by [Create].
+func Delegated(token *jwt.Token) bool {
+	issuer, e := token.Claims.GetIssuer()
+	if e != nil || issuer != "user-service" {
+		return false
+	}
+
+	audiences, e := token.Claims.GetAudience()
+	if e != nil {
+		return false
+	}
+
+	return slices.Contains(audiences, delegated)
+}
+
+// invitation represents the audience of an invitation token. Because the audience excludes every service name,
+// [Verify] rejects invitation tokens as session credentials.
+const invitation = "authentication-service/invitation"
+
+// Invitation generates a signed, 72-hour invitation JWT for the specified email. The token is exchanged for a password
+// via the invitation acceptance endpoint; see [Invited].
+func Invitation(ctx context.Context, email string) (string, error) {
+	now := time.Now()
+	expiration := now.Add(time.Hour * 72)
+
+	issuer := middleware.New().Service().Value(ctx)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   email,
+			Audience:  jwt.ClaimStrings{invitation},
+			ExpiresAt: jwt.NewNumericDate(expiration),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
+		},
+	})
+
+	jwt, e := token.SignedString(signer)
+	if e != nil {
+		slog.WarnContext(ctx, "Error Signing Invitation JWT Token", slog.String("email", email), slog.String("error", e.Error()))
+
+		return "", e
+	}
+
+	return jwt, nil
+}
+
+// Invited verifies an invitation JWT generated by [Invitation] and returns the invited email address.
+func Invited(ctx context.Context, t string) (string, error) {
+	token, e := jwt.Parse(t, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrTokenSignatureInvalid
+		}
+
+		return signer, nil
+	}, jwt.WithAudience(invitation), jwt.WithExpirationRequired())
+
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Invitation JWT Token", slog.String("error", e.Error()))
+		return "", e
+	}
+
+	return token.Claims.GetSubject()
+}
@@ -0,0 +1,29 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestDelegated(t *testing.T) {
+	tests := map[string]struct {
+		claims   jwt.MapClaims
+		expected bool
+	}{
+		"Delegated":           {jwt.MapClaims{"iss": "user-service", "aud": []interface{}{"authentication-service", delegated}}, true},
+		"Session":             {jwt.MapClaims{"iss": "authentication-service", "aud": []interface{}{"authentication-service", "user-service"}}, false},
+		"Forged Audience":     {jwt.MapClaims{"iss": "authentication-service", "aud": []interface{}{"authentication-service", delegated}}, false},
+		"Missing Audience":    {jwt.MapClaims{"iss": "user-service", "aud": []interface{}{"authentication-service"}}, false},
+		"Missing Claims":      {jwt.MapClaims{}, false},
+		"Invitation Audience": {jwt.MapClaims{"iss": "authentication-service", "aud": invitation}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if v := Delegated(jwt.NewWithClaims(jwt.SigningMethodHS512, test.claims)); v != test.expected {
+				t.Errorf("Delegated() = %v, expected %v", v, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package imports
+
+//go:generate sqlc generate
@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package imports
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Import represents an administrative bulk user import, identified by the administrator's idempotency key so a partially failed import can be re-run.
+type Import struct {
+	ID int64 `db:"id" json:"id"`
+	// Key represents the client-provided Idempotency-Key request header.
+	Key           string             `db:"key" json:"key"`
+	Administrator string             `db:"administrator" json:"administrator"`
+	Creation      pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification  pgtype.Timestamptz `db:"modification" json:"modification"`
+}
+
+// Import-Row represents a user provisioned by an "Import". A PROVISIONED row's invitation has yet to be delivered; re-running the import only re-sends its invitation.
+type ImportRow struct {
+	ID     int64  `db:"id" json:"id"`
+	Import int64  `db:"import" json:"import"`
+	Email  string `db:"email" json:"email"`
+	// User represents the provisioned "User" record's identifier.
+	User   int64  `db:"user" json:"user"`
+	Status string `db:"status" json:"status"`
+	// Error represents the most recent invitation delivery failure.
+	Error        *string            `db:"error" json:"error"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+}
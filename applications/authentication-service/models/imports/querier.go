@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package imports
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Establish retrieves the administrator's [Import] for the idempotency key, creating it if it doesn't exist.
+	Establish(ctx context.Context, db DBTX, arg *EstablishParams) (Import, error)
+	// Fail records an [ImportRow]'s invitation delivery failure.
+	Fail(ctx context.Context, db DBTX, arg *FailParams) error
+	// Invited records the successful delivery of an [ImportRow]'s invitation.
+	Invited(ctx context.Context, db DBTX, id int64) error
+	// Provision records a provisioned user whose invitation has yet to be delivered.
+	Provision(ctx context.Context, db DBTX, arg *ProvisionParams) (ImportRow, error)
+	// Row retrieves the [ImportRow] of the import's email address.
+	Row(ctx context.Context, db DBTX, arg *RowParams) (ImportRow, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,21 @@
+-- name: Establish :one
+-- Establish retrieves the administrator's [Import] for the idempotency key, creating it if it doesn't exist.
+INSERT INTO "Import" (key, administrator) VALUES (sqlc.arg(key), sqlc.arg(administrator))
+ON CONFLICT (administrator, key) DO UPDATE SET modification = now()
+RETURNING *;
+
+-- name: Row :one
+-- Row retrieves the [ImportRow] of the import's email address.
+SELECT * FROM "Import-Row" WHERE (import) = sqlc.arg(import) AND (email) = sqlc.arg(email);
+
+-- name: Provision :one
+-- Provision records a provisioned user whose invitation has yet to be delivered.
+INSERT INTO "Import-Row" (import, email, "user", status) VALUES (sqlc.arg(import), sqlc.arg(email), sqlc.arg(user_id), 'PROVISIONED') RETURNING *;
+
+-- name: Invited :exec
+-- Invited records the successful delivery of an [ImportRow]'s invitation.
+UPDATE "Import-Row" SET status = 'INVITED', error = NULL, modification = now() WHERE (id) = sqlc.arg(id);
+
+-- name: Fail :exec
+-- Fail records an [ImportRow]'s invitation delivery failure.
+UPDATE "Import-Row" SET error = sqlc.arg(error), modification = now() WHERE (id) = sqlc.arg(id);
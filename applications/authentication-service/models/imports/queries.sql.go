@@ -0,0 +1,113 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package imports
+
+import (
+	"context"
+)
+
+const establish = `-- name: Establish :one
+INSERT INTO "Import" (key, administrator) VALUES ($1, $2)
+ON CONFLICT (administrator, key) DO UPDATE SET modification = now()
+RETURNING id, key, administrator, creation, modification
+`
+
+type EstablishParams struct {
+	Key           string `db:"key" json:"key"`
+	Administrator string `db:"administrator" json:"administrator"`
+}
+
+// Establish retrieves the administrator's [Import] for the idempotency key, creating it if it doesn't exist.
+func (q *Queries) Establish(ctx context.Context, db DBTX, arg *EstablishParams) (Import, error) {
+	row := db.QueryRow(ctx, establish, arg.Key, arg.Administrator)
+	var i Import
+	err := row.Scan(
+		&i.ID,
+		&i.Key,
+		&i.Administrator,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const fail = `-- name: Fail :exec
+UPDATE "Import-Row" SET error = $1, modification = now() WHERE (id) = $2
+`
+
+type FailParams struct {
+	Error *string `db:"error" json:"error"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Fail records an [ImportRow]'s invitation delivery failure.
+func (q *Queries) Fail(ctx context.Context, db DBTX, arg *FailParams) error {
+	_, err := db.Exec(ctx, fail, arg.Error, arg.ID)
+	return err
+}
+
+const invited = `-- name: Invited :exec
+UPDATE "Import-Row" SET status = 'INVITED', error = NULL, modification = now() WHERE (id) = $1
+`
+
+// Invited records the successful delivery of an [ImportRow]'s invitation.
+func (q *Queries) Invited(ctx context.Context, db DBTX, id int64) error {
+	_, err := db.Exec(ctx, invited, id)
+	return err
+}
+
+const provision = `-- name: Provision :one
+INSERT INTO "Import-Row" (import, email, "user", status) VALUES ($1, $2, $3, 'PROVISIONED') RETURNING id, import, email, "user", status, error, creation, modification
+`
+
+type ProvisionParams struct {
+	Import int64  `db:"import" json:"import"`
+	Email  string `db:"email" json:"email"`
+	UserID int64  `db:"user_id" json:"user_id"`
+}
+
+// Provision records a provisioned user whose invitation has yet to be delivered.
+func (q *Queries) Provision(ctx context.Context, db DBTX, arg *ProvisionParams) (ImportRow, error) {
+	row := db.QueryRow(ctx, provision, arg.Import, arg.Email, arg.UserID)
+	var i ImportRow
+	err := row.Scan(
+		&i.ID,
+		&i.Import,
+		&i.Email,
+		&i.User,
+		&i.Status,
+		&i.Error,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const row = `-- name: Row :one
+SELECT id, import, email, "user", status, error, creation, modification FROM "Import-Row" WHERE (import) = $1 AND (email) = $2
+`
+
+type RowParams struct {
+	Import int64  `db:"import" json:"import"`
+	Email  string `db:"email" json:"email"`
+}
+
+// Row retrieves the [ImportRow] of the import's email address.
+func (q *Queries) Row(ctx context.Context, db DBTX, arg *RowParams) (ImportRow, error) {
+	row := db.QueryRow(ctx, row, arg.Import, arg.Email)
+	var i ImportRow
+	err := row.Scan(
+		&i.ID,
+		&i.Import,
+		&i.Email,
+		&i.User,
+		&i.Status,
+		&i.Error,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
@@ -0,0 +1,50 @@
+--
+-- Import
+--
+
+CREATE TABLE "Import"
+(
+    "id"            bigserial
+        CONSTRAINT "import-id-primary-key" primary key,
+
+    "key"           varchar(255)                           not null,
+    "administrator" varchar(255)                           not null,
+
+    "creation"      timestamp with time zone default now() not null,
+    "modification"  timestamp with time zone,
+
+    CONSTRAINT "import-administrator-key-unique" UNIQUE (administrator, key)
+);
+
+COMMENT ON TABLE "Import" IS 'Import represents an administrative bulk user import, identified by the administrator''s idempotency key so a partially failed import can be re-run.';
+COMMENT ON COLUMN "Import"."key" IS 'Key represents the client-provided Idempotency-Key request header.';
+
+--
+-- Import-Row
+--
+
+CREATE TABLE "Import-Row"
+(
+    "id"           bigserial
+        CONSTRAINT "import-row-id-primary-key" primary key,
+
+    "import"       bigint                                 not null
+        CONSTRAINT "import-row-import-foreign-key" REFERENCES "Import" (id) ON DELETE CASCADE,
+
+    "email"        varchar(255)                           not null,
+    "user"         bigint                                 not null,
+
+    "status"       varchar(16)                            not null
+        CONSTRAINT "import-row-status-constraint" CHECK ("Import-Row"."status" IN ('PROVISIONED', 'INVITED')),
+
+    "error"        text                     default NULL,
+
+    "creation"     timestamp with time zone default now() not null,
+    "modification" timestamp with time zone,
+
+    CONSTRAINT "import-row-email-unique" UNIQUE (import, email)
+);
+
+COMMENT ON TABLE "Import-Row" IS 'Import-Row represents a user provisioned by an "Import". A PROVISIONED row''s invitation has yet to be delivered; re-running the import only re-sends its invitation.';
+COMMENT ON COLUMN "Import-Row"."user" IS 'User represents the provisioned "User" record''s identifier.';
+COMMENT ON COLUMN "Import-Row"."error" IS 'Error represents the most recent invitation delivery failure.';
@@ -0,0 +1,7 @@
+package imports
+
+// [ImportRow] status value(s).
+const (
+	Provisioned = "PROVISIONED" // Provisioned represents a row whose user was created, but whose invitation hasn't been delivered.
+	Invited     = "INVITED"     // Invited represents a row whose invitation was delivered.
+)
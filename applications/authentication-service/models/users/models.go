@@ -10,8 +10,10 @@ import (
 
 type User struct {
 	// ID represents a PostgreSQL-generated unique identifier.
-	ID           int64              `db:"id" json:"id"`
-	Email        string             `db:"email" json:"email"`
+	ID    int64  `db:"id" json:"id"`
+	Email string `db:"email" json:"email"`
+	// Username represents the user's optional login identifier, synchronized from user-service. Uniqueness is case-insensitive.
+	Username     *string            `db:"username" json:"username"`
 	Password     string             `db:"password" json:"-"`
 	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
 	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 
 	"golang.org/x/crypto/bcrypt"
+
+	"authentication-service/internal/library/random"
 )
 
 // Hash will hash a given password. Uses [bcrypt.DefaultCost].
@@ -25,3 +27,9 @@ func Verify(hashed, password string) error {
 
 	return err
 }
+
+// Placeholder returns an unusable password value for a provisioned user that hasn't yet accepted an invitation. The
+// "!" prefix can never be produced by [Hash], so [Verify] always fails against it; see the Accept query.
+func Placeholder() string {
+	return "!" + random.Verification()
+}
@@ -9,6 +9,8 @@ import (
 )
 
 type Querier interface {
+	// Accept assigns the hashed password of a provisioned [User] database record that hasn't yet accepted its invitation (see [Placeholder]). A zero row count indicates the invitation was already accepted, or the user doesn't exist.
+	Accept(ctx context.Context, db DBTX, arg *AcceptParams) (int64, error)
 	// Clean performs a hard delete on the [User] database record, regardless if a soft delete has been performed, and only by email. This function should only be used in test(s).
 	Clean(ctx context.Context, db DBTX, email string) error
 	// Count returns 0 or 1 depending on if a User record matching the provided email exists.
@@ -23,14 +25,22 @@ type Querier interface {
 	Exists(ctx context.Context, db DBTX, id int64) (bool, error)
 	// Exists checks if a [User] record exists, searching for the entry via the [User.ID] property, regardless if a user has been soft deleted.
 	ExistsForce(ctx context.Context, db DBTX, id int64) (bool, error)
+	// Export retrieves a [User] database record's personal data -- excluding credential(s) -- for a data-subject access request.
+	Export(ctx context.Context, db DBTX, email string) (ExportRow, error)
 	// Extract retrieves a given [User] database record, regardless of its deletion status.
 	Extract(ctx context.Context, db DBTX, arg *ExtractParams) (User, error)
 	Get(ctx context.Context, db DBTX, email string) (GetRow, error)
+	// GetByUsername retrieves an active [User] by username, case-insensitively.
+	GetByUsername(ctx context.Context, db DBTX, username string) (GetByUsernameRow, error)
 	GetForce(ctx context.Context, db DBTX, email string) (GetForceRow, error)
 	// GetUserEmailAddressByID will return a [User] with the record's [User.Email] and [User.ID] hydrated when searching by a [User] identifier.
 	GetUserEmailAddressByID(ctx context.Context, db DBTX, id int64) (GetUserEmailAddressByIDRow, error)
 	// GetUserEmailAddressByIDForce will return a [User] with the record's [User.Email] and [User.ID] hydrated when searching by a [User] identifier -- regardless of soft delete.
 	GetUserEmailAddressByIDForce(ctx context.Context, db DBTX, id int64) (GetUserEmailAddressByIDForceRow, error)
+	// Rename assigns the username of the active [User] with the specified email address.
+	Rename(ctx context.Context, db DBTX, arg *RenameParams) (int64, error)
+	// Restore reverts a soft delete on the [User] database record. Restore is used to compensate a failed deletion saga.
+	Restore(ctx context.Context, db DBTX, id int64) error
 }
 
 var _ Querier = (*Queries)(nil)
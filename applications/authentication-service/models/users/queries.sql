@@ -43,3 +43,23 @@ UPDATE "User" SET (modification, deletion) = (now(), now()) WHERE (id) = (sqlc.a
 -- name: Extract :one
 -- Extract retrieves a given [User] database record, regardless of its deletion status.
 SELECT * FROM "User" WHERE (id, email) = (sqlc.arg(id), sqlc.arg(email));
+
+-- name: Export :one
+-- Export retrieves a [User] database record's personal data -- excluding credential(s) -- for a data-subject access request.
+SELECT "id", "email", "username", "creation", "modification", "deletion" FROM "User" WHERE (email) = sqlc.arg(email) AND (deletion) IS NULL;
+
+-- name: Restore :exec
+-- Restore reverts a soft delete on the [User] database record. Restore is used to compensate a failed deletion saga.
+UPDATE "User" SET modification = now(), deletion = NULL WHERE (id) = sqlc.arg(id) AND (deletion) IS NOT NULL;
+
+-- name: Accept :execrows
+-- Accept assigns the hashed password of a provisioned [User] database record that hasn't yet accepted its invitation (see [Placeholder]). A zero row count indicates the invitation was already accepted, or the user doesn't exist.
+UPDATE "User" SET modification = now(), password = sqlc.arg(password) WHERE (email) = sqlc.arg(email) AND (password) LIKE '!%' AND (deletion) IS NULL;
+
+-- name: GetByUsername :one
+-- GetByUsername retrieves an active [User] by username, case-insensitively.
+SELECT id, email, password FROM "User" WHERE lower(username) = lower(sqlc.arg(username)::text) AND (deletion) IS NULL;
+
+-- name: Rename :execrows
+-- Rename assigns the username of the active [User] with the specified email address.
+UPDATE "User" SET username = sqlc.arg(username)::varchar, modification = now() WHERE (email) = sqlc.arg(email) AND (deletion) IS NULL;
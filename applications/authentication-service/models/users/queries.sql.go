@@ -7,8 +7,28 @@ package users
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const accept = `-- name: Accept :execrows
+UPDATE "User" SET modification = now(), password = $1 WHERE (email) = $2 AND (password) LIKE '!%' AND (deletion) IS NULL
+`
+
+type AcceptParams struct {
+	Password string `db:"password" json:"-"`
+	Email    string `db:"email" json:"email"`
+}
+
+// Accept assigns the hashed password of a provisioned [User] database record that hasn't yet accepted its invitation (see [Placeholder]). A zero row count indicates the invitation was already accepted, or the user doesn't exist.
+func (q *Queries) Accept(ctx context.Context, db DBTX, arg *AcceptParams) (int64, error) {
+	result, err := db.Exec(ctx, accept, arg.Password, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const clean = `-- name: Clean :exec
 DELETE FROM "User" WHERE (email) = $1
 `
@@ -32,7 +52,7 @@ func (q *Queries) Count(ctx context.Context, db DBTX, email string) (int64, erro
 }
 
 const create = `-- name: Create :one
-INSERT INTO "User" (email, password) VALUES ($1, $2) RETURNING id, email, password, creation, modification, deletion
+INSERT INTO "User" (email, password) VALUES ($1, $2) RETURNING id, email, username, password, creation, modification, deletion
 `
 
 type CreateParams struct {
@@ -47,6 +67,7 @@ func (q *Queries) Create(ctx context.Context, db DBTX, arg *CreateParams) (User,
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
+		&i.Username,
 		&i.Password,
 		&i.Creation,
 		&i.Modification,
@@ -99,8 +120,36 @@ func (q *Queries) ExistsForce(ctx context.Context, db DBTX, id int64) (bool, err
 	return exists, err
 }
 
+const export = `-- name: Export :one
+SELECT "id", "email", "username", "creation", "modification", "deletion" FROM "User" WHERE (email) = $1 AND (deletion) IS NULL
+`
+
+type ExportRow struct {
+	ID           int64              `db:"id" json:"id"`
+	Email        string             `db:"email" json:"email"`
+	Username     *string            `db:"username" json:"username"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	Deletion     pgtype.Timestamptz `db:"deletion" json:"deletion"`
+}
+
+// Export retrieves a [User] database record's personal data -- excluding credential(s) -- for a data-subject access request.
+func (q *Queries) Export(ctx context.Context, db DBTX, email string) (ExportRow, error) {
+	row := db.QueryRow(ctx, export, email)
+	var i ExportRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Username,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
 const extract = `-- name: Extract :one
-SELECT id, email, password, creation, modification, deletion FROM "User" WHERE (id, email) = ($1, $2)
+SELECT id, email, username, password, creation, modification, deletion FROM "User" WHERE (id, email) = ($1, $2)
 `
 
 type ExtractParams struct {
@@ -115,6 +164,7 @@ func (q *Queries) Extract(ctx context.Context, db DBTX, arg *ExtractParams) (Use
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
+		&i.Username,
 		&i.Password,
 		&i.Creation,
 		&i.Modification,
@@ -140,6 +190,24 @@ func (q *Queries) Get(ctx context.Context, db DBTX, email string) (GetRow, error
 	return i, err
 }
 
+const getByUsername = `-- name: GetByUsername :one
+SELECT id, email, password FROM "User" WHERE lower(username) = lower($1::text) AND (deletion) IS NULL
+`
+
+type GetByUsernameRow struct {
+	ID       int64  `db:"id" json:"id"`
+	Email    string `db:"email" json:"email"`
+	Password string `db:"password" json:"-"`
+}
+
+// GetByUsername retrieves an active [User] by username, case-insensitively.
+func (q *Queries) GetByUsername(ctx context.Context, db DBTX, username string) (GetByUsernameRow, error) {
+	row := db.QueryRow(ctx, getByUsername, username)
+	var i GetByUsernameRow
+	err := row.Scan(&i.ID, &i.Email, &i.Password)
+	return i, err
+}
+
 const getForce = `-- name: GetForce :one
 SELECT id, email, password FROM "User" WHERE email = $1
 `
@@ -190,3 +258,31 @@ func (q *Queries) GetUserEmailAddressByIDForce(ctx context.Context, db DBTX, id
 	err := row.Scan(&i.ID, &i.Email)
 	return i, err
 }
+
+const rename = `-- name: Rename :execrows
+UPDATE "User" SET username = $1::varchar, modification = now() WHERE (email) = $2 AND (deletion) IS NULL
+`
+
+type RenameParams struct {
+	Username string `db:"username" json:"username"`
+	Email    string `db:"email" json:"email"`
+}
+
+// Rename assigns the username of the active [User] with the specified email address.
+func (q *Queries) Rename(ctx context.Context, db DBTX, arg *RenameParams) (int64, error) {
+	result, err := db.Exec(ctx, rename, arg.Username, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restore = `-- name: Restore :exec
+UPDATE "User" SET modification = now(), deletion = NULL WHERE (id) = $1 AND (deletion) IS NOT NULL
+`
+
+// Restore reverts a soft delete on the [User] database record. Restore is used to compensate a failed deletion saga.
+func (q *Queries) Restore(ctx context.Context, db DBTX, id int64) error {
+	_, err := db.Exec(ctx, restore, id)
+	return err
+}
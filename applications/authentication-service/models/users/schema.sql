@@ -41,6 +41,8 @@ CREATE TABLE "User"
         CONSTRAINT "user-email-validation-constraint" CHECK ("User"."email" ~* '^[A-Za-z0-9._+%-]+@[A-Za-z0-9.-]+[.][A-Za-z]+$')
         CONSTRAINT "user-email-unique-constraint" unique,
 
+    "username"     varchar(32) DEFAULT NULL,
+
     "password"     varchar(255) NOT NULL,
 
     "creation"     timestamp with time zone default now(),
@@ -52,3 +54,7 @@ COMMENT ON COLUMN "User".id IS 'ID represents a PostgreSQL-generated unique iden
 
 CREATE INDEX IF NOT EXISTS "user-email-index" on "User" (email);
 CREATE INDEX IF NOT EXISTS "user-deletion-index" on "User" (deletion);
+
+COMMENT ON COLUMN "User".username IS 'Username represents the user''s optional login identifier, synchronized from user-service. Uniqueness is case-insensitive.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS "user-username-unique-index" on "User" (lower(username));
@@ -5,8 +5,12 @@ import (
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"reconnaissance-service/internal/api/tls/chain"
+	"reconnaissance-service/internal/api/tls/enumeration"
 	"reconnaissance-service/internal/api/tls/expiration"
+	"reconnaissance-service/internal/api/tls/scan"
 	"reconnaissance-service/internal/api/tls/x509"
+	"reconnaissance-service/internal/api/watchlist"
 )
 
 func Router(parent *http.ServeMux) {
@@ -32,4 +36,16 @@ func Router(parent *http.ServeMux) {
 	parent.Handle("POST /tls/expiration", otelhttp.WithRouteTag("/tls/expiration", expiration.Handler))
 
 	parent.Handle("POST /tls/x509", otelhttp.WithRouteTag("/tls/x509", x509.Handler))
+
+	parent.Handle("POST /tls/chain", otelhttp.WithRouteTag("/tls/chain", chain.Handler))
+
+	parent.Handle("POST /tls/scan", otelhttp.WithRouteTag("/tls/scan", scan.Handler))
+
+	parent.Handle("POST /tls/enumeration", otelhttp.WithRouteTag("/tls/enumeration", enumeration.Handler))
+
+	parent.Handle("POST /watchlist", otelhttp.WithRouteTag("/watchlist", watchlist.Create))
+	parent.Handle("GET /watchlist", otelhttp.WithRouteTag("/watchlist", watchlist.List))
+	parent.Handle("GET /watchlist/{id}", otelhttp.WithRouteTag("/watchlist/{id}", watchlist.Watch))
+	parent.Handle("PUT /watchlist/{id}", otelhttp.WithRouteTag("/watchlist/{id}", watchlist.Update))
+	parent.Handle("DELETE /watchlist/{id}", otelhttp.WithRouteTag("/watchlist/{id}", watchlist.Delete))
 }
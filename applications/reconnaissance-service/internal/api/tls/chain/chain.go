@@ -0,0 +1,117 @@
+package chain
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"reconnaissance-service/internal/chain"
+	"reconnaissance-service/internal/library/middleware"
+	"reconnaissance-service/internal/probe"
+
+	"reconnaissance-service/internal/library/server"
+)
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "chain"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Input", slog.String("hostname", input.Hostname), slog.Int("port", input.Port), slog.Bool("roots", input.Roots != ""))
+
+	roots, e := input.Pool()
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Root Certificate Bundle", slog.String("error", e.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(input.Help())
+
+		return
+	}
+
+	address := net.JoinHostPort(input.Hostname, strconv.Itoa(input.Port))
+
+	// Attempt to resolve the hostname to an IP address
+	if _, e := net.DefaultResolver.LookupIPAddr(ctx, input.Hostname); e != nil {
+		labeler.Add(attribute.Bool("warning", true))
+		slog.WarnContext(ctx, "Hostname Doesn't Exist or Cannot be Resolved", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	// Establish the handshake without verification, such that an untrusted chain can still be retrieved
+	state, e := probe.Handshake(ctx, address, &tls.Config{ServerName: input.Hostname})
+	if e != nil {
+		labeler.Add(attribute.Bool("error", true))
+		slog.ErrorContext(ctx, "Unable to Establish TLS Connection", slog.String("address", address), slog.String("error", e.Error()))
+		http.Error(w, fmt.Sprintf("Unable to Establish TLS Connection to Address %s", address), http.StatusBadGateway)
+		return
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		message := fmt.Sprintf("No Peer Certificates Found for Address %s", address)
+
+		labeler.Add(attribute.Bool("warning", true))
+		slog.WarnContext(ctx, "No Peer Certificates Found", slog.String("address", address), slog.String("message", message))
+		http.Error(w, message, http.StatusUnprocessableEntity)
+		return
+	}
+
+	report := chain.Analyze(input.Hostname, state.PeerCertificates, roots, time.Now())
+	if !(report.Trusted) || !(report.HostnameMatch) {
+		labeler.Add(attribute.Bool("warning", true))
+	}
+
+	slog.InfoContext(ctx, "Evaluated Certificate Chain", slog.String("address", address), slog.Int("certificates", len(report.Certificates)), slog.Bool("trusted", report.Trusted), slog.Int("issues", len(report.Issues)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"address":      address,
+		"hostname":     input.Hostname,
+		"port":         input.Port,
+		"tls-version":  tls.VersionName(state.Version),
+		"cipher-suite": tls.CipherSuiteName(state.CipherSuite),
+		"chain":        report,
+	})
+
+	return
+}
+
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
@@ -0,0 +1,126 @@
+package chain_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"reconnaissance-service/internal/api"
+	"reconnaissance-service/internal/library/middleware"
+
+	"reconnaissance-service/internal/library/middleware/keystore"
+)
+
+func Test(t *testing.T) {
+	ctx := context.Background()
+
+	ctx = context.WithValue(ctx, keystore.Keys().Service(), "service")
+
+	middlewares := middleware.Middleware()
+	middlewares.Add(middleware.New().Telemetry().Middleware)
+
+	mux := http.NewServeMux()
+
+	api.Router(mux)
+
+	handler := middlewares.Handler(mux)
+
+	server := httptest.NewServer(handler)
+
+	server.Config.BaseContext = func(net.Listener) context.Context {
+		return ctx
+	}
+
+	defer server.Close()
+
+	client := server.Client()
+
+	// target represents the TLS system whose chain is evaluated; its self-signed certificate is valid for "example.com"
+	target := httptest.NewTLSServer(http.NotFoundHandler())
+
+	defer target.Close()
+
+	location, e := url.Parse(target.URL)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	port, e := strconv.Atoi(location.Port())
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	roots := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: target.Certificate().Raw}))
+
+	call := func(t *testing.T, body map[string]interface{}) (*http.Response, map[string]interface{}) {
+		t.Helper()
+
+		var buffer bytes.Buffer
+		json.NewEncoder(&buffer).Encode(body)
+
+		request, e := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/tls/chain", server.URL), &buffer)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		response, e := client.Do(request)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		defer response.Body.Close()
+
+		var output map[string]interface{}
+		json.NewDecoder(response.Body).Decode(&output)
+
+		t.Logf("Output: %v", output)
+
+		return response, output
+	}
+
+	t.Run("Caller-Roots", func(t *testing.T) {
+		response, output := call(t, map[string]interface{}{"hostname": "localhost", "port": port, "roots": roots})
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("Expected Status Code (%d), Received (%d)", http.StatusOK, response.StatusCode)
+		}
+
+		report := output["chain"].(map[string]interface{})
+		if report["trusted"] != true || report["roots"] != "caller" {
+			t.Errorf("Expected Trusted Chain via Caller Roots: %v", report)
+		}
+
+		if report["hostname-match"] != false {
+			t.Errorf("Expected Hostname Mismatch: %v", report)
+		}
+
+		if certificates := report["certificates"].([]interface{}); len(certificates) != 1 {
+			t.Errorf("Unexpected Certificate Count: %d", len(certificates))
+		}
+	})
+
+	t.Run("System-Roots", func(t *testing.T) {
+		response, output := call(t, map[string]interface{}{"hostname": "localhost", "port": port})
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("Expected Status Code (%d), Received (%d)", http.StatusOK, response.StatusCode)
+		}
+
+		if report := output["chain"].(map[string]interface{}); report["trusted"] != false || report["roots"] != "system" {
+			t.Errorf("Expected Untrusted Chain via System Roots: %v", report)
+		}
+	})
+
+	t.Run("Invalid-Roots", func(t *testing.T) {
+		response, _ := call(t, map[string]interface{}{"hostname": "localhost", "port": port, "roots": "invalid"})
+		if response.StatusCode != http.StatusBadRequest {
+			t.Fatalf("Expected Status Code (%d), Received (%d)", http.StatusBadRequest, response.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package chain
+
+import (
+	"crypto/x509"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+
+	"reconnaissance-service/internal/library/server"
+)
+
+// ErrRoots is returned by [Body.Pool] when the caller-supplied root bundle contains no PEM-encoded certificate.
+var ErrRoots = errors.New("invalid root certificate bundle")
+
+// Body represents the handler's structured request-body
+type Body struct {
+	Hostname string `json:"hostname" validate:"required,hostname"` // Hostname represents the target system's hostname, according to RFC 952.
+	Port     int    `json:"port" validate:"min=1,max=65535"`       // Port represents the target system's TLS-exposed port, the partial used to construct an address according to RFC 1123.
+	Roots    string `json:"roots,omitempty"`                       // Roots represents an optional PEM-encoded root certificate bundle verified against in place of the system's roots.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"hostname": {
+			Value:   b.Hostname,
+			Valid:   b.Hostname != "",
+			Message: "(Required) A valid, RFC-952 specification defined hostname is required.",
+		},
+		"port": {
+			Valid:   b.Port > 0 && b.Port <= 65535,
+			Message: "(Required) The system's hostname-related port is required. Port must be in range 0 < port <= 65535.",
+		},
+		"roots": {
+			Valid:   b.Roots == "" || x509.NewCertPool().AppendCertsFromPEM([]byte(b.Roots)),
+			Message: "(Optional) A PEM-encoded root certificate bundle to verify the chain against in place of the system's roots.",
+		},
+	}
+
+	return mapping
+}
+
+// Pool returns the caller-supplied root bundle, else nil -- denoting the system's roots.
+func (b *Body) Pool() (*x509.CertPool, error) {
+	if b.Roots == "" {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !(pool.AppendCertsFromPEM([]byte(b.Roots))) {
+		return nil, ErrRoots
+	}
+
+	return pool, nil
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
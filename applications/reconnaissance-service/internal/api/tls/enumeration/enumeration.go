@@ -0,0 +1,99 @@
+package enumeration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"reconnaissance-service/internal/enumeration"
+	"reconnaissance-service/internal/library/middleware"
+
+	"reconnaissance-service/internal/library/server"
+)
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "enumeration"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Input", slog.Any("body", input))
+
+	address := net.JoinHostPort(input.Hostname, strconv.Itoa(input.Port))
+
+	// Attempt to resolve the hostname to an IP address
+	if _, e := net.DefaultResolver.LookupIPAddr(ctx, input.Hostname); e != nil {
+		labeler.Add(attribute.Bool("warning", true))
+		slog.WarnContext(ctx, "Hostname Doesn't Exist or Cannot be Resolved", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	report, e := enumeration.Enumerate(ctx, address, input.Hostname)
+	if e != nil {
+		switch {
+		case errors.Is(e, enumeration.ErrUnreachable):
+			labeler.Add(attribute.Bool("warning", true))
+			slog.WarnContext(ctx, "Unable to Establish Connection", slog.String("address", address), slog.String("error", e.Error()))
+			http.Error(w, fmt.Sprintf("Unable to Establish Connection to Address %s", address), http.StatusBadGateway)
+		default: // --> the request's deadline interrupted the enumeration
+			labeler.Add(attribute.Bool("error", true))
+			slog.ErrorContext(ctx, "Unable to Complete Enumeration", slog.String("address", address), slog.String("error", e.Error()))
+			http.Error(w, http.StatusText(http.StatusGatewayTimeout), http.StatusGatewayTimeout)
+		}
+
+		return
+	}
+
+	if len(report.Findings) > 0 {
+		labeler.Add(attribute.Bool("warning", true))
+	}
+
+	slog.InfoContext(ctx, "Enumerated TLS Configuration", slog.String("address", address), slog.String("grade", report.Grade), slog.Int("findings", len(report.Findings)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"address":     address,
+		"hostname":    input.Hostname,
+		"port":        input.Port,
+		"enumeration": report,
+	})
+
+	return
+}
+
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
@@ -0,0 +1,116 @@
+package enumeration_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"reconnaissance-service/internal/api"
+	"reconnaissance-service/internal/library/middleware"
+
+	"reconnaissance-service/internal/library/middleware/keystore"
+)
+
+func Test(t *testing.T) {
+	ctx := context.Background()
+
+	ctx = context.WithValue(ctx, keystore.Keys().Service(), "service")
+
+	middlewares := middleware.Middleware()
+	middlewares.Add(middleware.New().Telemetry().Middleware)
+
+	mux := http.NewServeMux()
+
+	api.Router(mux)
+
+	handler := middlewares.Handler(mux)
+
+	server := httptest.NewServer(handler)
+
+	server.Config.BaseContext = func(net.Listener) context.Context {
+		return ctx
+	}
+
+	defer server.Close()
+
+	client := server.Client()
+
+	// target represents the TLS system whose configuration is enumerated
+	target := httptest.NewUnstartedServer(http.NotFoundHandler())
+	target.TLS = &tls.Config{MinVersion: tls.VersionTLS11, MaxVersion: tls.VersionTLS12, NextProtos: []string{"http/1.1"}}
+	target.Config.ErrorLog = log.New(io.Discard, "", 0)
+	target.StartTLS()
+
+	defer target.Close()
+
+	location, e := url.Parse(target.URL)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	port, e := strconv.Atoi(location.Port())
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	t.Run("200", func(t *testing.T) {
+		var body bytes.Buffer
+		json.NewEncoder(&body).Encode(map[string]interface{}{"hostname": "localhost", "port": port})
+
+		request, e := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/tls/enumeration", server.URL), &body)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		response, e := client.Do(request)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("Expected Status Code (%d), Received (%d)", http.StatusOK, response.StatusCode)
+		}
+
+		var output struct {
+			Enumeration struct {
+				Protocols []struct {
+					Version   string `json:"version"`
+					Supported bool   `json:"supported"`
+				} `json:"protocols"`
+				ALPN  []string `json:"alpn"`
+				Grade string   `json:"grade"`
+			} `json:"enumeration"`
+		}
+
+		if e := json.NewDecoder(response.Body).Decode(&output); e != nil {
+			t.Fatal(e)
+		}
+
+		t.Logf("Output: %+v", output)
+
+		supported := make(map[string]bool)
+		for _, protocol := range output.Enumeration.Protocols {
+			supported[protocol.Version] = protocol.Supported
+		}
+
+		if supported["TLS 1.0"] || !(supported["TLS 1.1"]) || !(supported["TLS 1.2"]) || supported["TLS 1.3"] {
+			t.Errorf("Unexpected Protocol(s): %v", supported)
+		}
+
+		if output.Enumeration.Grade != "B" && output.Enumeration.Grade != "C" {
+			t.Errorf("Unexpected Grade: %s", output.Enumeration.Grade)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package scan
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"reconnaissance-service/internal/library/server"
+	"reconnaissance-service/internal/probe"
+	"reconnaissance-service/internal/scan"
+)
+
+const (
+	NDJSON  = "application/x-ndjson" // NDJSON represents the newline-delimited JSON response media type.
+	Maximum = 1000                   // Maximum represents the maximum number of target(s) per request.
+)
+
+// Body represents the handler's structured request-body
+type Body struct {
+	Targets     []string `json:"targets" validate:"required,min=1,max=1000,dive,hostname_port"` // Targets represents the host:port address(es) to scan.
+	Concurrency int      `json:"concurrency" validate:"omitempty,min=1,max=64"`                 // Concurrency optionally overrides the number of target(s) probed concurrently.
+	Timeout     int      `json:"timeout" validate:"omitempty,min=1,max=60"`                     // Timeout optionally overrides each target's timeout, in seconds.
+}
+
+func (b *Body) Help() server.Validators {
+	valid := len(b.Targets) > 0 && len(b.Targets) <= Maximum
+	for _, target := range b.Targets {
+		if v.Var(target, "hostname_port") != nil {
+			valid = false
+		}
+	}
+
+	var mapping = server.Validators{
+		"targets": {
+			Value:   len(b.Targets),
+			Valid:   valid,
+			Message: "(Required) Between 1 and 1000 host:port address(es), e.g. \"example.com:443\".",
+		},
+		"concurrency": {
+			Value:   b.Concurrency,
+			Valid:   b.Concurrency >= 0 && b.Concurrency <= 64,
+			Message: "(Optional) The number of target(s) probed concurrently. Concurrency must be in range 0 < concurrency <= 64.",
+		},
+		"timeout": {
+			Value:   b.Timeout,
+			Valid:   b.Timeout >= 0 && b.Timeout <= 60,
+			Message: "(Optional) Each target's timeout, in seconds. Timeout must be in range 0 < timeout <= 60.",
+		},
+	}
+
+	return mapping
+}
+
+// Workers returns the requested concurrency, else the [scan.Concurrency] default.
+func (b *Body) Workers() int {
+	if b.Concurrency > 0 {
+		return b.Concurrency
+	}
+
+	return scan.Concurrency()
+}
+
+// Deadline returns the requested per-target timeout, else the [probe.Timeout] default.
+func (b *Body) Deadline() time.Duration {
+	if b.Timeout > 0 {
+		return time.Duration(b.Timeout) * time.Second
+	}
+
+	return probe.Timeout()
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
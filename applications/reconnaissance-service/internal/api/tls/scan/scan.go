@@ -0,0 +1,91 @@
+package scan
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"reconnaissance-service/internal/library/middleware"
+	"reconnaissance-service/internal/scan"
+
+	"reconnaissance-service/internal/library/server"
+)
+
+// margin represents the time reserved ahead of the request's deadline to write the remaining record(s) and summary.
+const margin = 2 * time.Second
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "scan"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	concurrency, timeout := input.Workers(), input.Deadline()
+
+	slog.InfoContext(ctx, "Starting TLS Scan", slog.Int("targets", len(input.Targets)), slog.Int("concurrency", concurrency), slog.Duration("timeout", timeout))
+
+	// --> stop ahead of the request's deadline such that the skipped target(s) and summary can be written
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithDeadline(ctx, deadline.Add(-margin))
+
+		defer cancel()
+	}
+
+	w.Header().Set("Content-Type", NDJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+
+	summary := scan.Scan(ctx, input.Targets, concurrency, timeout, func(result scan.Result) {
+		encoder.Encode(result)
+		controller.Flush()
+	})
+
+	if summary.Statuses[scan.Failed] > 0 || summary.Untrusted > 0 || summary.Expiring > 0 {
+		labeler.Add(attribute.Bool("warning", true))
+	}
+
+	slog.InfoContext(ctx, "Completed TLS Scan", slog.Int("targets", summary.Total), slog.Any("statuses", summary.Statuses), slog.Int("untrusted", summary.Untrusted), slog.Int("expiring", summary.Expiring), slog.Bool("complete", summary.Complete))
+
+	encoder.Encode(map[string]scan.Summary{"summary": summary})
+
+	return
+}
+
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
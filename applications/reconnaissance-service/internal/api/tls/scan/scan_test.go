@@ -0,0 +1,135 @@
+package scan_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"reconnaissance-service/internal/api"
+	"reconnaissance-service/internal/library/middleware"
+
+	"reconnaissance-service/internal/library/middleware/keystore"
+)
+
+func Test(t *testing.T) {
+	ctx := context.Background()
+
+	ctx = context.WithValue(ctx, keystore.Keys().Service(), "service")
+
+	middlewares := middleware.Middleware()
+	middlewares.Add(middleware.New().Telemetry().Middleware)
+
+	mux := http.NewServeMux()
+
+	api.Router(mux)
+
+	handler := middlewares.Handler(mux)
+
+	server := httptest.NewServer(handler)
+
+	server.Config.BaseContext = func(net.Listener) context.Context {
+		return ctx
+	}
+
+	defer server.Close()
+
+	client := server.Client()
+
+	target := httptest.NewTLSServer(http.NotFoundHandler())
+
+	defer target.Close()
+
+	address := strings.TrimPrefix(target.URL, "https://")
+
+	call := func(t *testing.T, body map[string]interface{}) *http.Response {
+		t.Helper()
+
+		var buffer bytes.Buffer
+		json.NewEncoder(&buffer).Encode(body)
+
+		request, e := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/tls/scan", server.URL), &buffer)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		response, e := client.Do(request)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		return response
+	}
+
+	t.Run("200", func(t *testing.T) {
+		response := call(t, map[string]interface{}{"targets": []string{address, "localhost:1", address}, "concurrency": 2, "timeout": 5})
+
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("Expected Status Code (%d), Received (%d)", http.StatusOK, response.StatusCode)
+		}
+
+		if v := response.Header.Get("Content-Type"); v != "application/x-ndjson" {
+			t.Errorf("Unexpected Content-Type: %s", v)
+		}
+
+		var records []map[string]interface{}
+
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			var record map[string]interface{}
+			if e := json.Unmarshal(scanner.Bytes(), &record); e != nil {
+				t.Fatalf("Invalid NDJSON Record (%s): %v", scanner.Text(), e)
+			}
+
+			t.Logf("Record: %v", record)
+
+			records = append(records, record)
+		}
+
+		if len(records) != 4 {
+			t.Fatalf("Expected 4 Record(s), Received %d", len(records))
+		}
+
+		for _, record := range records[:3] {
+			if _, ok := record["target"]; !(ok) {
+				t.Errorf("Expected Result Record: %v", record)
+			}
+		}
+
+		summary, ok := records[3]["summary"].(map[string]interface{})
+		if !(ok) {
+			t.Fatalf("Expected Summary Record: %v", records[3])
+		}
+
+		statuses := summary["statuses"].(map[string]interface{})
+		if summary["total"] != float64(3) || statuses["succeeded"] != float64(2) || statuses["failed"] != float64(1) || summary["complete"] != true {
+			t.Errorf("Unexpected Summary: %v", summary)
+		}
+	})
+
+	t.Run("400", func(t *testing.T) {
+		for name, body := range map[string]map[string]interface{}{
+			"Empty":        {"targets": []string{}},
+			"Missing-Port": {"targets": []string{"example.com"}},
+			"Concurrency":  {"targets": []string{"example.com:443"}, "concurrency": 65},
+		} {
+			t.Run(name, func(t *testing.T) {
+				response := call(t, body)
+
+				defer response.Body.Close()
+
+				if response.StatusCode != http.StatusBadRequest {
+					t.Fatalf("Expected Status Code (%d), Received (%d)", http.StatusBadRequest, response.StatusCode)
+				}
+			})
+		}
+	})
+}
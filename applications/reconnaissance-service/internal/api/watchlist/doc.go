@@ -0,0 +1,3 @@
+// Package watchlist manages the host:port(s) re-scanned by the scheduler (see the internal watchlist package): create,
+// list, retrieve, update & delete.
+package watchlist
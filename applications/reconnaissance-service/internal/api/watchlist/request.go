@@ -0,0 +1,75 @@
+package watchlist
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+
+	"reconnaissance-service/internal/library/server"
+)
+
+const (
+	size    = 50  // size represents the default page size.
+	maximum = 250 // maximum represents the largest permitted page size.
+)
+
+// Body represents the create & update handlers' structured request-body
+type Body struct {
+	Hostname string `json:"hostname" validate:"required,hostname"` // Hostname represents the watched system's hostname, according to RFC 952.
+	Port     int    `json:"port" validate:"min=1,max=65535"`       // Port represents the watched system's TLS-exposed port.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"hostname": {
+			Value:   b.Hostname,
+			Valid:   b.Hostname != "",
+			Message: "(Required) A valid, RFC-952 specification defined hostname is required.",
+		},
+		"port": {
+			Valid:   b.Port > 0 && b.Port <= 65535,
+			Message: "(Required) The system's hostname-related port is required. Port must be in range 0 < port <= 65535.",
+		},
+	}
+
+	return mapping
+}
+
+// Filter represents the list handler's parsed query parameter(s).
+type Filter struct {
+	Cursor *int64 // Cursor represents the exclusive upper bound of the page's watch identifier(s).
+	Size   int32  // Size represents the page size.
+}
+
+// Parse validates the list handler's query parameter(s): "cursor" and "limit".
+func Parse(query url.Values) (*Filter, error) {
+	filter := &Filter{Size: size}
+
+	if value := query.Get("cursor"); value != "" {
+		cursor, e := strconv.ParseInt(value, 10, 64)
+		if e != nil || cursor < 1 {
+			return nil, errors.New("invalid cursor: expected a positive integer")
+		}
+
+		filter.Cursor = &cursor
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, e := strconv.Atoi(value)
+		if e != nil || limit < 1 || limit > maximum {
+			return nil, errors.New("invalid limit: expected an integer between 1 and 250")
+		}
+
+		filter.Size = int32(limit)
+	}
+
+	return filter, nil
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
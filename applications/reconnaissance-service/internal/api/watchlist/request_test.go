@@ -0,0 +1,29 @@
+package watchlist
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	filter, e := Parse(url.Values{})
+	if e != nil || filter.Cursor != nil || filter.Size != size {
+		t.Errorf("Unexpected Default Filter: %+v (%v)", filter, e)
+	}
+
+	filter, e = Parse(url.Values{"cursor": {"120"}, "limit": {"10"}})
+	if e != nil || *filter.Cursor != 120 || filter.Size != 10 {
+		t.Errorf("Unexpected Filter: %+v (%v)", filter, e)
+	}
+
+	for _, query := range []url.Values{
+		{"cursor": {"-1"}},
+		{"cursor": {"abc"}},
+		{"limit": {"0"}},
+		{"limit": {"251"}},
+	} {
+		if _, e := Parse(query); e == nil {
+			t.Errorf("Expected Invalid Query to be Rejected: %v", query)
+		}
+	}
+}
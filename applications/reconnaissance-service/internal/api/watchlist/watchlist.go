@@ -0,0 +1,315 @@
+package watchlist
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"reconnaissance-service/internal/database"
+	"reconnaissance-service/internal/library/middleware"
+	"reconnaissance-service/models/watches"
+
+	"reconnaissance-service/internal/library/server"
+)
+
+// Page represents the list handler's response.
+type Page struct {
+	Watches []watches.Watch `json:"watches"` // Watches represents the page's watch(es), newest first.
+	Cursor  *int64          `json:"cursor"`  // Cursor represents the "cursor" query parameter of the next page, if any.
+}
+
+// Create adds a host:port to the watchlist; it's scanned by the scheduler's next cycle.
+var Create = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "watchlist-create"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	watch, e := watches.New().Create(ctx, connection, &watches.CreateParams{Hostname: strings.ToLower(input.Hostname), Port: int32(input.Port)})
+	if e != nil {
+		var exception *pgconn.PgError
+		if errors.As(e, &exception) && exception.Code == "23505" { // --> unique_violation
+			slog.WarnContext(ctx, "Address Already Watched", slog.String("hostname", input.Hostname), slog.Int("port", input.Port))
+			http.Error(w, "Address Already Watched", http.StatusConflict)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Create Watch", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Created Watch", slog.Int64("watch", watch.ID), slog.String("hostname", watch.Hostname), slog.Int("port", input.Port))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(watch)
+
+	return
+})
+
+// List returns a page of the watchlist, newest first.
+var List = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "watchlist-list"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	filter, e := Parse(r.URL.Query())
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Watchlist Query Parameter(s)", slog.String("error", e.Error()))
+		http.Error(w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	var page Page
+
+	page.Watches, e = watches.New().List(ctx, connection, &watches.ListParams{Cursor: filter.Cursor, Size: filter.Size})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to List Watchlist", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if count := len(page.Watches); count == int(filter.Size) {
+		page.Cursor = &page.Watches[count-1].ID
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
+
+	return
+})
+
+// Watch returns a single watch, including its most recent scan's outcome.
+var Watch = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "watchlist-watch"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		http.Error(w, "Invalid Watch Identifier", http.StatusBadRequest)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	watch, e := watches.New().Get(ctx, connection, id)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			http.Error(w, "Watch Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Get Watch", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(watch)
+
+	return
+})
+
+// Update replaces a watch's address, discarding its prior observation(s); it's rescanned by the scheduler's next cycle.
+var Update = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "watchlist-update"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		http.Error(w, "Invalid Watch Identifier", http.StatusBadRequest)
+		return
+	}
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	watch, e := watches.New().Update(ctx, connection, &watches.UpdateParams{ID: id, Hostname: strings.ToLower(input.Hostname), Port: int32(input.Port)})
+	if e != nil {
+		var exception *pgconn.PgError
+		if errors.Is(e, pgx.ErrNoRows) {
+			http.Error(w, "Watch Not Found", http.StatusNotFound)
+			return
+		} else if errors.As(e, &exception) && exception.Code == "23505" { // --> unique_violation; another watch has the address
+			slog.WarnContext(ctx, "Address Already Watched", slog.String("hostname", input.Hostname), slog.Int("port", input.Port))
+			http.Error(w, "Address Already Watched", http.StatusConflict)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Update Watch", slog.Int64("watch", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Updated Watch", slog.Int64("watch", watch.ID), slog.String("hostname", watch.Hostname), slog.Int("port", input.Port))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(watch)
+
+	return
+})
+
+// Delete removes a watch from the watchlist.
+var Delete = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "watchlist-delete"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		http.Error(w, "Invalid Watch Identifier", http.StatusBadRequest)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	rows, e := watches.New().Delete(ctx, connection, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Delete Watch", slog.Int64("watch", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if rows == 0 {
+		http.Error(w, "Watch Not Found", http.StatusNotFound)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Deleted Watch", slog.Int64("watch", id))
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return
+})
@@ -0,0 +1,294 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	Error   = "error"   // Error represents an issue that breaks trust, or the connection, for client(s).
+	Warning = "warning" // Warning represents an issue tolerated by most client(s), or one that soon will break trust.
+	Info    = "info"    // Info represents an informational finding.
+)
+
+const (
+	NameMismatch        = "name-mismatch"        // NameMismatch represents a leaf certificate that isn't valid for the hostname.
+	Expired             = "expired"              // Expired represents a certificate past its validity period.
+	Premature           = "not-yet-valid"        // Premature represents a certificate prior to its validity period.
+	WeakSignature       = "weak-signature"       // WeakSignature represents a certificate signed via a broken hash (MD2, MD5 or SHA-1).
+	WeakKey             = "weak-key"             // WeakKey represents a certificate's insufficiently sized public key.
+	WrongOrder          = "wrong-order"          // WrongOrder represents a certificate not followed by its issuer, though its issuer was presented.
+	MissingIntermediate = "missing-intermediate" // MissingIntermediate represents a certificate whose issuer was neither presented nor is a trusted root.
+	SelfSigned          = "self-signed"          // SelfSigned represents a self-signed leaf certificate.
+	NotAuthority        = "not-authority"        // NotAuthority represents an issuing certificate lacking the CA basic constraint.
+	RootIncluded        = "root-included"        // RootIncluded represents a self-signed root presented by the server, needlessly.
+	Untrusted           = "untrusted"            // Untrusted represents a chain that doesn't verify against the root(s).
+)
+
+// Issue represents a single finding, either of a [Link] or of the [Report] as a whole.
+type Issue struct {
+	Code     string `json:"code"`     // Code represents the issue's stable identifier (e.g. [WrongOrder]).
+	Severity string `json:"severity"` // Severity represents one of [Error], [Warning] or [Info].
+	Message  string `json:"message"`  // Message represents the issue's human-readable description.
+}
+
+// Link represents a single certificate, in the order the server presented it.
+type Link struct {
+	Position           int       `json:"position"`             // Position represents the certificate's zero-based index in the presented chain; zero being the leaf.
+	Subject            string    `json:"subject"`              // Subject represents the certificate's distinguished name.
+	Issuer             string    `json:"issuer"`               // Issuer represents the certificate's issuer's distinguished name.
+	SerialNumber       string    `json:"serial-number"`        // SerialNumber represents the certificate's serial number.
+	Fingerprint        string    `json:"fingerprint"`          // Fingerprint represents the hex-encoded SHA-256 digest of the certificate.
+	NotBefore          time.Time `json:"not-before"`           // NotBefore represents the start of the certificate's validity period.
+	NotAfter           time.Time `json:"not-after"`            // NotAfter represents the end of the certificate's validity period.
+	DNSNames           []string  `json:"dns-names,omitempty"`  // DNSNames represents the certificate's subject alternative name(s).
+	SignatureAlgorithm string    `json:"signature-algorithm"`  // SignatureAlgorithm represents the algorithm the certificate's issuer signed it with.
+	PublicKeyAlgorithm string    `json:"public-key-algorithm"` // PublicKeyAlgorithm represents the certificate's public key algorithm.
+	KeySize            int       `json:"key-size"`             // KeySize represents the public key's size in bits.
+	Authority          bool      `json:"authority"`            // Authority represents the certificate's CA basic constraint.
+	SelfSigned         bool      `json:"self-signed"`          // SelfSigned represents whether the certificate is signed by its own key.
+	IssuedBy           *int      `json:"issued-by"`            // IssuedBy represents the position of the presented certificate that signed this one, if any.
+	Issues             []Issue   `json:"issues,omitempty"`     // Issues represents the certificate's finding(s).
+}
+
+// Report represents the evaluation of a server's presented certificate chain.
+type Report struct {
+	Hostname      string     `json:"hostname"`               // Hostname represents the name the leaf certificate was verified against.
+	Roots         string     `json:"roots"`                  // Roots represents the root(s) source: "system", or "caller" for a caller-supplied bundle.
+	Trusted       bool       `json:"trusted"`                // Trusted represents whether a path from the leaf to a trusted root could be established.
+	HostnameMatch bool       `json:"hostname-match"`         // HostnameMatch represents whether the leaf certificate is valid for the hostname.
+	Verification  string     `json:"verification,omitempty"` // Verification represents the path validation's error, if any.
+	Paths         [][]string `json:"paths,omitempty"`        // Paths represents the verified path(s) as certificate fingerprint(s), from leaf to root.
+	Certificates  []Link     `json:"certificates"`           // Certificates represents each presented certificate.
+	Issues        []Issue    `json:"issues,omitempty"`       // Issues represents the chain's finding(s), including each [Link]'s.
+}
+
+// Analyze evaluates the certificate(s), as presented by the server, at the provided time. Nil roots evaluate against
+// the system's root(s).
+func Analyze(hostname string, certificates []*x509.Certificate, roots *x509.CertPool, now time.Time) Report {
+	report := Report{Hostname: hostname, Roots: "caller", Certificates: make([]Link, len(certificates))}
+	if roots == nil {
+		report.Roots = "system"
+	}
+
+	if len(certificates) == 0 {
+		return report
+	}
+
+	for index, certificate := range certificates {
+		report.Certificates[index] = describe(index, certificate)
+	}
+
+	// --> verify the path independently of the hostname, such that trust and the name match are reported separately
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	leaf := certificates[0]
+
+	paths, e := leaf.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates, CurrentTime: now, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+	if e != nil {
+		report.Verification = e.Error()
+	} else {
+		report.Trusted = true
+		for _, path := range paths {
+			fingerprints := make([]string, len(path))
+			for index, certificate := range path {
+				fingerprints[index] = fingerprint(certificate)
+			}
+
+			report.Paths = append(report.Paths, fingerprints)
+		}
+	}
+
+	if e := leaf.VerifyHostname(hostname); e != nil {
+		report.Certificates[0].Issues = append(report.Certificates[0].Issues, Issue{Code: NameMismatch, Severity: Error, Message: e.Error()})
+	} else {
+		report.HostnameMatch = true
+	}
+
+	for index, certificate := range certificates {
+		link := &report.Certificates[index]
+
+		link.Issues = append(link.Issues, validity(certificate, now)...)
+		link.Issues = append(link.Issues, strength(certificate)...)
+
+		if link.SelfSigned {
+			switch {
+			case index == 0:
+				severity := Error
+				if report.Trusted { // --> explicitly trusted, e.g. via a caller-supplied root bundle
+					severity = Warning
+				}
+
+				link.Issues = append(link.Issues, Issue{Code: SelfSigned, Severity: severity, Message: "The leaf certificate is self-signed."})
+			case index == len(certificates)-1:
+				link.Issues = append(link.Issues, Issue{Code: RootIncluded, Severity: Info, Message: "The self-signed root is presented needlessly; client(s) rely on their own trust store."})
+			}
+
+			continue
+		}
+
+		link.Issues = append(link.Issues, order(certificates, index, roots, now, report.Certificates)...)
+	}
+
+	for index, link := range report.Certificates {
+		if link.IssuedBy != nil && !(report.Certificates[*link.IssuedBy].Authority) {
+			issuer := &report.Certificates[*link.IssuedBy]
+			issuer.Issues = append(issuer.Issues, Issue{Code: NotAuthority, Severity: Error, Message: fmt.Sprintf("The certificate signs the certificate at position %d, though it lacks the CA basic constraint.", index)})
+		}
+	}
+
+	for _, link := range report.Certificates {
+		report.Issues = append(report.Issues, link.Issues...)
+	}
+
+	if !(report.Trusted) {
+		report.Issues = append(report.Issues, Issue{Code: Untrusted, Severity: Error, Message: "No path to a trusted root could be established: " + report.Verification})
+	}
+
+	return report
+}
+
+// describe returns the certificate's [Link], absent its issue(s).
+func describe(position int, certificate *x509.Certificate) Link {
+	return Link{
+		Position:           position,
+		Subject:            certificate.Subject.String(),
+		Issuer:             certificate.Issuer.String(),
+		SerialNumber:       certificate.SerialNumber.String(),
+		Fingerprint:        fingerprint(certificate),
+		NotBefore:          certificate.NotBefore,
+		NotAfter:           certificate.NotAfter,
+		DNSNames:           certificate.DNSNames,
+		SignatureAlgorithm: certificate.SignatureAlgorithm.String(),
+		PublicKeyAlgorithm: certificate.PublicKeyAlgorithm.String(),
+		KeySize:            size(certificate),
+		Authority:          certificate.BasicConstraintsValid && certificate.IsCA,
+		SelfSigned:         signs(certificate, certificate),
+	}
+}
+
+// order evaluates whether the certificate at the index is immediately followed by its issuer, recording the issuer's
+// position on the link; absent a presented issuer, the certificate must chain to a root directly.
+func order(certificates []*x509.Certificate, index int, roots *x509.CertPool, now time.Time, links []Link) []Issue {
+	certificate := certificates[index]
+
+	for position, candidate := range certificates {
+		if position == index || !(signs(candidate, certificate)) {
+			continue
+		}
+
+		links[index].IssuedBy = &position
+
+		if position != index+1 {
+			return []Issue{{Code: WrongOrder, Severity: Warning, Message: fmt.Sprintf("The certificate's issuer is presented at position %d rather than %d.", position, index+1)}}
+		}
+
+		return nil
+	}
+
+	if anchored(certificate, roots, now) {
+		return nil
+	}
+
+	return []Issue{{Code: MissingIntermediate, Severity: Error, Message: "The certificate's issuer (" + certificate.Issuer.String() + ") was neither presented nor is a trusted root."}}
+}
+
+// anchored reports whether the certificate is directly issued by one of the root(s). Validity is evaluated within the
+// certificate's own validity period, such that an expired certificate isn't mistaken for a missing intermediate.
+func anchored(certificate *x509.Certificate, roots *x509.CertPool, now time.Time) bool {
+	instant := now
+	if instant.Before(certificate.NotBefore) || instant.After(certificate.NotAfter) {
+		instant = certificate.NotBefore.Add(certificate.NotAfter.Sub(certificate.NotBefore) / 2)
+	}
+
+	_, e := certificate.Verify(x509.VerifyOptions{Roots: roots, Intermediates: x509.NewCertPool(), CurrentTime: instant, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+
+	var unknown x509.UnknownAuthorityError
+
+	return !(errors.As(e, &unknown))
+}
+
+// validity evaluates the certificate's validity period at the provided time.
+func validity(certificate *x509.Certificate, now time.Time) []Issue {
+	switch {
+	case now.After(certificate.NotAfter):
+		return []Issue{{Code: Expired, Severity: Error, Message: "The certificate expired " + certificate.NotAfter.UTC().Format(time.RFC3339) + "."}}
+	case now.Before(certificate.NotBefore):
+		return []Issue{{Code: Premature, Severity: Error, Message: "The certificate isn't valid until " + certificate.NotBefore.UTC().Format(time.RFC3339) + "."}}
+	}
+
+	return nil
+}
+
+// strength evaluates the certificate's signature algorithm and public key size. A self-signed certificate's own
+// signature isn't evaluated, as a root is trusted by its presence in the trust store rather than its signature.
+func strength(certificate *x509.Certificate) []Issue {
+	var issues []Issue
+
+	switch certificate.SignatureAlgorithm {
+	case x509.MD2WithRSA, x509.MD5WithRSA, x509.SHA1WithRSA, x509.DSAWithSHA1, x509.ECDSAWithSHA1:
+		if !(signs(certificate, certificate)) {
+			issues = append(issues, Issue{Code: WeakSignature, Severity: Error, Message: "The certificate is signed via " + certificate.SignatureAlgorithm.String() + ", a broken hash function."})
+		}
+	}
+
+	switch key := certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < 2048 {
+			issues = append(issues, Issue{Code: WeakKey, Severity: Error, Message: fmt.Sprintf("The certificate's RSA key is %d bits; at least 2048 are required.", key.N.BitLen())})
+		}
+	case *ecdsa.PublicKey:
+		if key.Curve.Params().BitSize < 256 {
+			issues = append(issues, Issue{Code: WeakKey, Severity: Error, Message: fmt.Sprintf("The certificate's ECDSA key is %d bits; at least 256 are required.", key.Curve.Params().BitSize)})
+		}
+	}
+
+	return issues
+}
+
+// signs reports whether the certificate names the issuer's subject and is signed by the issuer's key. Neither the
+// issuer's CA constraint (see [NotAuthority]) nor the signature's hash (see [WeakSignature]) are evaluated here, such
+// that a misissued link still is attributed to its issuer.
+func signs(issuer *x509.Certificate, certificate *x509.Certificate) bool {
+	if !(bytes.Equal(issuer.RawSubject, certificate.RawIssuer)) {
+		return false
+	}
+
+	e := issuer.CheckSignature(certificate.SignatureAlgorithm, certificate.RawTBSCertificate, certificate.Signature)
+
+	var insecure x509.InsecureAlgorithmError
+
+	return e == nil || errors.As(e, &insecure)
+}
+
+// size returns the certificate's public key size in bits, else zero for an unrecognized key type.
+func size(certificate *x509.Certificate) int {
+	switch key := certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return 256
+	}
+
+	return 0
+}
+
+// fingerprint returns the hex-encoded SHA-256 digest of the certificate.
+func fingerprint(certificate *x509.Certificate) string {
+	digest := sha256.Sum256(certificate.Raw)
+
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,221 @@
+package chain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// authority represents a generated certificate alongside its private key.
+type authority struct {
+	certificate *x509.Certificate
+	key         crypto.Signer
+}
+
+// generate issues a certificate named by the common name, signed by the parent; a nil parent self-signs it.
+func generate(t *testing.T, name string, parent *authority, ca bool, key crypto.Signer, notbefore, notafter time.Time) *authority {
+	t.Helper()
+
+	if key == nil {
+		var e error
+		if key, e = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notbefore,
+		NotAfter:              notafter,
+		BasicConstraintsValid: true,
+		IsCA:                  ca,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	if !(ca) {
+		template.DNSNames = []string{name}
+	}
+
+	signer, issuer := key, template
+	if parent != nil {
+		signer, issuer = parent.key, parent.certificate
+	}
+
+	raw, e := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), signer)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	certificate, e := x509.ParseCertificate(raw)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	return &authority{certificate: certificate, key: key}
+}
+
+// codes returns the issue code(s) of the link.
+func codes(link Link) map[string]bool {
+	mapping := make(map[string]bool)
+	for _, issue := range link.Issues {
+		mapping[issue.Code] = true
+	}
+
+	return mapping
+}
+
+func TestAnalyze(t *testing.T) {
+	before, after := now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0)
+
+	root := generate(t, "Root", nil, true, nil, before, after.AddDate(10, 0, 0))
+	intermediate := generate(t, "Intermediate", root, true, nil, before, after)
+	leaf := generate(t, "service.test", intermediate, false, nil, before, after)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.certificate)
+
+	t.Run("Valid", func(t *testing.T) {
+		report := Analyze("service.test", []*x509.Certificate{leaf.certificate, intermediate.certificate}, roots, now)
+
+		if !(report.Trusted) || !(report.HostnameMatch) || len(report.Issues) != 0 {
+			t.Fatalf("Unexpected Report: %+v", report)
+		}
+
+		if len(report.Paths) != 1 || len(report.Paths[0]) != 3 {
+			t.Errorf("Unexpected Path(s): %v", report.Paths)
+		}
+
+		if v := report.Certificates[0].IssuedBy; v == nil || *v != 1 {
+			t.Errorf("Unexpected Leaf Issuer Position: %v", v)
+		}
+
+		if report.Roots != "caller" {
+			t.Errorf("Unexpected Roots Source: %s", report.Roots)
+		}
+	})
+
+	t.Run("Missing-Intermediate", func(t *testing.T) {
+		report := Analyze("service.test", []*x509.Certificate{leaf.certificate}, roots, now)
+
+		if report.Trusted {
+			t.Error("Expected Untrusted Chain")
+		}
+
+		if !(codes(report.Certificates[0])[MissingIntermediate]) {
+			t.Errorf("Expected %s Issue: %+v", MissingIntermediate, report.Certificates[0].Issues)
+		}
+	})
+
+	t.Run("Wrong-Order", func(t *testing.T) {
+		secondary := generate(t, "Secondary", intermediate, true, nil, before, after)
+		child := generate(t, "child.test", secondary, false, nil, before, after)
+
+		report := Analyze("child.test", []*x509.Certificate{child.certificate, intermediate.certificate, secondary.certificate}, roots, now)
+
+		if !(report.Trusted) {
+			t.Errorf("Expected Trusted Chain: %s", report.Verification)
+		}
+
+		if !(codes(report.Certificates[0])[WrongOrder]) || !(codes(report.Certificates[2])[WrongOrder]) {
+			t.Errorf("Expected %s Issue(s): %+v", WrongOrder, report.Certificates)
+		}
+
+		if codes(report.Certificates[1])[WrongOrder] {
+			t.Errorf("Unexpected %s Issue: %+v", WrongOrder, report.Certificates[1].Issues)
+		}
+	})
+
+	t.Run("Expired-Intermediate", func(t *testing.T) {
+		expired := generate(t, "Expired", root, true, nil, before.AddDate(-1, 0, 0), now.AddDate(0, 0, -1))
+		child := generate(t, "child.test", expired, false, nil, before, after)
+
+		report := Analyze("child.test", []*x509.Certificate{child.certificate, expired.certificate}, roots, now)
+
+		if report.Trusted {
+			t.Error("Expected Untrusted Chain")
+		}
+
+		issues := codes(report.Certificates[1])
+		if !(issues[Expired]) || issues[MissingIntermediate] {
+			t.Errorf("Unexpected Intermediate Issue(s): %+v", report.Certificates[1].Issues)
+		}
+	})
+
+	t.Run("Name-Mismatch", func(t *testing.T) {
+		report := Analyze("other.test", []*x509.Certificate{leaf.certificate, intermediate.certificate}, roots, now)
+
+		if !(report.Trusted) || report.HostnameMatch {
+			t.Errorf("Unexpected Report: Trusted (%v), Hostname-Match (%v)", report.Trusted, report.HostnameMatch)
+		}
+
+		if !(codes(report.Certificates[0])[NameMismatch]) {
+			t.Errorf("Expected %s Issue: %+v", NameMismatch, report.Certificates[0].Issues)
+		}
+	})
+
+	t.Run("Root-Included", func(t *testing.T) {
+		report := Analyze("service.test", []*x509.Certificate{leaf.certificate, intermediate.certificate, root.certificate}, roots, now)
+
+		if !(report.Trusted) || !(codes(report.Certificates[2])[RootIncluded]) {
+			t.Errorf("Unexpected Report: %+v", report)
+		}
+	})
+
+	t.Run("Self-Signed", func(t *testing.T) {
+		report := Analyze("Root", []*x509.Certificate{root.certificate}, x509.NewCertPool(), now)
+
+		if report.Trusted || !(codes(report.Certificates[0])[SelfSigned]) {
+			t.Errorf("Unexpected Report: %+v", report)
+		}
+	})
+
+	t.Run("Not-Authority", func(t *testing.T) {
+		constrained := generate(t, "Constrained", root, false, nil, before, after)
+		child := generate(t, "child.test", constrained, false, nil, before, after)
+
+		report := Analyze("child.test", []*x509.Certificate{child.certificate, constrained.certificate}, roots, now)
+
+		if report.Trusted || !(codes(report.Certificates[1])[NotAuthority]) {
+			t.Errorf("Unexpected Report: %+v", report)
+		}
+	})
+
+	t.Run("Weak-Key", func(t *testing.T) {
+		key, e := rsa.GenerateKey(rand.Reader, 1024)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		weak := generate(t, "weak.test", intermediate, false, key, before, after)
+
+		report := Analyze("weak.test", []*x509.Certificate{weak.certificate, intermediate.certificate}, roots, now)
+
+		if link := report.Certificates[0]; !(codes(link)[WeakKey]) || link.KeySize != 1024 {
+			t.Errorf("Unexpected Leaf: %+v", link)
+		}
+	})
+}
+
+func TestStrength(t *testing.T) {
+	certificate := &x509.Certificate{SignatureAlgorithm: x509.SHA1WithRSA, RawSubject: []byte("subject"), RawIssuer: []byte("issuer")}
+
+	if issues := strength(certificate); len(issues) != 1 || issues[0].Code != WeakSignature {
+		t.Errorf("Unexpected Issue(s): %+v", issues)
+	}
+
+	certificate.SignatureAlgorithm = x509.ECDSAWithSHA256
+	if issues := strength(certificate); len(issues) != 0 {
+		t.Errorf("Unexpected Issue(s): %+v", issues)
+	}
+}
@@ -0,0 +1,4 @@
+// Package chain evaluates a server's presented certificate chain: its trust path to the system's -- or a
+// caller-supplied -- root(s), and per-certificate issue(s) such as missing intermediates, out-of-order certificates,
+// expired intermediates, hostname mismatches, and weak signatures or keys.
+package chain
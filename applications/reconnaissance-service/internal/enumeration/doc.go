@@ -0,0 +1,8 @@
+// Package enumeration determines which TLS protocol version(s), cipher suite(s) and ALPN protocol(s) an endpoint
+// accepts via controlled handshake(s) (see the probe package), alongside the server's cipher suite preference order,
+// and grades the configuration.
+//
+// TLS 1.3 cipher suite(s) aren't configurable via [crypto/tls], such that only the suite negotiated by TLS 1.3 is
+// reported; every TLS 1.3 suite is an AEAD providing forward secrecy. Suite(s) beyond those [crypto/tls] implements --
+// e.g. NULL, EXPORT, or DHE key exchange(s) -- and SSL 3.0 can't be evaluated.
+package enumeration
@@ -0,0 +1,211 @@
+package enumeration
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+
+	"reconnaissance-service/internal/chain"
+	"reconnaissance-service/internal/probe"
+)
+
+// ErrUnreachable is returned by [Enumerate] when a TCP connection to the address can't be established.
+var ErrUnreachable = errors.New("address unreachable")
+
+// parallelism represents the maximum number of concurrent handshake(s) while enumerating a version's cipher suite(s).
+const parallelism = 8
+
+// versions represents the evaluated protocol version(s), oldest first.
+var versions = []uint16{tls.VersionTLS10, tls.VersionTLS11, tls.VersionTLS12, tls.VersionTLS13}
+
+// protocols represents the evaluated ALPN protocol(s).
+var protocols = []string{"h2", "http/1.1", "http/1.0"}
+
+// Protocol represents a single protocol version's evaluation.
+type Protocol struct {
+	Version          string  `json:"version"`                     // Version represents the protocol version's name, e.g. "TLS 1.2".
+	Supported        bool    `json:"supported"`                   // Supported represents whether a handshake of the version completed.
+	Suites           []Suite `json:"suites,omitempty"`            // Suites represents the accepted cipher suite(s), in the order the server selects them.
+	ServerPreference *bool   `json:"server-preference,omitempty"` // ServerPreference represents whether the server selects by its own order rather than the client's; absent when fewer than two suites are accepted.
+}
+
+// Report represents an endpoint's enumerated TLS configuration.
+type Report struct {
+	Address   string        `json:"address"`            // Address represents the evaluated host:port.
+	Protocols []Protocol    `json:"protocols"`          // Protocols represents each evaluated protocol version, oldest first.
+	ALPN      []string      `json:"alpn"`               // ALPN represents the accepted application protocol(s).
+	Grade     string        `json:"grade"`              // Grade represents the configuration's grade; one of "A+", "A", "B", "C" or "F".
+	Findings  []chain.Issue `json:"findings,omitempty"` // Findings represents the issue(s) determining the grade.
+}
+
+// Supported reports whether the report's protocol version completed a handshake.
+func (r *Report) Supported(version uint16) bool {
+	for _, protocol := range r.Protocols {
+		if protocol.Version == tls.VersionName(version) {
+			return protocol.Supported
+		}
+	}
+
+	return false
+}
+
+// Enumerate evaluates the protocol version(s), cipher suite(s) and ALPN protocol(s) the address accepts, presenting the
+// server name via SNI. An unreachable address returns an error wrapping [ErrUnreachable].
+func Enumerate(ctx context.Context, address string, servername string) (Report, error) {
+	report := Report{Address: address, ALPN: []string{}}
+
+	// --> distinguish an unreachable address from one that accepts none of the evaluated version(s)
+	if _, e := handshake(ctx, address, servername, tls.VersionTLS10, tls.VersionTLS13, all(), nil); e != nil && dial(e) {
+		return report, fmt.Errorf("%w: %w", ErrUnreachable, e)
+	}
+
+	for _, version := range versions {
+		protocol, e := evaluate(ctx, address, servername, version)
+		if e != nil {
+			return report, e
+		}
+
+		report.Protocols = append(report.Protocols, protocol)
+	}
+
+	for _, name := range protocols {
+		state, e := handshake(ctx, address, servername, tls.VersionTLS10, tls.VersionTLS13, all(), []string{name})
+		if e == nil && state.NegotiatedProtocol == name {
+			report.ALPN = append(report.ALPN, name)
+		}
+	}
+
+	report.Grade, report.Findings = grade(&report)
+
+	return report, ctx.Err()
+}
+
+// evaluate determines whether the version is supported and, prior to TLS 1.3, which of its cipher suite(s) are
+// accepted and in what order the server selects them.
+func evaluate(ctx context.Context, address string, servername string, version uint16) (Protocol, error) {
+	protocol := Protocol{Version: tls.VersionName(version)}
+
+	if version == tls.VersionTLS13 { // --> TLS 1.3 suite(s) can't be configured; report the negotiated suite
+		state, e := handshake(ctx, address, servername, version, version, nil, nil)
+		if e == nil {
+			protocol.Supported = true
+			if suite := lookup(state.CipherSuite); suite != nil {
+				protocol.Suites = []Suite{describe(suite)}
+			}
+		}
+
+		return protocol, ctx.Err()
+	}
+
+	suites := candidates(version)
+
+	identifiers := make([]uint16, len(suites))
+	for index, suite := range suites {
+		identifiers[index] = suite.ID
+	}
+
+	if _, e := handshake(ctx, address, servername, version, version, identifiers, nil); e != nil {
+		return protocol, ctx.Err()
+	}
+
+	protocol.Supported = true
+
+	// --> each suite is offered alone; the accepted subset retains the candidate order
+	accepted := make([]bool, len(suites))
+	semaphore := make(chan struct{}, parallelism)
+
+	var group sync.WaitGroup
+	for index, suite := range suites {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			state, e := handshake(ctx, address, servername, version, version, []uint16{suite.ID}, nil)
+			accepted[index] = e == nil && state.CipherSuite == suite.ID
+		}()
+	}
+
+	group.Wait()
+
+	if e := ctx.Err(); e != nil {
+		return protocol, e
+	}
+
+	var remaining []uint16
+	for index, suite := range suites {
+		if accepted[index] {
+			remaining = append(remaining, suite.ID)
+		}
+	}
+
+	if len(remaining) >= 2 {
+		forward, e := handshake(ctx, address, servername, version, version, remaining, nil)
+		reverse, exception := handshake(ctx, address, servername, version, version, reversed(remaining), nil)
+		if e == nil && exception == nil {
+			preference := forward.CipherSuite == reverse.CipherSuite
+			protocol.ServerPreference = &preference
+		}
+	}
+
+	// --> the server's selection order: repeatedly offer the remaining suite(s), removing the selected one
+	for len(remaining) > 0 {
+		selected := remaining[0]
+		if state, e := handshake(ctx, address, servername, version, version, remaining, nil); e == nil && slices.Contains(remaining, state.CipherSuite) {
+			selected = state.CipherSuite
+		}
+
+		protocol.Suites = append(protocol.Suites, describe(lookup(selected)))
+		remaining = slices.DeleteFunc(remaining, func(id uint16) bool { return id == selected })
+	}
+
+	return protocol, ctx.Err()
+}
+
+// handshake establishes a handshake restricted to the version range, cipher suite(s) and ALPN protocol(s).
+func handshake(ctx context.Context, address string, servername string, minimum, maximum uint16, suites []uint16, protocols []string) (tls.ConnectionState, error) {
+	return probe.Handshake(ctx, address, &tls.Config{
+		ServerName:   servername,
+		MinVersion:   minimum,
+		MaxVersion:   maximum,
+		CipherSuites: suites,
+		NextProtos:   protocols,
+	})
+}
+
+// all returns the identifier of every pre-TLS 1.3 cipher suite [crypto/tls] implements.
+func all() []uint16 {
+	var identifiers []uint16
+	for _, suite := range candidates(tls.VersionTLS10) {
+		identifiers = append(identifiers, suite.ID)
+	}
+
+	for _, suite := range candidates(tls.VersionTLS12) {
+		if !(slices.Contains(identifiers, suite.ID)) {
+			identifiers = append(identifiers, suite.ID)
+		}
+	}
+
+	return identifiers
+}
+
+// reversed returns a reversed copy of the identifiers.
+func reversed(identifiers []uint16) []uint16 {
+	copied := slices.Clone(identifiers)
+	slices.Reverse(copied)
+
+	return copied
+}
+
+// dial reports whether the error occurred establishing the TCP connection, rather than during the handshake.
+func dial(e error) bool {
+	var exception *net.OpError
+
+	return errors.As(e, &exception) && exception.Op == "dial"
+}
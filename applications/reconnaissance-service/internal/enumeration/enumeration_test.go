@@ -0,0 +1,168 @@
+package enumeration
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"reconnaissance-service/internal/chain"
+)
+
+// start returns a started TLS server of the configuration alongside its address.
+func start(t *testing.T, configuration *tls.Config) string {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.NotFoundHandler())
+	server.TLS = configuration
+	server.Config.ErrorLog = log.New(io.Discard, "", 0) // --> rejected handshake(s) are expected
+	server.StartTLS()
+
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "https://")
+}
+
+// codes returns the finding code(s) of the report.
+func codes(report Report) []string {
+	var values []string
+	for _, finding := range report.Findings {
+		values = append(values, finding.Code)
+	}
+
+	return values
+}
+
+// names returns the cipher suite name(s) of the protocol.
+func names(protocol Protocol) []string {
+	var values []string
+	for _, suite := range protocol.Suites {
+		values = append(values, suite.Name)
+	}
+
+	return values
+}
+
+func TestEnumerate(t *testing.T) {
+	t.Run("Modern", func(t *testing.T) {
+		address := start(t, &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			NextProtos:   []string{"h2", "http/1.1"},
+		})
+
+		report, e := Enumerate(context.Background(), address, "example.com")
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		t.Logf("Report: %+v", report)
+
+		if report.Supported(tls.VersionTLS10) || report.Supported(tls.VersionTLS11) || !(report.Supported(tls.VersionTLS12)) || !(report.Supported(tls.VersionTLS13)) {
+			t.Errorf("Unexpected Protocol(s): %+v", report.Protocols)
+		}
+
+		suites := names(report.Protocols[2])
+		slices.Sort(suites)
+		if !(slices.Equal(suites, []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"})) {
+			t.Errorf("Unexpected TLS 1.2 Suite(s): %v", suites)
+		}
+
+		if report.Protocols[2].ServerPreference == nil {
+			t.Error("Expected TLS 1.2 Server Preference Evaluation")
+		}
+
+		if len(report.Protocols[3].Suites) != 1 || !(report.Protocols[3].Suites[0].AEAD) {
+			t.Errorf("Unexpected TLS 1.3 Suite(s): %+v", report.Protocols[3].Suites)
+		}
+
+		if !(slices.Equal(report.ALPN, []string{"h2", "http/1.1"})) {
+			t.Errorf("Unexpected ALPN Protocol(s): %v", report.ALPN)
+		}
+
+		if report.Grade != "A+" {
+			t.Errorf("Expected Grade (A+), Received (%s): %+v", report.Grade, report.Findings)
+		}
+	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		address := start(t, &tls.Config{
+			MinVersion: tls.VersionTLS10,
+			MaxVersion: tls.VersionTLS12,
+			CipherSuites: []uint16{
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+				tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+				tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
+			},
+			NextProtos: []string{"http/1.1"},
+		})
+
+		report, e := Enumerate(context.Background(), address, "example.com")
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		t.Logf("Report: %+v", report)
+
+		if !(report.Supported(tls.VersionTLS10)) || !(report.Supported(tls.VersionTLS12)) || report.Supported(tls.VersionTLS13) {
+			t.Errorf("Unexpected Protocol(s): %+v", report.Protocols)
+		}
+
+		if suites := names(report.Protocols[0]); len(suites) != 3 || slices.Contains(suites, "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256") {
+			t.Errorf("Unexpected TLS 1.0 Suite(s): %v", suites)
+		}
+
+		if suites := names(report.Protocols[2]); len(suites) != 4 {
+			t.Errorf("Unexpected TLS 1.2 Suite(s): %v", suites)
+		}
+
+		if !(slices.Equal(report.ALPN, []string{"http/1.1"})) {
+			t.Errorf("Unexpected ALPN Protocol(s): %v", report.ALPN)
+		}
+
+		for _, code := range []string{Legacy, Outdated, InsecureSuite, NoForward, NonAEAD} {
+			if !(slices.Contains(codes(report), code)) {
+				t.Errorf("Expected %s Finding: %v", code, codes(report))
+			}
+		}
+
+		if report.Grade != "C" {
+			t.Errorf("Expected Grade (C), Received (%s)", report.Grade)
+		}
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		listener, e := net.Listen("tcp", "127.0.0.1:0")
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		address := listener.Addr().String()
+		listener.Close()
+
+		if _, e := Enumerate(context.Background(), address, "example.com"); !(errors.Is(e, ErrUnreachable)) {
+			t.Errorf("Expected %v, Received %v", ErrUnreachable, e)
+		}
+	})
+}
+
+func TestGrade(t *testing.T) {
+	report := &Report{Protocols: []Protocol{
+		{Version: "TLS 1.0", Supported: true},
+		{Version: "TLS 1.1"},
+		{Version: "TLS 1.2"},
+		{Version: "TLS 1.3"},
+	}}
+
+	if value, findings := grade(report); value != "F" || findings[0].Code != Unsupported || findings[0].Severity != chain.Error {
+		t.Errorf("Unexpected Grade (%s): %+v", value, findings)
+	}
+}
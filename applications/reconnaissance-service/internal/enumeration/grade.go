@@ -0,0 +1,97 @@
+package enumeration
+
+import (
+	"crypto/tls"
+	"fmt"
+	"slices"
+	"strings"
+
+	"reconnaissance-service/internal/chain"
+)
+
+// Finding code(s).
+const (
+	Unsupported     = "modern-protocol-unsupported" // Unsupported represents an endpoint accepting neither TLS 1.2 nor TLS 1.3.
+	Legacy          = "legacy-protocol"             // Legacy represents an accepted, deprecated protocol version (RFC 8996).
+	Outdated        = "tls13-unsupported"           // Outdated represents an endpoint not accepting TLS 1.3.
+	InsecureSuite   = "insecure-suite"              // InsecureSuite represents an accepted cipher suite with known security issue(s).
+	NoForward       = "no-forward-secrecy"          // NoForward represents an accepted cipher suite using a static RSA key exchange.
+	NonAEAD         = "non-aead-suite"              // NonAEAD represents an accepted CBC-mode cipher suite.
+	ClientPreferred = "client-preference"           // ClientPreferred represents a server honoring the client's cipher suite order.
+)
+
+// grades represents the possible grade(s), best first.
+var grades = []string{"A+", "A", "B", "C", "F"}
+
+// grade evaluates the report, returning its grade alongside its finding(s). Each finding other than [ClientPreferred]
+// caps the grade: no modern protocol at F; an insecure suite at C; a legacy protocol or static RSA key exchange at B; and the
+// absence of TLS 1.3 or a CBC-mode suite at A.
+func grade(report *Report) (string, []chain.Issue) {
+	result := grades[0]
+
+	var findings []chain.Issue
+
+	lower := func(grade string, finding chain.Issue) {
+		if slices.Index(grades, grade) > slices.Index(grades, result) {
+			result = grade
+		}
+
+		findings = append(findings, finding)
+	}
+
+	if !(report.Supported(tls.VersionTLS12)) && !(report.Supported(tls.VersionTLS13)) {
+		lower("F", chain.Issue{Code: Unsupported, Severity: chain.Error, Message: "Neither TLS 1.2 nor TLS 1.3 is accepted."})
+	}
+
+	for _, version := range []uint16{tls.VersionTLS10, tls.VersionTLS11} {
+		if report.Supported(version) {
+			lower("B", chain.Issue{Code: Legacy, Severity: chain.Warning, Message: fmt.Sprintf("%s is accepted; it's deprecated (RFC 8996).", tls.VersionName(version))})
+		}
+	}
+
+	if !(report.Supported(tls.VersionTLS13)) {
+		lower("A", chain.Issue{Code: Outdated, Severity: chain.Info, Message: "TLS 1.3 isn't accepted."})
+	}
+
+	var insecure, static, cbc []string
+
+	add := func(names *[]string, name string) {
+		if !(slices.Contains(*names, name)) {
+			*names = append(*names, name)
+		}
+	}
+
+	for _, protocol := range report.Protocols {
+		for _, suite := range protocol.Suites {
+			if suite.Insecure {
+				add(&insecure, suite.Name)
+			}
+
+			if !(suite.ForwardSecrecy) {
+				add(&static, suite.Name)
+			}
+
+			if !(suite.AEAD) {
+				add(&cbc, suite.Name)
+			}
+		}
+
+		if protocol.ServerPreference != nil && !(*protocol.ServerPreference) {
+			findings = append(findings, chain.Issue{Code: ClientPreferred, Severity: chain.Info, Message: fmt.Sprintf("The server honors the client's cipher suite order for %s.", protocol.Version)})
+		}
+	}
+
+	if len(insecure) > 0 {
+		lower("C", chain.Issue{Code: InsecureSuite, Severity: chain.Error, Message: "Insecure cipher suite(s) are accepted: " + strings.Join(insecure, ", ") + "."})
+	}
+
+	if len(static) > 0 {
+		lower("B", chain.Issue{Code: NoForward, Severity: chain.Warning, Message: "Cipher suite(s) without forward secrecy are accepted: " + strings.Join(static, ", ") + "."})
+	}
+
+	if len(cbc) > 0 {
+		lower("A", chain.Issue{Code: NonAEAD, Severity: chain.Info, Message: "CBC-mode cipher suite(s) are accepted: " + strings.Join(cbc, ", ") + "."})
+	}
+
+	return result, findings
+}
@@ -0,0 +1,57 @@
+package enumeration
+
+import (
+	"crypto/tls"
+	"slices"
+	"strings"
+)
+
+// Suite represents an accepted cipher suite.
+type Suite struct {
+	ID             uint16 `json:"id"`              // ID represents the suite's IANA identifier.
+	Name           string `json:"name"`            // Name represents the suite's IANA name.
+	Insecure       bool   `json:"insecure"`        // Insecure represents a suite with known security issue(s), e.g. RC4 or 3DES.
+	ForwardSecrecy bool   `json:"forward-secrecy"` // ForwardSecrecy represents a suite using an ephemeral key exchange.
+	AEAD           bool   `json:"aead"`            // AEAD represents a suite using authenticated encryption, rather than CBC mode or a stream cipher.
+}
+
+// describe returns the suite's [Suite].
+func describe(suite *tls.CipherSuite) Suite {
+	name := suite.Name
+
+	return Suite{
+		ID:             suite.ID,
+		Name:           name,
+		Insecure:       suite.Insecure,
+		ForwardSecrecy: strings.HasPrefix(name, "TLS_ECDHE_") || tls13(suite),
+		AEAD:           strings.Contains(name, "_GCM_") || strings.Contains(name, "CHACHA20_POLY1305") || tls13(suite),
+	}
+}
+
+// tls13 reports whether the suite is exclusive to TLS 1.3.
+func tls13(suite *tls.CipherSuite) bool {
+	return len(suite.SupportedVersions) == 1 && suite.SupportedVersions[0] == tls.VersionTLS13
+}
+
+// candidates returns every cipher suite [crypto/tls] implements for the (pre-TLS 1.3) version, secure suite(s) first.
+func candidates(version uint16) []*tls.CipherSuite {
+	var suites []*tls.CipherSuite
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		if slices.Contains(suite.SupportedVersions, version) && !(tls13(suite)) {
+			suites = append(suites, suite)
+		}
+	}
+
+	return suites
+}
+
+// lookup returns the [crypto/tls] cipher suite identified by the id, else nil.
+func lookup(id uint16) *tls.CipherSuite {
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		if suite.ID == id {
+			return suite
+		}
+	}
+
+	return nil
+}
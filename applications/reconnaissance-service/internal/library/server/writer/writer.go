@@ -12,6 +12,8 @@ type Writer struct {
 
 	status int
 	buffer bytes.Buffer
+
+	flushed bool // flushed is true once the status and header(s) have been written to the underlying writer.
 }
 
 func Handle(next http.Handler) http.Handler {
@@ -40,11 +42,31 @@ func (w *Writer) Write(bytes []byte) (int, error) {
 }
 
 func (w *Writer) WriteHeader(status int) {
+	if w.flushed {
+		return
+	}
+
 	w.status = status
 }
 
+// Flush writes the buffered response to the underlying writer and flushes it to the client, enabling streamed
+// response(s). The status and header(s) can't be changed after the first call.
+func (w *Writer) Flush() {
+	if !(w.flushed) && w.status >= 100 {
+		w.w.WriteHeader(w.status)
+	}
+
+	w.flushed = true
+
+	io.Copy(w.w, &w.buffer)
+
+	if flusher, ok := w.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 func (w *Writer) Done() (int64, error) {
-	if w.status >= 100 {
+	if !(w.flushed) && w.status >= 100 {
 		w.w.WriteHeader(w.status)
 	}
 
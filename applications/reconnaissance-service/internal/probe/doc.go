@@ -0,0 +1,4 @@
+// Package probe establishes the bounded TLS handshake(s) the reconnaissance endpoint(s) inspect. A handshake never
+// verifies the peer, such that an untrusted, expired or misconfigured endpoint can still be evaluated; evaluating trust
+// is left to the caller (see the chain package).
+package probe
@@ -0,0 +1,66 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"time"
+)
+
+// Timeout returns the duration bounding a single handshake, including its TCP connection. Configured via the
+// PROBE_TIMEOUT environment variable (a [time.ParseDuration] string); defaults to 10 seconds.
+func Timeout() time.Duration {
+	const fallback = 10 * time.Second
+
+	value := os.Getenv("PROBE_TIMEOUT")
+	if value == "" {
+		return fallback
+	}
+
+	duration, e := time.ParseDuration(value)
+	if e != nil || duration <= 0 {
+		slog.Warn("Invalid PROBE_TIMEOUT Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", fallback))
+
+		return fallback
+	}
+
+	return duration
+}
+
+// Handshake establishes a TLS connection to the address, bounded by the context and the [Timeout], returning its
+// state once the handshake completes; the connection is closed prior to returning. The configuration is cloned and
+// never verifies the peer; absent a server name, the address's host is used for SNI.
+func Handshake(ctx context.Context, address string, configuration *tls.Config) (tls.ConnectionState, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout())
+	defer cancel()
+
+	if configuration == nil {
+		configuration = &tls.Config{}
+	}
+
+	configuration = configuration.Clone()
+	configuration.InsecureSkipVerify = true // --> trust is evaluated by the caller against the presented certificate(s)
+
+	if configuration.ServerName == "" {
+		host, _, e := net.SplitHostPort(address)
+		if e != nil {
+			return tls.ConnectionState{}, fmt.Errorf("invalid address %q: %w", address, e)
+		}
+
+		configuration.ServerName = host
+	}
+
+	dialer := &tls.Dialer{Config: configuration}
+
+	connection, e := dialer.DialContext(ctx, "tcp", address)
+	if e != nil {
+		return tls.ConnectionState{}, e
+	}
+
+	defer connection.Close()
+
+	return connection.(*tls.Conn).ConnectionState(), nil
+}
@@ -0,0 +1,53 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandshake(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+
+	defer server.Close()
+
+	address := strings.TrimPrefix(server.URL, "https://")
+
+	state, e := Handshake(context.Background(), address, nil)
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	if !(state.HandshakeComplete) || len(state.PeerCertificates) == 0 {
+		t.Errorf("Unexpected Connection State: %+v", state)
+	}
+
+	if _, e := Handshake(context.Background(), address, &tls.Config{MaxVersion: tls.VersionTLS12, CipherSuites: []uint16{tls.TLS_RSA_WITH_RC4_128_SHA}}); e == nil {
+		t.Error("Expected Handshake Failure for an Unsupported Cipher Suite")
+	}
+
+	if _, e := Handshake(context.Background(), "invalid", nil); e == nil {
+		t.Error("Expected Error for an Invalid Address")
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	t.Setenv("PROBE_TIMEOUT", "")
+	if v := Timeout(); v != 10*time.Second {
+		t.Errorf("Unexpected Default Timeout: %s", v)
+	}
+
+	t.Setenv("PROBE_TIMEOUT", "2s")
+	if v := Timeout(); v != 2*time.Second {
+		t.Errorf("Unexpected Timeout: %s", v)
+	}
+
+	t.Setenv("PROBE_TIMEOUT", "-1s")
+	if v := Timeout(); v != 10*time.Second {
+		t.Errorf("Unexpected Fallback Timeout: %s", v)
+	}
+}
@@ -0,0 +1,3 @@
+// Package scan probes batches of host:port target(s) concurrently through a bounded worker pool, evaluating each
+// target's presented certificate chain (see the chain package) and expiration.
+package scan
@@ -0,0 +1,187 @@
+package scan
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"reconnaissance-service/internal/chain"
+	"reconnaissance-service/internal/probe"
+)
+
+// Result status(es).
+const (
+	Succeeded = "succeeded" // Succeeded represents a target whose handshake completed.
+	Failed    = "failed"    // Failed represents a target that couldn't be resolved, connected to, or handshaked with.
+	Skipped   = "skipped"   // Skipped represents a target the scan's cancellation prevented from being probed.
+)
+
+// Horizon represents the remaining validity period below which a certificate is counted as expiring.
+const Horizon = 30 * 24 * time.Hour
+
+// Result represents a single target's outcome.
+type Result struct {
+	Target        string        `json:"target"`                   // Target represents the host:port as requested.
+	Status        string        `json:"status"`                   // Status represents the target's outcome.
+	Error         string        `json:"error,omitempty"`          // Error describes a failed or skipped target.
+	Duration      int64         `json:"duration-ms"`              // Duration represents the target's probe duration, in milliseconds.
+	TLSVersion    string        `json:"tls-version,omitempty"`    // TLSVersion represents the negotiated protocol version.
+	CipherSuite   string        `json:"cipher-suite,omitempty"`   // CipherSuite represents the negotiated cipher suite.
+	Subject       string        `json:"subject,omitempty"`        // Subject represents the leaf certificate's distinguished name.
+	Issuer        string        `json:"issuer,omitempty"`         // Issuer represents the leaf certificate's issuer's distinguished name.
+	Expiration    *time.Time    `json:"expiration,omitempty"`     // Expiration represents the leaf certificate's expiration.
+	Remaining     *float64      `json:"days-remaining,omitempty"` // Remaining represents the days until the leaf certificate's expiration; negative once expired.
+	Trusted       bool          `json:"trusted"`                  // Trusted represents whether the chain verifies against the system's roots.
+	HostnameMatch bool          `json:"hostname-match"`           // HostnameMatch represents whether the leaf certificate is valid for the target's host.
+	Issues        []chain.Issue `json:"issues,omitempty"`         // Issues represents the chain's finding(s); see [chain.Report].
+}
+
+// Summary represents a scan's aggregate outcome.
+type Summary struct {
+	Total     int            `json:"total"`       // Total represents the number of requested target(s).
+	Statuses  map[string]int `json:"statuses"`    // Statuses counts the target(s) by status.
+	Untrusted int            `json:"untrusted"`   // Untrusted counts the succeeded target(s) whose chain isn't trusted, or doesn't match the host.
+	Expiring  int            `json:"expiring"`    // Expiring counts the succeeded target(s) whose certificate expires within the [Horizon], including expired certificate(s).
+	Duration  int64          `json:"duration-ms"` // Duration represents the scan's duration, in milliseconds.
+	Complete  bool           `json:"complete"`    // Complete is false when cancellation skipped at least one target.
+}
+
+// Concurrency returns the default number of target(s) probed concurrently. Configured via the SCAN_CONCURRENCY
+// environment variable; defaults to 16.
+func Concurrency() int {
+	const fallback = 16
+
+	value := os.Getenv("SCAN_CONCURRENCY")
+	if value == "" {
+		return fallback
+	}
+
+	concurrency, e := strconv.Atoi(value)
+	if e != nil || concurrency <= 0 {
+		slog.Warn("Invalid SCAN_CONCURRENCY Environment Variable - Using Default", slog.String("value", value), slog.Int("default", fallback))
+
+		return fallback
+	}
+
+	return concurrency
+}
+
+// Scan probes the target(s) via the concurrency-bounded worker pool, each bounded by the timeout, and calls emit with
+// each [Result] as it completes -- from the calling goroutine, such that emit needn't be safe for concurrent use. Once
+// the context is canceled, the remaining target(s) are emitted as [Skipped].
+func Scan(ctx context.Context, targets []string, concurrency int, timeout time.Duration, emit func(Result)) Summary {
+	start := time.Now()
+
+	summary := Summary{Total: len(targets), Statuses: make(map[string]int), Complete: true}
+
+	concurrency = max(1, min(concurrency, len(targets)))
+
+	jobs := make(chan string)
+	results := make(chan Result)
+
+	var group sync.WaitGroup
+	for range concurrency {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+
+			for target := range jobs {
+				results <- Probe(ctx, target, timeout)
+			}
+		}()
+	}
+
+	go func() {
+		for _, target := range targets {
+			jobs <- target
+		}
+
+		close(jobs)
+
+		group.Wait()
+
+		close(results)
+	}()
+
+	for result := range results {
+		summary.Statuses[result.Status]++
+
+		switch result.Status {
+		case Skipped:
+			summary.Complete = false
+		case Succeeded:
+			if !(result.Trusted) || !(result.HostnameMatch) {
+				summary.Untrusted++
+			}
+
+			if *result.Remaining < Horizon.Hours()/24 {
+				summary.Expiring++
+			}
+		}
+
+		emit(result)
+	}
+
+	summary.Duration = time.Since(start).Milliseconds()
+
+	return summary
+}
+
+// Probe handshakes with the host:port target, bounded by the timeout, and evaluates its certificate chain against
+// the system's roots.
+func Probe(ctx context.Context, target string, timeout time.Duration) Result {
+	start := time.Now()
+
+	result := Result{Target: target}
+
+	if e := ctx.Err(); e != nil {
+		result.Status, result.Error = Skipped, e.Error()
+		return result
+	}
+
+	hostname, _, e := net.SplitHostPort(target)
+	if e != nil {
+		result.Status, result.Error = Failed, e.Error()
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state, e := probe.Handshake(ctx, target, &tls.Config{ServerName: hostname})
+
+	result.Duration = time.Since(start).Milliseconds()
+
+	switch {
+	case e != nil:
+		result.Status, result.Error = Failed, e.Error()
+		return result
+	case len(state.PeerCertificates) == 0:
+		result.Status, result.Error = Failed, fmt.Sprintf("no peer certificates found for address %s", target)
+		return result
+	}
+
+	now := time.Now()
+	leaf := state.PeerCertificates[0]
+	report := chain.Analyze(hostname, state.PeerCertificates, nil, now)
+	remaining := leaf.NotAfter.Sub(now).Hours() / 24
+
+	result.Status = Succeeded
+	result.TLSVersion = tls.VersionName(state.Version)
+	result.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	result.Subject = leaf.Subject.String()
+	result.Issuer = leaf.Issuer.String()
+	result.Expiration = &leaf.NotAfter
+	result.Remaining = &remaining
+	result.Trusted = report.Trusted
+	result.HostnameMatch = report.HostnameMatch
+	result.Issues = report.Issues
+
+	return result
+}
@@ -0,0 +1,144 @@
+package scan
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+
+	defer server.Close()
+
+	address := strings.TrimPrefix(server.URL, "https://")
+
+	// --> a closed listener's address refuses connection(s)
+	listener, e := net.Listen("tcp", "127.0.0.1:0")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	refused := listener.Addr().String()
+	listener.Close()
+
+	targets := []string{address, address, refused, "invalid"}
+
+	var results []Result
+	summary := Scan(context.Background(), targets, 2, time.Second, func(result Result) {
+		results = append(results, result)
+	})
+
+	if len(results) != len(targets) {
+		t.Fatalf("Unexpected Result Count: %d", len(results))
+	}
+
+	if summary.Total != 4 || summary.Statuses[Succeeded] != 2 || summary.Statuses[Failed] != 2 || !(summary.Complete) {
+		t.Errorf("Unexpected Summary: %+v", summary)
+	}
+
+	// --> the httptest certificate is self-signed, and valid for "example.com" rather than 127.0.0.1's hostname
+	if summary.Untrusted != 2 {
+		t.Errorf("Unexpected Untrusted Count: %d", summary.Untrusted)
+	}
+
+	for _, result := range results {
+		if result.Status == Succeeded && (result.Expiration == nil || result.Remaining == nil || result.TLSVersion == "") {
+			t.Errorf("Incomplete Result: %+v", result)
+		}
+	}
+}
+
+func TestScanConcurrency(t *testing.T) {
+	// --> a listener that accepts, though never handshakes, such that each probe holds its connection until timeout
+	listener, e := net.Listen("tcp", "127.0.0.1:0")
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	defer listener.Close()
+
+	var current, peak atomic.Int64
+	var group sync.WaitGroup
+
+	go func() {
+		for {
+			connection, e := listener.Accept()
+			if e != nil {
+				return
+			}
+
+			group.Add(1)
+			go func() {
+				defer group.Done()
+				defer connection.Close()
+
+				n := current.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+
+				connection.Read(make([]byte, 1024)) // --> the client hello
+				time.Sleep(50 * time.Millisecond)
+				current.Add(-1)
+
+				connection.Read(make([]byte, 1)) // --> block until the probe's timeout closes the connection
+			}()
+		}
+	}()
+
+	targets := make([]string, 6)
+	for index := range targets {
+		targets[index] = listener.Addr().String()
+	}
+
+	summary := Scan(context.Background(), targets, 2, 150*time.Millisecond, func(Result) {})
+
+	if summary.Statuses[Failed] != len(targets) {
+		t.Errorf("Unexpected Summary: %+v", summary)
+	}
+
+	if v := peak.Load(); v > 2 {
+		t.Errorf("Peak Concurrency (%d) Exceeded the Bound (2)", v)
+	}
+}
+
+func TestScanCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var results []Result
+	summary := Scan(ctx, []string{"example.com:443", "example.org:443"}, 4, time.Second, func(result Result) {
+		results = append(results, result)
+	})
+
+	if len(results) != 2 || summary.Statuses[Skipped] != 2 || summary.Complete {
+		t.Errorf("Unexpected Summary: %+v", summary)
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	t.Setenv("SCAN_CONCURRENCY", "")
+	if v := Concurrency(); v != 16 {
+		t.Errorf("Unexpected Default Concurrency: %d", v)
+	}
+
+	t.Setenv("SCAN_CONCURRENCY", "4")
+	if v := Concurrency(); v != 4 {
+		t.Errorf("Unexpected Concurrency: %d", v)
+	}
+
+	t.Setenv("SCAN_CONCURRENCY", "zero")
+	if v := Concurrency(); v != 16 {
+		t.Errorf("Unexpected Fallback Concurrency: %d", v)
+	}
+}
@@ -0,0 +1,113 @@
+package watchlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"reconnaissance-service/internal/scan"
+	"reconnaissance-service/models/watches"
+)
+
+// Event represents the [Alert.Event] of a certificate expiry alert.
+const Event = "certificate-expiry"
+
+// client delivers alert(s) to the webhook(s).
+var client = &http.Client{Timeout: 10 * time.Second}
+
+// Alert represents the JSON payload posted to each webhook once a watched certificate crosses a threshold.
+type Alert struct {
+	Event      string    `json:"event"`          // Event represents the alert's kind; always [Event].
+	Key        string    `json:"key"`            // Key uniquely identifies the watch, threshold & certificate, such that a receiver may discard a redelivery.
+	Text       string    `json:"text"`           // Text represents a human-readable summary, as chat webhook(s) expect.
+	Watch      int64     `json:"watch"`          // Watch represents the watch's identifier.
+	Address    string    `json:"address"`        // Address represents the watch's host:port.
+	Hostname   string    `json:"hostname"`       // Hostname represents the watch's hostname.
+	Port       int32     `json:"port"`           // Port represents the watch's port.
+	Threshold  int       `json:"threshold"`      // Threshold represents the crossed threshold, in days.
+	Remaining  float64   `json:"days-remaining"` // Remaining represents the days until the certificate's expiration; negative once expired.
+	Expiration time.Time `json:"expiration"`     // Expiration represents the leaf certificate's expiration.
+	Subject    string    `json:"subject"`        // Subject represents the leaf certificate's distinguished name.
+	Issuer     string    `json:"issuer"`         // Issuer represents the leaf certificate's issuer's distinguished name.
+}
+
+// New returns the [Alert] of the watch's succeeded scan result crossing the threshold.
+func New(watch watches.Watch, result scan.Result, threshold int) Alert {
+	address := net.JoinHostPort(watch.Hostname, strconv.Itoa(int(watch.Port)))
+
+	text := fmt.Sprintf("The certificate of %s expires in %.1f day(s), on %s.", address, *result.Remaining, result.Expiration.UTC().Format(time.RFC3339))
+	if *result.Remaining < 0 {
+		text = fmt.Sprintf("The certificate of %s expired on %s.", address, result.Expiration.UTC().Format(time.RFC3339))
+	}
+
+	return Alert{
+		Event:      Event,
+		Key:        fmt.Sprintf("%d-%d-%d", watch.ID, threshold, result.Expiration.Unix()),
+		Text:       text,
+		Watch:      watch.ID,
+		Address:    address,
+		Hostname:   watch.Hostname,
+		Port:       watch.Port,
+		Threshold:  threshold,
+		Remaining:  *result.Remaining,
+		Expiration: *result.Expiration,
+		Subject:    result.Subject,
+		Issuer:     result.Issuer,
+	}
+}
+
+// Notify logs the alert and posts it to each webhook, returning the joined error(s) of any failed delivery. A
+// delivery fails unless the webhook responds with a 2xx status.
+func Notify(ctx context.Context, webhooks []string, alert Alert) error {
+	slog.WarnContext(ctx, "Certificate Expiry Alert", slog.String("key", alert.Key), slog.String("address", alert.Address), slog.Int("threshold", alert.Threshold), slog.Float64("days-remaining", alert.Remaining))
+
+	body, e := json.Marshal(alert)
+	if e != nil {
+		return e
+	}
+
+	var exceptions []error
+	for index, webhook := range webhooks {
+		if e := deliver(ctx, webhook, body); e != nil {
+			exceptions = append(exceptions, fmt.Errorf("webhook %d: %w", index, e))
+		}
+	}
+
+	return errors.Join(exceptions...)
+}
+
+// deliver posts the JSON body to the webhook.
+func deliver(ctx context.Context, webhook string, body []byte) error {
+	request, e := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if e != nil {
+		return e
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, e := client.Do(request)
+	if exception := (*url.Error)(nil); errors.As(e, &exception) {
+		return exception.Err // --> omit the URL, which commonly embeds a secret token
+	} else if e != nil {
+		return e
+	}
+
+	defer response.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package watchlist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"reconnaissance-service/internal/scan"
+	"reconnaissance-service/models/watches"
+)
+
+func TestNew(t *testing.T) {
+	expiration := time.Date(2030, time.January, 2, 0, 0, 0, 0, time.UTC)
+	remaining := 6.5
+
+	alert := New(watches.Watch{ID: 4, Hostname: "example.com", Port: 443}, scan.Result{Expiration: &expiration, Remaining: &remaining, Subject: "CN=example.com"}, 7)
+
+	if alert.Event != Event || alert.Address != "example.com:443" || alert.Threshold != 7 || alert.Remaining != remaining {
+		t.Errorf("Unexpected Alert: %+v", alert)
+	}
+
+	if alert.Key != "4-7-1893542400" {
+		t.Errorf("Unexpected Alert Key: %s", alert.Key)
+	}
+
+	if !(strings.Contains(alert.Text, "example.com:443")) || !(strings.Contains(alert.Text, "6.5 day(s)")) {
+		t.Errorf("Unexpected Alert Text: %s", alert.Text)
+	}
+}
+
+func TestNotify(t *testing.T) {
+	var received []Alert
+
+	accepting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert Alert
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected Webhook Request: %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+
+		if e := json.NewDecoder(r.Body).Decode(&alert); e != nil {
+			t.Errorf("Unable to Decode Webhook Payload: %v", e)
+		}
+
+		received = append(received, alert)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	defer accepting.Close()
+
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	defer rejecting.Close()
+
+	alert := Alert{Event: Event, Key: "1-30-0", Address: "example.com:443", Threshold: 30}
+
+	if e := Notify(context.Background(), []string{accepting.URL}, alert); e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	if len(received) != 1 || received[0].Key != alert.Key {
+		t.Fatalf("Unexpected Webhook Payload(s): %+v", received)
+	}
+
+	e := Notify(context.Background(), []string{accepting.URL, rejecting.URL}, alert)
+	if e == nil || !(strings.Contains(e.Error(), "webhook 1")) || !(strings.Contains(e.Error(), "503")) {
+		t.Errorf("Expected Rejecting Webhook's Error, Received: %v", e)
+	}
+
+	if e := Notify(context.Background(), nil, alert); e != nil {
+		t.Errorf("Expected Alert Without Webhook(s) to be Logged, Received: %v", e)
+	}
+}
@@ -0,0 +1,4 @@
+// Package watchlist re-scans the watchlisted host:port(s) of the models/watches table at the configured interval,
+// reporting each leaf certificate's days until expiration as an OpenTelemetry gauge and alerting the configured
+// webhook(s) once a certificate crosses an expiration threshold.
+package watchlist
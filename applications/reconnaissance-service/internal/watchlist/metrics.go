@@ -0,0 +1,58 @@
+package watchlist
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"reconnaissance-service/internal/database"
+	"reconnaissance-service/models/watches"
+)
+
+// Gauge represents the name of the gauge reporting each watch's days until expiration.
+const Gauge = "tls.certificate.expiry"
+
+// Register registers the [Gauge] with the global meter provider. Each collection reads the watch(es) observed at
+// least once, such that every replica reports the whole watchlist, and a deleted watch's series ceases.
+func Register() error {
+	meter := otel.Meter("reconnaissance-service/internal/watchlist")
+
+	_, e := meter.Float64ObservableGauge(Gauge,
+		metric.WithUnit("d"),
+		metric.WithDescription("Days until the watched host's leaf certificate expires; negative once expired."),
+		metric.WithFloat64Callback(observe),
+	)
+
+	return e
+}
+
+// observe reports the days until expiration of each watch observed at least once.
+func observe(ctx context.Context, observer metric.Float64Observer) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	rows, e := watches.New().Expirations(ctx, connection)
+	if e != nil {
+		return e
+	}
+
+	now := time.Now()
+	for _, row := range rows {
+		observer.Observe(row.Expiration.Time.Sub(now).Hours()/24, metric.WithAttributes(
+			attribute.String("server.address", row.Hostname),
+			attribute.Int("server.port", int(row.Port)),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package watchlist
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"reconnaissance-service/internal/database"
+	"reconnaissance-service/internal/probe"
+	"reconnaissance-service/internal/scan"
+	"reconnaissance-service/models/watches"
+)
+
+const (
+	batch = 100         // batch represents the maximum number of watch(es) claimed per cycle.
+	poll  = time.Minute // poll represents the delay between claims of due watch(es).
+)
+
+// Interval returns the delay between a watch's scans. Configured via the WATCHLIST_INTERVAL environment variable (a
+// [time.ParseDuration] string); defaults to one hour.
+func Interval() time.Duration {
+	const fallback = time.Hour
+
+	value := os.Getenv("WATCHLIST_INTERVAL")
+	if value == "" {
+		return fallback
+	}
+
+	duration, e := time.ParseDuration(value)
+	if e != nil || duration <= 0 {
+		slog.Warn("Invalid WATCHLIST_INTERVAL Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", fallback))
+
+		return fallback
+	}
+
+	return duration
+}
+
+// Thresholds returns the alerting threshold(s), in days until expiration, in descending order. Configured via the
+// WATCHLIST_THRESHOLDS environment variable (a comma-separated list of non-negative integers); defaults to 30, 14, 7
+// and 1.
+func Thresholds() []int {
+	fallback := []int{30, 14, 7, 1}
+
+	value := os.Getenv("WATCHLIST_THRESHOLDS")
+	if value == "" {
+		return fallback
+	}
+
+	var thresholds []int
+	for _, field := range strings.Split(value, ",") {
+		threshold, e := strconv.Atoi(strings.TrimSpace(field))
+		if e != nil || threshold < 0 {
+			slog.Warn("Invalid WATCHLIST_THRESHOLDS Environment Variable - Using Default", slog.String("value", value), slog.Any("default", fallback))
+
+			return fallback
+		}
+
+		if !(slices.Contains(thresholds, threshold)) {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+
+	slices.Sort(thresholds)
+	slices.Reverse(thresholds)
+
+	return thresholds
+}
+
+// Webhooks returns the alerting webhook URL(s). Configured via the WATCHLIST_WEBHOOKS environment variable (a
+// comma-separated list of http or https URL(s)); invalid URL(s) are ignored. Absent any webhook, alert(s) are logged.
+func Webhooks() []string {
+	var webhooks []string
+	for index, field := range strings.Split(os.Getenv("WATCHLIST_WEBHOOKS"), ",") {
+		value := strings.TrimSpace(field)
+		if value == "" {
+			continue
+		}
+
+		// --> the URL itself isn't logged; webhook(s) commonly embed a secret token
+		if uri, e := url.Parse(value); e != nil || (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+			slog.Warn("Invalid WATCHLIST_WEBHOOKS URL - Ignoring", slog.Int("index", index))
+
+			continue
+		}
+
+		webhooks = append(webhooks, value)
+	}
+
+	return webhooks
+}
+
+// Crossed returns the lowest threshold the days remaining until expiration are at or below, provided it's lower than
+// the already-alerted threshold, if any. The threshold(s) must be in descending order (see [Thresholds]).
+func Crossed(thresholds []int, remaining float64, alerted *int32) (int, bool) {
+	for index := len(thresholds) - 1; index >= 0; index-- {
+		threshold := thresholds[index]
+		if remaining > float64(threshold) {
+			continue
+		}
+
+		if alerted != nil && threshold >= int(*alerted) {
+			return 0, false
+		}
+
+		return threshold, true
+	}
+
+	return 0, false
+}
+
+// Schedule scans the due watch(es) every minute, until the context is cancelled; each claimed watch is next due once
+// the [Interval] elapses. Because locked watch(es) are skipped, any number of replicas may schedule concurrently.
+func Schedule(ctx context.Context) {
+	interval, thresholds, webhooks := Interval(), Thresholds(), Webhooks()
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "Starting Watchlist Scheduler", slog.Duration("interval", interval), slog.Any("thresholds", thresholds), slog.Int("webhooks", len(webhooks)))
+
+	for {
+		for ctx.Err() == nil {
+			count, e := cycle(ctx, interval, thresholds, webhooks)
+			if e != nil {
+				slog.ErrorContext(ctx, "Unable to Scan Watchlist", slog.String("error", e.Error()))
+				break
+			}
+
+			if count < batch {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Stopping Watchlist Scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// cycle claims and scans a single batch of due watch(es), returning the number claimed.
+func cycle(ctx context.Context, interval time.Duration, thresholds []int, webhooks []string) (int, error) {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return 0, e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	due, e := watches.New().Claim(ctx, connection, &watches.ClaimParams{Interval: interval.Seconds(), Size: batch})
+	if e != nil {
+		return 0, e
+	}
+
+	targets := make([]string, len(due))
+	mapping := make(map[string]watches.Watch, len(due))
+	for index, watch := range due {
+		targets[index] = net.JoinHostPort(watch.Hostname, strconv.Itoa(int(watch.Port)))
+		mapping[targets[index]] = watch
+	}
+
+	if len(targets) > 0 {
+		summary := scan.Scan(ctx, targets, scan.Concurrency(), probe.Timeout(), func(result scan.Result) {
+			record(ctx, connection, mapping[result.Target], result, thresholds, webhooks)
+		})
+
+		slog.InfoContext(ctx, "Scanned Watchlist", slog.Int("count", summary.Total), slog.Any("statuses", summary.Statuses), slog.Int("expiring", summary.Expiring))
+	}
+
+	return len(due), nil
+}
+
+// record persists the watch's scan result, alerting the webhook(s) when its certificate crossed a threshold not yet
+// alerted. A failed delivery leaves the threshold unalerted, such that the next scan retries it.
+func record(ctx context.Context, connection *pgxpool.Conn, watch watches.Watch, result scan.Result, thresholds []int, webhooks []string) {
+	switch result.Status {
+	case scan.Skipped:
+		return
+	case scan.Failed:
+		if e := watches.New().Fail(ctx, connection, &watches.FailParams{ID: watch.ID, Error: &result.Error}); e != nil {
+			slog.ErrorContext(ctx, "Unable to Record Failed Watchlist Scan", slog.Int64("watch", watch.ID), slog.String("error", e.Error()))
+		}
+
+		return
+	}
+
+	alerted := watch.Alerted
+	if !(watch.Expiration.Valid) || !(watch.Expiration.Time.Equal(*result.Expiration)) { // --> a renewed certificate re-arms every threshold
+		alerted = nil
+	}
+
+	if threshold, ok := Crossed(thresholds, *result.Remaining, alerted); ok {
+		alert := New(watch, result, threshold)
+		if e := Notify(ctx, webhooks, alert); e != nil {
+			slog.ErrorContext(ctx, "Unable to Deliver Certificate Expiry Alert", slog.String("key", alert.Key), slog.String("error", e.Error()))
+		} else {
+			value := int32(threshold)
+			alerted = &value
+		}
+	}
+
+	parameters := &watches.ObserveParams{ID: watch.ID, Expiration: pgtype.Timestamptz{Time: *result.Expiration, Valid: true}, Alerted: alerted}
+	if e := watches.New().Observe(ctx, connection, parameters); e != nil {
+		slog.ErrorContext(ctx, "Unable to Record Watchlist Scan", slog.Int64("watch", watch.ID), slog.String("error", e.Error()))
+	}
+}
@@ -0,0 +1,93 @@
+package watchlist
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":        time.Hour,
+		"30m":     30 * time.Minute,
+		"24h":     24 * time.Hour,
+		"0s":      time.Hour,
+		"-1m":     time.Hour,
+		"invalid": time.Hour,
+	}
+
+	for value, expected := range tests {
+		t.Setenv("WATCHLIST_INTERVAL", value)
+
+		if v := Interval(); v != expected {
+			t.Errorf("Interval() with %q = %s, expected %s", value, v, expected)
+		}
+	}
+}
+
+func TestThresholds(t *testing.T) {
+	tests := map[string][]int{
+		"":           {30, 14, 7, 1},
+		"7":          {7},
+		"1, 60, 7":   {60, 7, 1},
+		"14,14,0":    {14, 0},
+		"30,-1":      {30, 14, 7, 1},
+		"30,invalid": {30, 14, 7, 1},
+	}
+
+	for value, expected := range tests {
+		t.Setenv("WATCHLIST_THRESHOLDS", value)
+
+		if v := Thresholds(); !(slices.Equal(v, expected)) {
+			t.Errorf("Thresholds() with %q = %v, expected %v", value, v, expected)
+		}
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Setenv("WATCHLIST_WEBHOOKS", " https://hooks.example.com/a , ,ftp://example.com,http://localhost:9000/b,not a url")
+
+	expected := []string{"https://hooks.example.com/a", "http://localhost:9000/b"}
+	if v := Webhooks(); !(slices.Equal(v, expected)) {
+		t.Errorf("Webhooks() = %v, expected %v", v, expected)
+	}
+
+	t.Setenv("WATCHLIST_WEBHOOKS", "")
+
+	if v := Webhooks(); len(v) != 0 {
+		t.Errorf("Webhooks() = %v, expected none", v)
+	}
+}
+
+func TestCrossed(t *testing.T) {
+	thresholds := []int{30, 14, 7, 1}
+
+	pointer := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name      string
+		remaining float64
+		alerted   *int32
+		threshold int
+		ok        bool
+	}{
+		{"Beyond Every Threshold", 45, nil, 0, false},
+		{"First Threshold", 29.5, nil, 30, true},
+		{"Exactly at Threshold", 14, nil, 14, true},
+		{"Lowest Crossed Threshold Only", 5, nil, 7, true},
+		{"Already Alerted", 20, pointer(30), 0, false},
+		{"Already Alerted Lower", 20, pointer(14), 0, false},
+		{"Next Threshold", 12, pointer(30), 14, true},
+		{"Expired", -2, pointer(7), 1, true},
+		{"Expired & Alerted", -2, pointer(1), 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			threshold, ok := Crossed(thresholds, test.remaining, test.alerted)
+			if threshold != test.threshold || ok != test.ok {
+				t.Errorf("Crossed(%v) = (%d, %t), expected (%d, %t)", test.remaining, threshold, ok, test.threshold, test.ok)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"reconnaissance-service/internal/library/middleware"
 
 	"reconnaissance-service/internal/api"
+	"reconnaissance-service/internal/watchlist"
 )
 
 // sname is a dynamically linked string value - defaults to "local-http-server" - which represents the server name.
@@ -149,6 +150,13 @@ func main() {
 		e = errors.Join(e, shutdown(ctx))
 	}()
 
+	// --> Background Worker(s)
+	if e := watchlist.Register(); e != nil {
+		slog.ErrorContext(ctx, "Unable to Register Watchlist Metric(s)", slog.String("error", e.Error()))
+	}
+
+	go watchlist.Schedule(ctx)
+
 	// <-- Blocking
 	if e := api.ListenAndServe(); e != nil && !(errors.Is(e, http.ErrServerClosed)) {
 		slog.ErrorContext(ctx, "Error During Server's Listen & Serve Call ...", slog.String("error", e.Error()))
@@ -0,0 +1,3 @@
+package watches
+
+//go:generate sqlc generate
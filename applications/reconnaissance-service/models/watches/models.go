@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package watches
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Watch represents a watchlisted host:port whose leaf certificate is re-scanned by the scheduler at the configured interval.
+type Watch struct {
+	ID       int64  `db:"id" json:"id"`
+	Hostname string `db:"hostname" json:"hostname"`
+	Port     int32  `db:"port" json:"port"`
+	// Status represents the most recent scan's outcome; see models/watches/status.go.
+	Status string `db:"status" json:"status"`
+	// Error represents the most recent scan's failure.
+	Error *string `db:"error" json:"error"`
+	// Expiration represents the leaf certificate's expiration as of the most recent successful scan; retained across failed scan(s).
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+	// Alerted represents the lowest threshold, in days, already alerted for the certificate expiring at Expiration; reset once a renewed certificate is observed.
+	Alerted *int32 `db:"alerted" json:"alerted"`
+	// Schedule represents when the watch is next due; a claim moves it forward by the scan interval.
+	Schedule pgtype.Timestamptz `db:"schedule" json:"-"`
+	// Scan represents when the watch was most recently scanned.
+	Scan         pgtype.Timestamptz `db:"scan" json:"scan"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+}
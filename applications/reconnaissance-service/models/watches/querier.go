@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package watches
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Claim leases up to size due [Watch](s) by moving each schedule forward by the scan interval. Concurrent claimants
+	// skip locked row(s), and a watch whose claimant exits mid-scan is rescanned once the interval elapses.
+	Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Watch, error)
+	// Create establishes a PENDING [Watch], due immediately.
+	Create(ctx context.Context, db DBTX, arg *CreateParams) (Watch, error)
+	// Delete removes the [Watch].
+	Delete(ctx context.Context, db DBTX, id int64) (int64, error)
+	// Expirations returns the address & expiration of every [Watch] observed at least once.
+	Expirations(ctx context.Context, db DBTX) ([]ExpirationsRow, error)
+	// Fail records the [Watch]'s failed scan, retaining its prior expiration & alerted threshold.
+	Fail(ctx context.Context, db DBTX, arg *FailParams) error
+	// Get returns the [Watch] by its identifier.
+	Get(ctx context.Context, db DBTX, id int64) (Watch, error)
+	// List returns up to size [Watch](s), newest first, whose identifier(s) are below the optional cursor.
+	List(ctx context.Context, db DBTX, arg *ListParams) ([]Watch, error)
+	// Observe records the [Watch]'s successful scan, alongside the lowest threshold alerted for the observed certificate.
+	Observe(ctx context.Context, db DBTX, arg *ObserveParams) error
+	// Update replaces the [Watch]'s address, discarding its prior observation(s) and scheduling it immediately.
+	Update(ctx context.Context, db DBTX, arg *UpdateParams) (Watch, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,75 @@
+-- name: Create :one
+-- Create establishes a PENDING [Watch], due immediately.
+INSERT INTO "Watch" (hostname, port)
+VALUES (sqlc.arg(hostname), sqlc.arg(port))
+RETURNING *;
+
+-- name: Get :one
+-- Get returns the [Watch] by its identifier.
+SELECT * FROM "Watch" WHERE (id) = sqlc.arg(id) LIMIT 1;
+
+-- name: List :many
+-- List returns up to size [Watch](s), newest first, whose identifier(s) are below the optional cursor.
+SELECT *
+FROM "Watch"
+WHERE (sqlc.narg(cursor)::bigint IS NULL OR (id) < sqlc.narg(cursor)::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg(size)::int;
+
+-- name: Update :one
+-- Update replaces the [Watch]'s address, discarding its prior observation(s) and scheduling it immediately.
+UPDATE "Watch"
+SET hostname     = sqlc.arg(hostname),
+    port         = sqlc.arg(port),
+    status       = 'PENDING',
+    error        = NULL,
+    expiration   = NULL,
+    alerted      = NULL,
+    scan         = NULL,
+    schedule     = now(),
+    modification = now()
+WHERE (id) = sqlc.arg(id)
+RETURNING *;
+
+-- name: Delete :execrows
+-- Delete removes the [Watch].
+DELETE FROM "Watch" WHERE (id) = sqlc.arg(id);
+
+-- name: Claim :many
+-- Claim leases up to size due [Watch](s) by moving each schedule forward by the scan interval. Concurrent claimants
+-- skip locked row(s), and a watch whose claimant exits mid-scan is rescanned once the interval elapses.
+UPDATE "Watch"
+SET schedule = now() + make_interval(secs => sqlc.arg(interval)::float8)
+WHERE (id) IN (SELECT w.id
+               FROM "Watch" w
+               WHERE (w.schedule) <= now()
+               ORDER BY w.schedule
+               LIMIT sqlc.arg(size)::int FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: Observe :exec
+-- Observe records the [Watch]'s successful scan, alongside the lowest threshold alerted for the observed certificate.
+UPDATE "Watch"
+SET status       = 'SUCCEEDED',
+    error        = NULL,
+    expiration   = sqlc.arg(expiration),
+    alerted      = sqlc.narg(alerted),
+    scan         = now(),
+    modification = now()
+WHERE (id) = sqlc.arg(id);
+
+-- name: Fail :exec
+-- Fail records the [Watch]'s failed scan, retaining its prior expiration & alerted threshold.
+UPDATE "Watch"
+SET status       = 'FAILED',
+    error        = sqlc.arg(error),
+    scan         = now(),
+    modification = now()
+WHERE (id) = sqlc.arg(id);
+
+-- name: Expirations :many
+-- Expirations returns the address & expiration of every [Watch] observed at least once.
+SELECT id, hostname, port, expiration
+FROM "Watch"
+WHERE (expiration) IS NOT NULL
+ORDER BY id;
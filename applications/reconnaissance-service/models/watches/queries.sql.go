@@ -0,0 +1,300 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package watches
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claim = `-- name: Claim :many
+UPDATE "Watch"
+SET schedule = now() + make_interval(secs => $1::float8)
+WHERE (id) IN (SELECT w.id
+               FROM "Watch" w
+               WHERE (w.schedule) <= now()
+               ORDER BY w.schedule
+               LIMIT $2::int FOR UPDATE SKIP LOCKED)
+RETURNING id, hostname, port, status, error, expiration, alerted, schedule, scan, creation, modification
+`
+
+type ClaimParams struct {
+	Interval float64 `db:"interval" json:"interval"`
+	Size     int32   `db:"size" json:"size"`
+}
+
+// Claim leases up to size due [Watch](s) by moving each schedule forward by the scan interval. Concurrent claimants
+// skip locked row(s), and a watch whose claimant exits mid-scan is rescanned once the interval elapses.
+func (q *Queries) Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Watch, error) {
+	rows, err := db.Query(ctx, claim, arg.Interval, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Watch{}
+	for rows.Next() {
+		var i Watch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Hostname,
+			&i.Port,
+			&i.Status,
+			&i.Error,
+			&i.Expiration,
+			&i.Alerted,
+			&i.Schedule,
+			&i.Scan,
+			&i.Creation,
+			&i.Modification,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const create = `-- name: Create :one
+INSERT INTO "Watch" (hostname, port)
+VALUES ($1, $2)
+RETURNING id, hostname, port, status, error, expiration, alerted, schedule, scan, creation, modification
+`
+
+type CreateParams struct {
+	Hostname string `db:"hostname" json:"hostname"`
+	Port     int32  `db:"port" json:"port"`
+}
+
+// Create establishes a PENDING [Watch], due immediately.
+func (q *Queries) Create(ctx context.Context, db DBTX, arg *CreateParams) (Watch, error) {
+	row := db.QueryRow(ctx, create, arg.Hostname, arg.Port)
+	var i Watch
+	err := row.Scan(
+		&i.ID,
+		&i.Hostname,
+		&i.Port,
+		&i.Status,
+		&i.Error,
+		&i.Expiration,
+		&i.Alerted,
+		&i.Schedule,
+		&i.Scan,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const delete = `-- name: Delete :execrows
+DELETE FROM "Watch" WHERE (id) = $1
+`
+
+// Delete removes the [Watch].
+func (q *Queries) Delete(ctx context.Context, db DBTX, id int64) (int64, error) {
+	result, err := db.Exec(ctx, delete, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const expirations = `-- name: Expirations :many
+SELECT id, hostname, port, expiration
+FROM "Watch"
+WHERE (expiration) IS NOT NULL
+ORDER BY id
+`
+
+type ExpirationsRow struct {
+	ID         int64              `db:"id" json:"id"`
+	Hostname   string             `db:"hostname" json:"hostname"`
+	Port       int32              `db:"port" json:"port"`
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+}
+
+// Expirations returns the address & expiration of every [Watch] observed at least once.
+func (q *Queries) Expirations(ctx context.Context, db DBTX) ([]ExpirationsRow, error) {
+	rows, err := db.Query(ctx, expirations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExpirationsRow{}
+	for rows.Next() {
+		var i ExpirationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Hostname,
+			&i.Port,
+			&i.Expiration,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fail = `-- name: Fail :exec
+UPDATE "Watch"
+SET status       = 'FAILED',
+    error        = $1,
+    scan         = now(),
+    modification = now()
+WHERE (id) = $2
+`
+
+type FailParams struct {
+	Error *string `db:"error" json:"error"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Fail records the [Watch]'s failed scan, retaining its prior expiration & alerted threshold.
+func (q *Queries) Fail(ctx context.Context, db DBTX, arg *FailParams) error {
+	_, err := db.Exec(ctx, fail, arg.Error, arg.ID)
+	return err
+}
+
+const get = `-- name: Get :one
+SELECT id, hostname, port, status, error, expiration, alerted, schedule, scan, creation, modification FROM "Watch" WHERE (id) = $1 LIMIT 1
+`
+
+// Get returns the [Watch] by its identifier.
+func (q *Queries) Get(ctx context.Context, db DBTX, id int64) (Watch, error) {
+	row := db.QueryRow(ctx, get, id)
+	var i Watch
+	err := row.Scan(
+		&i.ID,
+		&i.Hostname,
+		&i.Port,
+		&i.Status,
+		&i.Error,
+		&i.Expiration,
+		&i.Alerted,
+		&i.Schedule,
+		&i.Scan,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const list = `-- name: List :many
+SELECT id, hostname, port, status, error, expiration, alerted, schedule, scan, creation, modification
+FROM "Watch"
+WHERE ($1::bigint IS NULL OR (id) < $1::bigint)
+ORDER BY id DESC
+LIMIT $2::int
+`
+
+type ListParams struct {
+	Cursor *int64 `db:"cursor" json:"cursor"`
+	Size   int32  `db:"size" json:"size"`
+}
+
+// List returns up to size [Watch](s), newest first, whose identifier(s) are below the optional cursor.
+func (q *Queries) List(ctx context.Context, db DBTX, arg *ListParams) ([]Watch, error) {
+	rows, err := db.Query(ctx, list, arg.Cursor, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Watch{}
+	for rows.Next() {
+		var i Watch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Hostname,
+			&i.Port,
+			&i.Status,
+			&i.Error,
+			&i.Expiration,
+			&i.Alerted,
+			&i.Schedule,
+			&i.Scan,
+			&i.Creation,
+			&i.Modification,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const observe = `-- name: Observe :exec
+UPDATE "Watch"
+SET status       = 'SUCCEEDED',
+    error        = NULL,
+    expiration   = $1,
+    alerted      = $2,
+    scan         = now(),
+    modification = now()
+WHERE (id) = $3
+`
+
+type ObserveParams struct {
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+	Alerted    *int32             `db:"alerted" json:"alerted"`
+	ID         int64              `db:"id" json:"id"`
+}
+
+// Observe records the [Watch]'s successful scan, alongside the lowest threshold alerted for the observed certificate.
+func (q *Queries) Observe(ctx context.Context, db DBTX, arg *ObserveParams) error {
+	_, err := db.Exec(ctx, observe, arg.Expiration, arg.Alerted, arg.ID)
+	return err
+}
+
+const update = `-- name: Update :one
+UPDATE "Watch"
+SET hostname     = $1,
+    port         = $2,
+    status       = 'PENDING',
+    error        = NULL,
+    expiration   = NULL,
+    alerted      = NULL,
+    scan         = NULL,
+    schedule     = now(),
+    modification = now()
+WHERE (id) = $3
+RETURNING id, hostname, port, status, error, expiration, alerted, schedule, scan, creation, modification
+`
+
+type UpdateParams struct {
+	Hostname string `db:"hostname" json:"hostname"`
+	Port     int32  `db:"port" json:"port"`
+	ID       int64  `db:"id" json:"id"`
+}
+
+// Update replaces the [Watch]'s address, discarding its prior observation(s) and scheduling it immediately.
+func (q *Queries) Update(ctx context.Context, db DBTX, arg *UpdateParams) (Watch, error) {
+	row := db.QueryRow(ctx, update, arg.Hostname, arg.Port, arg.ID)
+	var i Watch
+	err := row.Scan(
+		&i.ID,
+		&i.Hostname,
+		&i.Port,
+		&i.Status,
+		&i.Error,
+		&i.Expiration,
+		&i.Alerted,
+		&i.Schedule,
+		&i.Scan,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
@@ -0,0 +1,38 @@
+--
+-- Watch
+--
+
+CREATE TABLE "Watch"
+(
+    "id"           bigserial
+        CONSTRAINT "watch-id-primary-key" primary key,
+
+    "hostname"     varchar(253)                               not null,
+    "port"         integer                  default 443       not null
+        CONSTRAINT "watch-port-constraint" CHECK ("Watch"."port" BETWEEN 1 AND 65535),
+
+    "status"       varchar(16)              default 'PENDING' not null
+        CONSTRAINT "watch-status-constraint" CHECK ("Watch"."status" IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+
+    "error"        text                     default NULL,
+    "expiration"   timestamp with time zone default NULL,
+    "alerted"      integer                  default NULL,
+
+    "schedule"     timestamp with time zone default now()     not null,
+    "scan"         timestamp with time zone default NULL,
+
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone,
+
+    CONSTRAINT "watch-address-unique" UNIQUE ("hostname", "port")
+);
+
+COMMENT ON TABLE "Watch" IS 'Watch represents a watchlisted host:port whose leaf certificate is re-scanned by the scheduler at the configured interval.';
+COMMENT ON COLUMN "Watch"."status" IS 'Status represents the most recent scan''s outcome; see models/watches/status.go.';
+COMMENT ON COLUMN "Watch"."error" IS 'Error represents the most recent scan''s failure.';
+COMMENT ON COLUMN "Watch"."expiration" IS 'Expiration represents the leaf certificate''s expiration as of the most recent successful scan; retained across failed scan(s).';
+COMMENT ON COLUMN "Watch"."alerted" IS 'Alerted represents the lowest threshold, in days, already alerted for the certificate expiring at Expiration; reset once a renewed certificate is observed.';
+COMMENT ON COLUMN "Watch"."schedule" IS 'Schedule represents when the watch is next due; a claim moves it forward by the scan interval.';
+COMMENT ON COLUMN "Watch"."scan" IS 'Scan represents when the watch was most recently scanned.';
+
+CREATE INDEX IF NOT EXISTS "watch-due-index" on "Watch" (schedule);
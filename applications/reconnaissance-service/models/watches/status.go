@@ -0,0 +1,8 @@
+package watches
+
+// [Watch.Status] value(s), as constrained by the "watch-status-constraint" check.
+const (
+	Pending   = "PENDING"   // Pending represents a watch awaiting its first scan, including one whose address was updated.
+	Succeeded = "SUCCEEDED" // Succeeded represents a watch whose most recent scan completed a handshake.
+	Failed    = "FAILED"    // Failed represents a watch whose most recent scan couldn't resolve, connect to, or handshake with the address.
+)
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"user-service/internal/database"
+	"user-service/internal/library/events"
 	"user-service/internal/library/middleware"
 	"user-service/internal/library/middleware/authentication"
 	"user-service/internal/library/server"
@@ -181,6 +182,8 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 	slog.DebugContext(ctx, "Successfully Updated User's Avatar", slog.String("email", email), slog.Int64("id", id))
 
+	events.Emit(ctx, events.Updated, strconv.FormatInt(id, 10), events.UserUpdated{ID: id, Attributes: []string{"avatar"}})
+
 	w.WriteHeader(http.StatusNoContent)
 	return
 })
@@ -1,2 +1,3 @@
-// Package avatar provides HTTP Handler(s) for managing a [users.User] avatar.
+// Package avatar provides HTTP Handler(s) for managing a [users.User] avatar, including multipart upload(s) that are
+// sanitized and resized into [Variant](s) persisted through the [storage] package.
 package avatar
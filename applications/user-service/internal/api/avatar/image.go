@@ -0,0 +1,254 @@
+package avatar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	maximum   = 5 << 20     // maximum represents the largest accepted upload size, in bytes.
+	dimension = 8192        // dimension represents the largest accepted source image width or height, in pixels.
+	pixels    = 4096 * 4096 // pixels represents the largest accepted source image area; it bounds the decoded image's memory.
+)
+
+// sizes represents the square variant(s), in pixels, generated for every upload. The first size is considered the
+// user's primary avatar.
+var sizes = []int{512, 256, 128, 64}
+
+// formats maps sniffed media-type(s) to the supported image decoder(s).
+var formats = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+var (
+	ErrUnsupportedMediaType = errors.New("unsupported image media-type")        // ErrUnsupportedMediaType is returned when the upload's sniffed content isn't a supported image.
+	ErrDimensions           = errors.New("image dimensions exceed the maximum") // ErrDimensions is returned when the source image's width or height exceeds [dimension], or its area exceeds [pixels].
+)
+
+// Variant represents a resized, metadata-free rendition of an uploaded avatar.
+type Variant struct {
+	Size        int    // Size represents the variant's width and height in pixels.
+	Key         string // Key represents the variant's object-storage key.
+	ContentType string // ContentType represents the variant's encoded media-type.
+	Content     []byte // Content represents the variant's encoded image.
+}
+
+// Process sniffs, decodes, and re-encodes the uploaded content into square [Variant](s) of every [sizes] entry. Because
+// variant(s) are re-encoded from decoded pixel data, all source metadata (EXIF, XMP, ICC, comments) is discarded; a JPEG's
+// EXIF orientation is applied to the pixel data before it's removed.
+//
+// Variant keys are derived from the owner and the upload's content, and are therefore immutable.
+func Process(owner int64, content []byte) ([]Variant, error) {
+	media := http.DetectContentType(content)
+	if _, ok := formats[media]; !(ok) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, media)
+	}
+
+	configuration, format, e := image.DecodeConfig(bytes.NewReader(content))
+	if e != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedMediaType, e)
+	} else if format != formats[media] {
+		return nil, fmt.Errorf("%w: sniffed %s, decoded %s", ErrUnsupportedMediaType, media, format)
+	} else if configuration.Width > dimension || configuration.Height > dimension || configuration.Width == 0 || configuration.Height == 0 {
+		return nil, ErrDimensions
+	} else if configuration.Width*configuration.Height > pixels {
+		// --> a compressed image's header can declare far more pixel(s) than its size suggests; refuse before decoding
+		return nil, ErrDimensions
+	}
+
+	source, _, e := image.Decode(bytes.NewReader(content))
+	if e != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedMediaType, e)
+	}
+
+	if format == "jpeg" {
+		source = orient(source, orientation(content))
+	}
+
+	source = square(source)
+
+	digest := sha256.New()
+	binary.Write(digest, binary.BigEndian, owner)
+	digest.Write(content)
+
+	prefix := hex.EncodeToString(digest.Sum(nil))[:32]
+
+	variants := make([]Variant, 0, len(sizes))
+	for _, size := range sizes {
+		destination := image.NewRGBA(image.Rect(0, 0, size, size))
+		draw.CatmullRom.Scale(destination, destination.Bounds(), source, source.Bounds(), draw.Src, nil)
+
+		var buffer bytes.Buffer
+
+		variant := Variant{Size: size}
+		switch format {
+		case "jpeg":
+			variant.ContentType = "image/jpeg"
+			variant.Key = fmt.Sprintf("%s-%d.jpg", prefix, size)
+			e = jpeg.Encode(&buffer, destination, &jpeg.Options{Quality: 85})
+		default:
+			variant.ContentType = "image/png"
+			variant.Key = fmt.Sprintf("%s-%d.png", prefix, size)
+			e = png.Encode(&buffer, destination)
+		}
+
+		if e != nil {
+			return nil, e
+		}
+
+		variant.Content = buffer.Bytes()
+
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+// square center-crops the image to a square using its shortest side.
+func square(source image.Image) image.Image {
+	bounds := source.Bounds()
+
+	side := min(bounds.Dx(), bounds.Dy())
+
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	destination := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(destination, destination.Bounds(), source, image.Point{X: x, Y: y}, draw.Src)
+
+	return destination
+}
+
+// orientation returns the EXIF orientation (1-8) of a JPEG, or 1 if the tag is absent or malformed.
+func orientation(content []byte) int {
+	const fallback = 1
+
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return fallback
+	}
+
+	offset := 2
+	for offset+4 <= len(content) {
+		if content[offset] != 0xFF {
+			return fallback
+		}
+
+		marker := content[offset+1]
+		if marker == 0xDA || marker == 0xD9 { // --> start-of-scan or end-of-image; no further metadata segments
+			return fallback
+		}
+
+		length := int(binary.BigEndian.Uint16(content[offset+2:]))
+		if length < 2 || offset+2+length > len(content) {
+			return fallback
+		}
+
+		segment := content[offset+4 : offset+2+length]
+		if marker == 0xE1 && len(segment) > 14 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiff(segment[6:])
+		}
+
+		offset += 2 + length
+	}
+
+	return fallback
+}
+
+// tiff reads the orientation tag (0x0112) from a TIFF-structured EXIF payload's first image-file-directory.
+func tiff(payload []byte) int {
+	const fallback = 1
+
+	var order binary.ByteOrder
+	switch string(payload[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return fallback
+	}
+
+	directory := int(order.Uint32(payload[4:8]))
+	if directory+2 > len(payload) {
+		return fallback
+	}
+
+	entries := int(order.Uint16(payload[directory:]))
+	for index := 0; index < entries; index++ {
+		entry := directory + 2 + index*12
+		if entry+12 > len(payload) {
+			return fallback
+		}
+
+		if order.Uint16(payload[entry:]) == 0x0112 {
+			value := int(order.Uint16(payload[entry+8:]))
+			if value < 1 || value > 8 {
+				return fallback
+			}
+
+			return value
+		}
+	}
+
+	return fallback
+}
+
+// orient applies an EXIF orientation transform to the image.
+//
+//   - See https://www.exif.org/Exif2-2.PDF, page 18.
+func orient(source image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return source
+	}
+
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	transposed := orientation >= 5
+	if transposed {
+		width, height = height, width
+	}
+
+	destination := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			var dx, dy int
+
+			switch orientation {
+			case 2: // --> horizontal flip
+				dx, dy = bounds.Dx()-1-x, y
+			case 3: // --> 180° rotation
+				dx, dy = bounds.Dx()-1-x, bounds.Dy()-1-y
+			case 4: // --> vertical flip
+				dx, dy = x, bounds.Dy()-1-y
+			case 5: // --> transpose
+				dx, dy = y, x
+			case 6: // --> 90° clockwise rotation
+				dx, dy = bounds.Dy()-1-y, x
+			case 7: // --> transverse
+				dx, dy = bounds.Dy()-1-y, bounds.Dx()-1-x
+			case 8: // --> 90° counter-clockwise rotation
+				dx, dy = y, bounds.Dx()-1-x
+			}
+
+			destination.Set(dx, dy, source.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return destination
+}
@@ -0,0 +1,160 @@
+package avatar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// exif returns a minimal big-endian APP1 segment containing only an orientation tag.
+func exif(value uint16) []byte {
+	var payload bytes.Buffer
+
+	payload.WriteString("Exif\x00\x00")
+	payload.WriteString("MM")
+	binary.Write(&payload, binary.BigEndian, uint16(42))
+	binary.Write(&payload, binary.BigEndian, uint32(8))
+	binary.Write(&payload, binary.BigEndian, uint16(1))
+	binary.Write(&payload, binary.BigEndian, uint16(0x0112))
+	binary.Write(&payload, binary.BigEndian, uint16(3))
+	binary.Write(&payload, binary.BigEndian, uint32(1))
+	binary.Write(&payload, binary.BigEndian, value)
+	binary.Write(&payload, binary.BigEndian, uint16(0))
+	binary.Write(&payload, binary.BigEndian, uint32(0))
+
+	var segment bytes.Buffer
+	segment.Write([]byte{0xFF, 0xE1})
+	binary.Write(&segment, binary.BigEndian, uint16(payload.Len()+2))
+	segment.Write(payload.Bytes())
+
+	return segment.Bytes()
+}
+
+func fixture(t *testing.T, width, height int, orientation uint16) []byte {
+	t.Helper()
+
+	source := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			source.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buffer bytes.Buffer
+	if e := jpeg.Encode(&buffer, source, nil); e != nil {
+		t.Fatalf("Unable to Encode Fixture: %v", e)
+	}
+
+	content := buffer.Bytes()
+
+	// --> inject the APP1 segment directly after the start-of-image marker
+	return append(append([]byte{0xFF, 0xD8}, exif(orientation)...), content[2:]...)
+}
+
+// header returns a PNG consisting of only its signature & header chunk, declaring the dimensions without any pixel data.
+func header(width, height uint32) []byte {
+	var chunk bytes.Buffer
+
+	chunk.WriteString("IHDR")
+	binary.Write(&chunk, binary.BigEndian, width)
+	binary.Write(&chunk, binary.BigEndian, height)
+	chunk.Write([]byte{8, 6, 0, 0, 0}) // 8-bit depth, RGBA, default compression, filter, and interlace
+
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&buffer, binary.BigEndian, uint32(chunk.Len()-4))
+	buffer.Write(chunk.Bytes())
+	binary.Write(&buffer, binary.BigEndian, crc32.ChecksumIEEE(chunk.Bytes()))
+
+	return buffer.Bytes()
+}
+
+func TestProcess(t *testing.T) {
+	t.Run("Variants", func(t *testing.T) {
+		content := fixture(t, 640, 480, 6)
+		if v := orientation(content); v != 6 {
+			t.Fatalf("Expected Fixture Orientation (6), Received (%d)", v)
+		}
+
+		variants, e := Process(1, content)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if len(variants) != len(sizes) {
+			t.Fatalf("Expected (%d) Variants, Received (%d)", len(sizes), len(variants))
+		}
+
+		for index, variant := range variants {
+			if variant.Size != sizes[index] {
+				t.Errorf("Expected Size (%d), Received (%d)", sizes[index], variant.Size)
+			}
+
+			if bytes.Contains(variant.Content, []byte("Exif")) {
+				t.Errorf("Variant (%s) Contains EXIF Metadata", variant.Key)
+			}
+
+			configuration, format, e := image.DecodeConfig(bytes.NewReader(variant.Content))
+			if e != nil {
+				t.Fatalf("Unable to Decode Variant (%s): %v", variant.Key, e)
+			}
+
+			if format != "jpeg" || configuration.Width != variant.Size || configuration.Height != variant.Size {
+				t.Errorf("Variant (%s): Unexpected %s (%dx%d)", variant.Key, format, configuration.Width, configuration.Height)
+			}
+		}
+	})
+
+	t.Run("Deterministic-Keys", func(t *testing.T) {
+		content := fixture(t, 64, 64, 1)
+
+		first, _ := Process(1, content)
+		second, _ := Process(1, content)
+		other, _ := Process(2, content)
+
+		if first[0].Key != second[0].Key {
+			t.Errorf("Expected Identical Keys: (%s), (%s)", first[0].Key, second[0].Key)
+		}
+
+		if first[0].Key == other[0].Key {
+			t.Errorf("Expected Owner-Specific Keys: (%s)", first[0].Key)
+		}
+	})
+
+	t.Run("Unsupported-Media-Type", func(t *testing.T) {
+		for _, content := range [][]byte{[]byte("<html><body>example</body></html>"), []byte("%PDF-1.4"), {0xFF, 0xD8, 0xFF, 0xE0}} {
+			if _, e := Process(1, content); !(errors.Is(e, ErrUnsupportedMediaType)) {
+				t.Errorf("Expected (%v), Received (%v)", ErrUnsupportedMediaType, e)
+			}
+		}
+	})
+}
+
+func TestOrient(t *testing.T) {
+	source := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	source.Set(0, 0, color.RGBA{R: 255, A: 255})
+
+	rotated := orient(source, 6)
+	if bounds := rotated.Bounds(); bounds.Dx() != 2 || bounds.Dy() != 4 {
+		t.Fatalf("Expected (2x4), Received (%dx%d)", bounds.Dx(), bounds.Dy())
+	}
+
+	// --> a 90° clockwise rotation moves the top-left pixel to the top-right
+	if r, _, _, _ := rotated.At(1, 0).RGBA(); r != 0xFFFF {
+		t.Errorf("Expected Rotated Pixel at (1, 0)")
+	}
+
+	t.Run("Dimensions", func(t *testing.T) {
+		for _, content := range [][]byte{header(dimension+1, 1), header(8000, 8000)} {
+			if _, e := Process(1, content); !(errors.Is(e, ErrDimensions)) {
+				t.Errorf("Expected (%v), Received (%v)", ErrDimensions, e)
+			}
+		}
+	})
+}
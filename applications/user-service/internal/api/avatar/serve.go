@@ -0,0 +1,92 @@
+package avatar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/library/middleware"
+	"user-service/internal/storage"
+)
+
+// Serve is a public HTTP handler that returns a stored avatar [Variant]. Because variant keys are content-addressed, responses
+// are marked immutable and can be cached indefinitely by browsers and intermediaries.
+var Serve = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "avatar-serve"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	key := r.PathValue("key")
+	if !(storage.Valid(key)) || strings.Contains(key, "/") {
+		slog.WarnContext(ctx, "Invalid Avatar Key", slog.String("key", key))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	etag := fmt.Sprintf("%q", key)
+
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("ETag", etag)
+
+	if match := r.Header.Get("If-None-Match"); match != "" && (match == etag || match == "W/"+etag || match == "*") {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	bucket, e := storage.Default(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Establish Object Storage", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	reader, object, e := bucket.Get(ctx, key)
+	if e != nil {
+		w.Header().Del("Cache-Control")
+		w.Header().Del("ETag")
+
+		if errors.Is(e, storage.ErrNotFound) || errors.Is(e, storage.ErrInvalidKey) {
+			slog.WarnContext(ctx, "Avatar Not Found", slog.String("key", key))
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Retrieve Avatar", slog.String("key", key), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer reader.Close()
+
+	w.Header().Set("Content-Type", object.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(object.Size, 10))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if !(object.Modification.IsZero()) {
+		w.Header().Set("Last-Modified", object.Modification.UTC().Format(http.TimeFormat))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, e := io.Copy(w, reader); e != nil {
+		slog.ErrorContext(ctx, "Unable to Write Avatar Response", slog.String("key", key), slog.String("error", e.Error()))
+	}
+
+	return
+})
@@ -0,0 +1,241 @@
+package avatar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/api/ownership"
+	"user-service/internal/database"
+	"user-service/internal/library/events"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/server"
+	"user-service/internal/storage"
+	"user-service/models/users"
+)
+
+// Upload is an HTTP handler that accepts a multipart/form-data avatar image (form field "avatar"), generates metadata-free,
+// resized [Variant](s), persists them to [storage.Default], and updates the authenticated user's avatar to the primary variant.
+var Upload = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "avatar-upload"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	slog.DebugContext(ctx, "Executing Avatar Upload Handler", slog.String("email", email))
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> authorize before reading the multipart body; the connection isn't held for the upload's duration
+	{
+		connection, e := database.Connection(ctx)
+		if e != nil {
+			slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		authorized := ownership.Authorize(ctx, w, connection, id, email, "update the user-avatar")
+
+		database.Disconnect(ctx, connection, nil)
+
+		if !(authorized) {
+			return
+		}
+	}
+
+	// --> bound the entire multipart request; the additional allowance accounts for multipart boundaries and header(s)
+	r.Body = http.MaxBytesReader(w, r.Body, maximum+(64<<10))
+
+	file, header, e := r.FormFile("avatar")
+	if e != nil {
+		var limit *http.MaxBytesError
+		if errors.As(e, &limit) {
+			slog.WarnContext(ctx, "Avatar Upload Exceeds Maximum Size", slog.Int64("limit", limit.Limit))
+			http.Error(w, fmt.Sprintf("Avatar Exceeds Maximum Size of %d Bytes", maximum), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		slog.WarnContext(ctx, "Unable to Retrieve Avatar Form File", slog.String("error", e.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(server.Validators{
+			"avatar": {
+				Valid:   false,
+				Message: fmt.Sprintf("(Required) A multipart/form-data image file (JPEG, PNG, GIF, or WebP) no larger than %d bytes.", maximum),
+			},
+		})
+
+		return
+	}
+
+	defer file.Close()
+
+	content, e := io.ReadAll(io.LimitReader(file, maximum+1))
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Read Avatar Form File", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if len(content) > maximum {
+		slog.WarnContext(ctx, "Avatar Upload Exceeds Maximum Size", slog.Int("size", len(content)))
+		http.Error(w, fmt.Sprintf("Avatar Exceeds Maximum Size of %d Bytes", maximum), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	slog.DebugContext(ctx, "Received Avatar Upload", slog.String("filename", header.Filename), slog.Int("size", len(content)))
+
+	variants, e := Process(id, content)
+	if e != nil {
+		switch {
+		case errors.Is(e, ErrUnsupportedMediaType):
+			slog.WarnContext(ctx, "Unsupported Avatar Media-Type", slog.String("error", e.Error()))
+			http.Error(w, "Avatar Must be a JPEG, PNG, GIF, or WebP Image", http.StatusUnsupportedMediaType)
+		case errors.Is(e, ErrDimensions):
+			slog.WarnContext(ctx, "Avatar Dimensions Exceed Maximum", slog.String("error", e.Error()))
+			http.Error(w, fmt.Sprintf("Avatar Dimensions Cannot Exceed %dx%d Pixels, or %d Pixels in Total", dimension, dimension, pixels), http.StatusUnprocessableEntity)
+		default:
+			slog.ErrorContext(ctx, "Unable to Process Avatar", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Initialize a database transaction in the event of rollback.
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	// Persist the variant(s). Keys are content-addressed, so orphaned object(s) from a failed transaction are harmless.
+	bucket, e := storage.Default(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Establish Object Storage", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	urls := make(map[string]string, len(variants))
+	for _, variant := range variants {
+		if e := bucket.Put(ctx, variant.Key, variant.Content, variant.ContentType); e != nil {
+			slog.ErrorContext(ctx, "Unable to Store Avatar Variant", slog.String("key", variant.Key), slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		urls[strconv.Itoa(variant.Size)] = URL(variant.Key)
+	}
+
+	avatar := URL(variants[0].Key)
+
+	if e := users.New().UpdateUserAvatar(ctx, tx, &users.UpdateUserAvatarParams{Avatar: avatar, ID: id}); e != nil {
+		slog.ErrorContext(ctx, "Unable to Update User's Avatar",
+			slog.Int64("id", id),
+			slog.String("email", email),
+			slog.String("error", e.Error()),
+			slog.String("error-type", reflect.TypeOf(e).String()),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> commit the transaction
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.DebugContext(ctx, "Successfully Uploaded User's Avatar", slog.String("email", email), slog.Int64("id", id), slog.String("avatar", avatar))
+
+	events.Emit(ctx, events.Updated, strconv.FormatInt(id, 10), events.UserUpdated{ID: id, Attributes: []string{"avatar"}})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"avatar":   avatar,
+		"variants": urls,
+	})
+
+	return
+})
+
+// URL returns the public URL of a stored avatar object. The base is configurable via the AVATAR_BASE_URL environment
+// variable, and defaults to the service-relative "/avatars" route.
+func URL(key string) string {
+	base := os.Getenv("AVATAR_BASE_URL")
+	if base == "" {
+		base = "/avatars"
+	}
+
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(base, "/"), key)
+}
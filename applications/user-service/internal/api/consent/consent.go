@@ -0,0 +1,223 @@
+package consent
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/api/ownership"
+	"user-service/internal/database"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/server"
+	"user-service/models/consents"
+)
+
+// Ledger represents the consent ledger's response body.
+type Ledger struct {
+	Current map[string]bool    `json:"current"` // Current maps each recorded purpose to its latest decision.
+	History []consents.Consent `json:"history"` // History represents the ledger's entries, most recent first.
+}
+
+// Record is an HTTP handler that appends a consent decision to the authenticated user's ledger. Recording a "marketing"
+// decision updates the user's derived marketing attribute within the same transaction.
+var Record = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "consent-record"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Initialize a database transaction in the event of rollback.
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	if !(ownership.Authorize(ctx, w, tx, id, email, "access the user's consent ledger")) {
+		return
+	}
+
+	address := consents.Address(middleware.New().RIP().Value(ctx).Real, r.RemoteAddr)
+
+	entry, e := consents.New().Record(ctx, tx, input.Parameters(id, address))
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Record Consent",
+			slog.Int64("id", id),
+			slog.String("email", email),
+			slog.String("error", e.Error()),
+			slog.String("error-type", reflect.TypeOf(e).String()),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> commit the transaction
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Recorded Consent", slog.Int64("id", id), slog.String("purpose", entry.Purpose), slog.Bool("granted", entry.Granted))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+
+	return
+})
+
+// History is an HTTP handler that returns the authenticated user's consent [Ledger]. The optional "purpose" query
+// parameter filters the ledger's history.
+var History = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "consent-history"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var filter *string
+	if value := r.URL.Query().Get("purpose"); value != "" {
+		filter = &value
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	if !(ownership.Authorize(ctx, w, connection, id, email, "access the user's consent ledger")) {
+		return
+	}
+
+	current, e := consents.New().Current(ctx, connection, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query Current Consent(s)", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	history, e := consents.New().History(ctx, connection, &consents.HistoryParams{UserID: id, Purpose: filter})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query Consent History", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ledger := Ledger{Current: make(map[string]bool, len(current)), History: history}
+	for _, entry := range current {
+		ledger.Current[entry.Purpose] = entry.Granted
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ledger)
+
+	return
+})
@@ -0,0 +1,3 @@
+// Package consent provides HTTP Handler(s) for recording and reading a [users.User]'s append-only consent ledger. A
+// user's marketing attribute is derived from their latest "marketing" ledger entry.
+package consent
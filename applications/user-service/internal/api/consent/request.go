@@ -0,0 +1,88 @@
+package consent
+
+import (
+	"net/netip"
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+
+	"user-service/internal/library/server"
+	"user-service/models/consents"
+)
+
+// purpose represents the set of valid consent purpose(s), matching the "consent-purpose-constraint" check.
+var purpose = regexp.MustCompile(`^[a-z][a-z0-9-]{0,63}$`)
+
+// Body represents the handler's structured request-body
+type Body struct {
+	server.Helper `json:"-"`
+
+	Purpose string  `json:"purpose" validate:"required,purpose"`     // Purpose represents the processing purpose the decision applies to (e.g. "marketing").
+	Version *string `json:"version" validate:"omitnil,min=1,max=64"` // Version represents the optional version of the terms text presented to the user. Defaults to [consents.Version].
+	Granted *bool   `json:"granted" validate:"required"`             // Granted is true when consent is given, and false when consent is withdrawn.
+	Source  *string `json:"source" validate:"omitnil,min=1,max=64"`  // Source represents the optional channel through which the decision was captured. Defaults to [consents.API].
+}
+
+// Parameters converts the request-body into a consent ledger entry for the given [users.User] identifier.
+func (b *Body) Parameters(id int64, address *netip.Addr) *consents.RecordParams {
+	parameters := &consents.RecordParams{
+		UserID:  id,
+		Purpose: b.Purpose,
+		Version: consents.Version(),
+		Source:  consents.API,
+		Address: address,
+	}
+
+	if b.Version != nil {
+		parameters.Version = *(b.Version)
+	}
+
+	if b.Source != nil {
+		parameters.Source = *(b.Source)
+	}
+
+	if b.Granted != nil {
+		parameters.Granted = *(b.Granted)
+	}
+
+	return parameters
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"purpose": {
+			Value:   b.Purpose,
+			Valid:   purpose.MatchString(b.Purpose),
+			Message: "(Required) The processing purpose (e.g. \"marketing\"). Lowercase alphanumeric characters and hyphens, at most 64 characters.",
+		},
+		"version": {
+			Value:   b.Version,
+			Valid:   b.Version == nil || (len(*(b.Version)) >= 1 && len(*(b.Version)) <= 64),
+			Message: "(Optional) The version of the terms text presented to the user. Defaults to the service's current terms version.",
+		},
+		"granted": {
+			Value:   b.Granted,
+			Valid:   b.Granted != nil,
+			Message: "(Required) True when consent is given; false when consent is withdrawn.",
+		},
+		"source": {
+			Value:   b.Source,
+			Valid:   b.Source == nil || (len(*(b.Source)) >= 1 && len(*(b.Source)) <= 64),
+			Message: "(Optional) The channel through which the decision was captured (e.g. \"web\", \"mobile\"). Defaults to \"api\".",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+func init() {
+	v.RegisterValidation("purpose", func(fl validator.FieldLevel) bool {
+		return purpose.MatchString(fl.Field().String())
+	})
+}
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
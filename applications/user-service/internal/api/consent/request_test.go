@@ -0,0 +1,52 @@
+package consent
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"user-service/internal/library/server"
+	"user-service/models/consents"
+)
+
+func TestBody(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Defaults", func(t *testing.T) {
+		var input Body
+		if _, e := server.Validate(ctx, v, bytes.NewReader([]byte(`{"purpose": "marketing", "granted": true}`)), &input); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		parameters := input.Parameters(1, nil)
+		if !(parameters.Granted) || parameters.Source != consents.API || parameters.Version != consents.Version() {
+			t.Errorf("Unexpected Parameters: %+v", parameters)
+		}
+	})
+
+	t.Run("Withdrawn", func(t *testing.T) {
+		var input Body
+		if _, e := server.Validate(ctx, v, bytes.NewReader([]byte(`{"purpose": "marketing", "granted": false, "version": "2024-01", "source": "web"}`)), &input); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		parameters := input.Parameters(1, nil)
+		if parameters.Granted || parameters.Source != "web" || parameters.Version != "2024-01" {
+			t.Errorf("Unexpected Parameters: %+v", parameters)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, document := range []string{
+			`{"granted": true}`,
+			`{"purpose": "marketing"}`,
+			`{"purpose": "Marketing!", "granted": true}`,
+			`{"purpose": "marketing", "granted": true, "source": ""}`,
+		} {
+			var input Body
+			if _, e := server.Validate(ctx, v, bytes.NewReader([]byte(document)), &input); e == nil {
+				t.Errorf("Document (%s): Expected Validation Error", document)
+			}
+		}
+	})
+}
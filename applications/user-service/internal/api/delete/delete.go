@@ -1,36 +1,37 @@
 package delete
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
-	"maps"
 	"net/http"
 	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"user-service/internal/api/deletion"
 	"user-service/internal/library/middleware"
 	"user-service/internal/library/middleware/authentication"
-	"user-service/internal/library/middleware/telemetrics"
 	"user-service/internal/library/server"
 	"user-service/internal/library/server/cookies"
-	"user-service/internal/library/server/telemetry"
 
 	"user-service/internal/database"
+	"user-service/models/deletions"
 	"user-service/models/users"
 )
 
-// Handler is an HTTP handler for processing user deletion requests, supporting both soft and hard delete operations with
-// authentication and transaction handling.
+// Handler is an HTTP handler for processing user deletion requests, supporting both soft and hard delete operations. The
+// handler establishes a [deletion] saga -- which removes the user's record(s) across the authentication-service,
+// user-service, and verification-service -- and responds with the saga's status location. Repeating the request while a
+// saga is in-flight returns the in-flight saga.
 var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	const name = "delete"
 
@@ -43,9 +44,7 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	defer span.End()
 
 	// Retrieve authentication context.
-	token := authentication.New().Value(ctx).Token
-
-	claims := token.Claims.(jwt.MapClaims)
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
 
 	email, e := claims.GetSubject()
 	if e != nil {
@@ -64,6 +63,14 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Determine the type of delete operation is being requested -- defaults to soft.
+	operation := deletions.Soft
+	if strings.ToLower(r.URL.Query().Get("type")) == deletions.Hard {
+		operation = deletions.Hard
+	}
+
+	slog.DebugContext(ctx, "Delete User Operation", slog.String("type", operation))
+
 	// Establish connection to database.
 	connection, e := database.Connection(ctx)
 	if e != nil {
@@ -86,144 +93,77 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 	defer database.Disconnect(ctx, connection, tx)
 
-	// Determine the type of delete operation is being requested -- defaults to soft.
-	operation := strings.ToLower(r.URL.Query().Get("type"))
-	if operation == "" {
-		operation = "soft"
+	// A hard delete applies regardless if the user has been soft deleted.
+	exists, lookup := users.New().Exists, func(ctx context.Context, db users.DBTX, id int64) (string, error) {
+		row, e := users.New().GetUserEmailAddressByID(ctx, db, id)
+		return row.Email, e
 	}
 
-	slog.DebugContext(ctx, "Delete User Operation", slog.String("type", operation))
-
-	if operation == "hard" {
-		// Check if the database record exists (hard).
-		exists, e := users.New().ExistsForce(ctx, tx, id)
-		if e != nil {
-			slog.ErrorContext(ctx, "Unable to Check if User Exists", slog.String("error", e.Error()), slog.String("operation", "hard"))
-			labeler.Add(attribute.Bool("error", true))
-
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
+	if operation == deletions.Hard {
+		exists, lookup = users.New().ExistsForce, func(ctx context.Context, db users.DBTX, id int64) (string, error) {
+			row, e := users.New().GetUserEmailAddressByIDForce(ctx, db, id)
+			return row.Email, e
 		}
+	}
 
-		if !(exists) {
-			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
-			return
-		}
+	// Check if the database record exists.
+	found, e := exists(ctx, tx, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Check if User Exists", slog.String("error", e.Error()), slog.String("operation", operation))
+		labeler.Add(attribute.Bool("error", true))
 
-		// Ensure database record's email-address matches authenticated user.
-
-		{
-			row, e := users.New().GetUserEmailAddressByIDForce(ctx, tx, id)
-			if e != nil {
-				slog.ErrorContext(ctx, "Unable to Query User for Email & ID Information",
-					slog.Int64("id", id),
-					slog.String("email", email),
-					slog.String("error", e.Error()),
-					slog.String("error-type", reflect.TypeOf(e).String()),
-				)
-
-				labeler.Add(attribute.Bool("error", true))
-				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-				return
-			}
-
-			if email != row.Email {
-				slog.ErrorContext(ctx, "Potential Hijack, Token Forgery Event",
-					slog.Int64("id", id),
-					slog.String("authentication-email", email),
-					slog.String("database-record-email", row.Email),
-				)
-
-				labeler.Add(attribute.Bool("error", true))
-				labeler.Add(attribute.Bool("security-risk", true))
-
-				exception := server.Exception{
-					Code:    http.StatusForbidden,
-					Message: "Email mismatch: You are not authorized to delete the user record.",
-					Internal: &server.Internal{
-						Error:   fmt.Errorf("email mismatch: user is not authorized to delete the target database record"),
-						Message: "Potential Database Inconsistency, Hijack, or Token Forgery Event Detected",
-					},
-				}
-
-				exception.Response(w)
-				return
-			}
-		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 
-		if e := users.New().DeleteHard(ctx, tx, id); e != nil {
-			slog.ErrorContext(ctx, "Unable to Delete (Hard) User Record",
-				slog.Int64("id", id),
-				slog.String("email", email),
-				slog.String("error", e.Error()),
-				slog.String("error-type", reflect.TypeOf(e).String()),
-			)
+	if !(found) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
 
-			labeler.Add(attribute.Bool("error", true))
-			http.Error(w, "Unable to Remove User", http.StatusInternalServerError)
-			return
-		}
+	// Ensure database record's email-address matches authenticated user.
+	address, e := lookup(ctx, tx, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query User for Email & ID Information",
+			slog.Int64("id", id),
+			slog.String("email", email),
+			slog.String("error", e.Error()),
+			slog.String("error-type", reflect.TypeOf(e).String()),
+		)
 
-		slog.InfoContext(ctx, "Successfully Removed User Record", slog.String("email", email), slog.Int64("id", id), slog.String("operation", "hard"))
-	} else { // --> default condition
-		// Check if the database record exists (soft).
-		exists, e := users.New().Exists(ctx, tx, id)
-		if e != nil {
-			slog.ErrorContext(ctx, "Unable to Check if User Exists", slog.String("error", e.Error()), slog.String("operation", "soft"))
-			labeler.Add(attribute.Bool("error", true))
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
-		}
+	if email != address {
+		slog.ErrorContext(ctx, "Potential Hijack, Token Forgery Event",
+			slog.Int64("id", id),
+			slog.String("authentication-email", email),
+			slog.String("database-record-email", address),
+		)
 
-		if !(exists) {
-			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
-			return
-		}
+		labeler.Add(attribute.Bool("error", true))
+		labeler.Add(attribute.Bool("security-risk", true))
 
-		// Ensure database record's email-address matches authenticated user.
-
-		{
-			row, e := users.New().GetUserEmailAddressByID(ctx, tx, id)
-			if e != nil {
-				slog.ErrorContext(ctx, "Unable to Query User for Email & ID Information",
-					slog.Int64("id", id),
-					slog.String("email", email),
-					slog.String("error", e.Error()),
-					slog.String("error-type", reflect.TypeOf(e).String()),
-				)
-
-				labeler.Add(attribute.Bool("error", true))
-				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-				return
-			}
-
-			if email != row.Email {
-				slog.ErrorContext(ctx, "Potential Hijack, Token Forgery Event",
-					slog.Int64("id", id),
-					slog.String("authentication-email", email),
-					slog.String("database-record-email", row.Email),
-				)
-
-				labeler.Add(attribute.Bool("error", true))
-				labeler.Add(attribute.Bool("security-risk", true))
-
-				exception := server.Exception{
-					Code:    http.StatusForbidden,
-					Message: "Email mismatch: You are not authorized to delete the user record.",
-					Internal: &server.Internal{
-						Error:   fmt.Errorf("email mismatch: user is not authorized to delete the target database record"),
-						Message: "Potential Database Inconsistency, Hijack, or Token Forgery Event Detected",
-					},
-				}
-
-				exception.Response(w)
-				return
-			}
+		exception := server.Exception{
+			Code:    http.StatusForbidden,
+			Message: "Email mismatch: You are not authorized to delete the user record.",
+			Internal: &server.Internal{
+				Error:   fmt.Errorf("email mismatch: user is not authorized to delete the target database record"),
+				Message: "Potential Database Inconsistency, Hijack, or Token Forgery Event Detected",
+			},
 		}
 
-		if e := users.New().DeleteSoft(ctx, tx, id); e != nil {
-			slog.ErrorContext(ctx, "Unable to Delete (Soft) User Record",
+		exception.Response(w)
+		return
+	}
+
+	// Reuse an in-flight saga, if any.
+	saga, e := deletions.New().Active(ctx, tx, id)
+	if errors.Is(e, pgx.ErrNoRows) {
+		if saga, e = deletion.Begin(ctx, tx, id, email, operation); e != nil {
+			slog.ErrorContext(ctx, "Unable to Begin Deletion Saga",
 				slog.Int64("id", id),
 				slog.String("email", email),
 				slog.String("error", e.Error()),
@@ -234,176 +174,11 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "Unable to Remove User", http.StatusInternalServerError)
 			return
 		}
+	} else if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query Active Deletion Saga", slog.Int64("id", id), slog.String("error", e.Error()))
 
-		slog.InfoContext(ctx, "Successfully Removed User Record", slog.String("email", email), slog.Int64("id", id), slog.String("operation", "soft"))
-	}
-
-	// Remove the user in authentication-service
-	var events = func() error { // --> only internal server errors relative to the current service will return an error
-		headers := telemetrics.New().Value(ctx).Headers
-		maps.Copy(headers, map[string]string{
-			"Authorization": fmt.Sprintf("Bearer %s", token.Raw),
-		})
-
-		c := telemetry.Client(headers)
-
-		var reader bytes.Buffer
-		if e := json.NewEncoder(&reader).Encode(map[string]string{"email": email}); e != nil {
-			e = fmt.Errorf("unable to encode email address: %w", e)
-
-			slog.ErrorContext(ctx, "Unable to Encode Email", slog.String("error", e.Error()))
-
-			return e
-		}
-
-		// Get the authentication-service user's identifier.
-		var identifier float64 // --> JSON integers always unmarshal to float64
-
-		{
-			force := "false"
-			if operation == "hard" {
-				force = "true"
-			}
-
-			url := fmt.Sprintf("%s://%s:%d/session?force=%s", "http", "authentication-service", 8080, force)
-			if override, ok := ctx.Value("authentication-service-session-endpoint").(string); ok {
-				url = override // currently used for overriding the user-service endpoint during unit-testing
-			}
-
-			slog.DebugContext(ctx, "Authentication-Service Session URL", slog.String("url", url))
-
-			request, e := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-			if e != nil {
-				slog.WarnContext(ctx, "Unable to Generate Request", slog.String("error", e.Error()))
-
-				return nil
-			}
-
-			response, e := c.Do(request)
-			if e != nil {
-				switch {
-				case strings.Contains(e.Error(), "no such host"):
-					slog.WarnContext(ctx, "Authentication-Service User-Session Endpoint Not Found", slog.String("error", e.Error()))
-					// --> occurs during local testing due to lack of internal kubernetes networking
-					return nil
-				default:
-					slog.WarnContext(ctx, "Unable to Send Request", slog.String("error", e.Error()))
-
-					return e
-				}
-			}
-
-			defer response.Body.Close()
-
-			content, e := io.ReadAll(response.Body)
-			if e != nil {
-				slog.WarnContext(ctx, "Unable to Read Raw Response", slog.String("error", e.Error()))
-
-				return nil
-			}
-
-			if response.StatusCode != http.StatusOK {
-				switch response.StatusCode {
-				default:
-					slog.WarnContext(ctx, "Authentication-Service Session Endpoint Fatal Error - Unhandled Status-Code", slog.String("content", string(content)), slog.String("status", response.Status), slog.Int("status-code", response.StatusCode))
-
-					exception := server.Exception{Code: response.StatusCode, Status: response.Status}
-
-					return &exception
-				}
-			}
-
-			slog.InfoContext(ctx, "User-Service Registration Response", slog.String("content", string(content)), slog.String("status", response.Status), slog.Int("status-code", response.StatusCode))
-
-			var datum map[string]interface{}
-			if e := json.Unmarshal(content, &datum); e != nil {
-				slog.ErrorContext(ctx, "Unable to Unmarshal JSON", slog.String("error", e.Error()))
-
-				return e
-			}
-
-			v, ok := datum["id"]
-			if !ok {
-				slog.ErrorContext(ctx, "Unable to Get ID Information from Authentication-Service Session Endpoint", slog.String("key", "id"), slog.Any("map", datum))
-
-				return fmt.Errorf("unable to get id information from authetnication-service session endpoint")
-			}
-
-			typecast, valid := v.(float64)
-			if !valid {
-				slog.ErrorContext(ctx, "Unable to Typecast ID to Integer from Authentication-Service Session Endpoint", slog.Any("value", v), slog.Any("response", datum), slog.String("type", reflect.TypeOf(v).String()))
-
-				return fmt.Errorf("unable to get id information from authetnication-service session endpoint")
-			}
-
-			identifier = typecast
-		}
-
-		url := fmt.Sprintf("%s://%s:%d/users/%d?type=%s", "http", "authentication-service", 8080, int64(identifier), operation)
-		if override, ok := ctx.Value("authentication-service-user-delete-endpoint").(string); ok {
-			url = override // currently used for overriding the user-service endpoint during unit-testing
-		}
-
-		slog.DebugContext(ctx, "Authentication-Service Delete-User URL", slog.String("url", url))
-
-		request, e := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-		if e != nil {
-			slog.WarnContext(ctx, "Unable to Generate Request", slog.String("error", e.Error()))
-
-			return nil
-		}
-
-		response, e := c.Do(request)
-		if e != nil {
-			switch {
-			case strings.Contains(e.Error(), "no such host"):
-				slog.WarnContext(ctx, "Authentication-Service User-Delete Endpoint Not Found", slog.String("error", e.Error()))
-				// --> occurs during local testing due to lack of internal kubernetes networking
-				return nil
-			default:
-				slog.WarnContext(ctx, "Unable to Send Request", slog.String("error", e.Error()))
-
-				return e
-			}
-		}
-
-		defer response.Body.Close()
-
-		content, e := io.ReadAll(response.Body)
-		if e != nil {
-			slog.WarnContext(ctx, "Unable to Read Raw Response", slog.String("error", e.Error()))
-
-			return nil
-		}
-
-		if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
-			switch response.StatusCode {
-			default:
-				slog.WarnContext(ctx, "Authentication-Service User-Delete Endpoint Fatal Error - Unhandled Status-Code", slog.String("content", string(content)), slog.String("status", response.Status), slog.Int("status-code", response.StatusCode))
-
-				exception := server.Exception{Code: response.StatusCode, Status: response.Status}
-
-				return &exception
-			}
-		}
-
-		return nil
-	}
-
-	if e := events(); e != nil {
 		labeler.Add(attribute.Bool("error", true))
-
-		var exception *server.Exception
-		if errors.As(e, &exception) {
-			slog.ErrorContext(ctx, "Authentication-Service Event Error", slog.String("error", e.Error()))
-			exception.Response(w)
-			return
-		}
-
-		slog.ErrorContext(ctx, "Authentication-Service Unhandled Event Error", slog.String("error", e.Error()))
-
-		http.Error(w, "Unhandled Exception", http.StatusInternalServerError)
-
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
@@ -418,9 +193,16 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slog.DebugContext(ctx, "Successfully Removed User Record", slog.String("email", email), slog.Int64("id", id))
+	deletion.Wake()
+
+	slog.InfoContext(ctx, "Successfully Scheduled User Record Removal", slog.String("email", email), slog.Int64("id", id), slog.Int64("deletion", saga.ID), slog.String("operation", saga.Type))
 
 	cookies.Delete(w, "token")
-	w.WriteHeader(http.StatusNoContent)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/deletions/%d", saga.ID))
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(deletion.Response{Deletion: saga, Steps: []deletions.DeletionStep{}})
+
 	return
 })
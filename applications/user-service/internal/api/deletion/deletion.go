@@ -0,0 +1,128 @@
+package deletion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/database"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/server"
+	"user-service/models/deletions"
+)
+
+// Response represents a deletion saga's status response body.
+type Response struct {
+	deletions.Deletion
+
+	Steps []deletions.DeletionStep `json:"steps"` // Steps represents the saga's step(s) in execution order.
+}
+
+// Status is an HTTP handler that returns the authenticated user's deletion saga, including each step's status, attempt(s),
+// and most recent error. Ownership is established by the saga's recorded email address, as the user's record may no
+// longer exist.
+var Status = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "deletion-status"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// Get the saga's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	saga, e := deletions.New().Get(ctx, connection, id)
+	if errors.Is(e, pgx.ErrNoRows) {
+		slog.WarnContext(ctx, "Deletion Saga Not Found", slog.Int64("deletion", id))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	} else if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query Deletion Saga", slog.Int64("deletion", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if email != saga.Email {
+		slog.ErrorContext(ctx, "Potential Hijack, Token Forgery Event",
+			slog.Int64("deletion", id),
+			slog.String("authentication-email", email),
+			slog.String("database-record-email", saga.Email),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		labeler.Add(attribute.Bool("security-risk", true))
+
+		exception := server.Exception{
+			Code:    http.StatusForbidden,
+			Message: "Email mismatch: You are not authorized to access the deletion.",
+			Internal: &server.Internal{
+				Error:   fmt.Errorf("email mismatch: user is not authorized to access the target database record"),
+				Message: "Potential Database Inconsistency, Hijack, or Token Forgery Event Detected",
+			},
+		}
+
+		exception.Response(w)
+		return
+	}
+
+	steps, e := deletions.New().Steps(ctx, connection, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query Deletion Saga Step(s)", slog.Int64("deletion", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Response{Deletion: saga, Steps: steps})
+
+	return
+})
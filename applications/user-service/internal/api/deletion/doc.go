@@ -0,0 +1,4 @@
+// Package deletion orchestrates account deletion as a durable saga across the authentication-service, user-service, and
+// verification-service. Each saga and its step(s) are persisted, retried with exponential backoff, and -- for soft
+// deletes -- compensated in reverse order when a step fails permanently.
+package deletion
@@ -0,0 +1,336 @@
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"user-service/internal/database"
+	"user-service/internal/library/events"
+	"user-service/models/deletions"
+)
+
+const (
+	attempts = 8                // attempts represents the maximum number of attempt(s) of a step's action.
+	base     = 5 * time.Second  // base represents the delay following a step's first failed attempt.
+	ceiling  = 10 * time.Minute // ceiling represents the maximum delay between a step's attempt(s).
+	lease    = 5 * time.Minute  // lease represents the duration a claimed saga is reserved for its claimant.
+	interval = 15 * time.Second // interval represents the delay between polling for due saga(s).
+	batch    = 10               // batch represents the maximum number of saga(s) claimed at once.
+)
+
+// Backoff returns the delay preceding a step's next attempt, doubling from [base] with each failed attempt up to
+// [ceiling].
+func Backoff(failures int) time.Duration {
+	if failures < 1 {
+		return base
+	}
+
+	delay := base
+	for range failures - 1 {
+		delay *= 2
+		if delay >= ceiling {
+			return ceiling
+		}
+	}
+
+	return delay
+}
+
+// wake signals [Orchestrate] to poll for due saga(s) ahead of its interval.
+var wake = make(chan struct{}, 1)
+
+// Wake signals the orchestrator that a saga is due, without blocking.
+func Wake() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Begin establishes a pending saga -- and a pending step per [Actions] entry -- within the caller's transaction. The
+// caller should [Wake] the orchestrator once the transaction commits.
+func Begin(ctx context.Context, tx pgx.Tx, user int64, email, kind string) (deletions.Deletion, error) {
+	saga, e := deletions.New().Create(ctx, tx, &deletions.CreateParams{UserID: user, Email: email, Type: kind})
+	if e != nil {
+		return saga, fmt.Errorf("unable to create deletion saga: %w", e)
+	}
+
+	for index, a := range Actions {
+		if _, e := deletions.New().Step(ctx, tx, &deletions.StepParams{Deletion: saga.ID, Sequence: int16(index), Service: a.Service}); e != nil {
+			return saga, fmt.Errorf("unable to create %s deletion step: %w", a.Service, e)
+		}
+	}
+
+	return saga, nil
+}
+
+// Orchestrate claims and advances due saga(s) until the context is cancelled. Because saga state is persisted and claims
+// are leased, any number of replicas may orchestrate concurrently, and saga(s) interrupted by a restart resume once their
+// lease expires.
+func Orchestrate(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "Starting Deletion Saga Orchestrator")
+
+	for {
+		drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Stopping Deletion Saga Orchestrator")
+			return
+		case <-ticker.C:
+		case <-wake:
+		}
+	}
+}
+
+// drain advances claimed saga(s) until none are due.
+func drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		var claimed []deletions.Deletion
+		if e := update(ctx, func(db deletions.DBTX) (e error) {
+			claimed, e = deletions.New().Claim(ctx, db, &deletions.ClaimParams{Lease: lease.Seconds(), Size: batch})
+			return e
+		}); e != nil {
+			slog.ErrorContext(ctx, "Unable to Claim Deletion Saga(s)", slog.String("error", e.Error()))
+			return
+		}
+
+		for _, saga := range claimed {
+			if e := Advance(ctx, saga); e != nil {
+				slog.ErrorContext(ctx, "Unable to Advance Deletion Saga", slog.Int64("deletion", saga.ID), slog.String("error", e.Error()))
+			}
+		}
+
+		if len(claimed) < batch {
+			return
+		}
+	}
+}
+
+// Advance executes the saga's pending step(s) in order until the saga completes, or a step must be retried. A step that
+// fails permanently -- or exhausts its attempt(s) -- transitions the saga to compensation, which reverts completed step(s)
+// in reverse order. An error is only returned when the saga's state can't be read or recorded; the saga is then retried
+// once its lease expires.
+func Advance(ctx context.Context, saga deletions.Deletion) error {
+	steps, e := load(ctx, saga.ID)
+	if e != nil {
+		return e
+	}
+
+	switch saga.Status {
+	case deletions.Pending, deletions.Running:
+		if saga.Status == deletions.Pending {
+			if e := transition(ctx, saga.ID, deletions.Running, nil); e != nil {
+				return e
+			}
+		}
+
+		for _, step := range steps {
+			if step.Status == deletions.Complete {
+				continue
+			}
+
+			a, ok := action(step.Service)
+			if !(ok) {
+				return fmt.Errorf("unknown deletion step service: %s", step.Service)
+			}
+
+			failure := a.Execute(ctx, saga)
+			if failure == nil {
+				slog.InfoContext(ctx, "Completed Deletion Step", slog.Int64("deletion", saga.ID), slog.String("service", step.Service), slog.String("type", saga.Type))
+
+				if e := progress(ctx, step.ID, deletions.Complete); e != nil {
+					return e
+				}
+
+				continue
+			}
+
+			retry, e := attempt(ctx, saga, step, failure)
+			if e != nil || retry {
+				return e
+			}
+
+			// --> the step failed permanently; revert the completed step(s)
+			slog.WarnContext(ctx, "Deletion Step Failed - Compensating", slog.Int64("deletion", saga.ID), slog.String("service", step.Service), slog.String("error", failure.Error()))
+
+			message := fmt.Sprintf("%s: %s", step.Service, failure.Error())
+			if e := update(ctx, func(db deletions.DBTX) error {
+				return deletions.New().Fail(ctx, db, &deletions.FailParams{ID: step.ID, Error: &message})
+			}); e != nil {
+				return e
+			}
+
+			if e := transition(ctx, saga.ID, deletions.Compensating, &message); e != nil {
+				return e
+			}
+
+			saga.Status, saga.Error = deletions.Compensating, &message
+
+			return compensate(ctx, saga)
+		}
+
+		slog.InfoContext(ctx, "Successfully Completed Deletion Saga", slog.Int64("deletion", saga.ID), slog.Int64("id", saga.User), slog.String("type", saga.Type))
+
+		if e := transition(ctx, saga.ID, deletions.Complete, nil); e != nil {
+			return e
+		}
+
+		events.Emit(ctx, events.Deleted, strconv.FormatInt(saga.User, 10), events.UserDeleted{ID: saga.User, Type: saga.Type, Deletion: saga.ID})
+
+		return nil
+	case deletions.Compensating:
+		return compensate(ctx, saga)
+	}
+
+	return nil
+}
+
+// compensate reverts the saga's completed step(s) in reverse order. Step(s) that can't be compensated remain complete,
+// and the saga fails rather than reporting a compensated state.
+func compensate(ctx context.Context, saga deletions.Deletion) error {
+	steps, e := load(ctx, saga.ID)
+	if e != nil {
+		return e
+	}
+
+	var irreversible []string
+	for index := len(steps) - 1; index >= 0; index-- {
+		step := steps[index]
+		if step.Status != deletions.Complete && step.Status != deletions.Compensating {
+			continue
+		}
+
+		a, ok := action(step.Service)
+		if !(ok) || !(a.Compensable(saga)) {
+			irreversible = append(irreversible, step.Service)
+			continue
+		}
+
+		if step.Status == deletions.Complete {
+			if e := progress(ctx, step.ID, deletions.Compensating); e != nil {
+				return e
+			}
+		}
+
+		failure := a.Compensate(ctx, saga)
+		if failure == nil {
+			slog.InfoContext(ctx, "Compensated Deletion Step", slog.Int64("deletion", saga.ID), slog.String("service", step.Service))
+
+			if e := progress(ctx, step.ID, deletions.Compensated); e != nil {
+				return e
+			}
+
+			continue
+		}
+
+		retry, e := attempt(ctx, saga, step, failure)
+		if e != nil || retry {
+			return e
+		}
+
+		slog.ErrorContext(ctx, "Unable to Compensate Deletion Step", slog.Int64("deletion", saga.ID), slog.String("service", step.Service), slog.String("error", failure.Error()))
+
+		message := fmt.Sprintf("unable to compensate %s: %s", step.Service, failure.Error())
+		if e := update(ctx, func(db deletions.DBTX) error {
+			return deletions.New().Fail(ctx, db, &deletions.FailParams{ID: step.ID, Error: &message})
+		}); e != nil {
+			return e
+		}
+
+		return transition(ctx, saga.ID, deletions.Failed, &message)
+	}
+
+	if len(irreversible) > 0 {
+		message := fmt.Sprintf("%s; unable to compensate completed step(s): %v", deref(saga.Error), irreversible)
+
+		slog.ErrorContext(ctx, "Deletion Saga Failed - Manual Intervention Required", slog.Int64("deletion", saga.ID), slog.Int64("id", saga.User), slog.Any("irreversible", irreversible))
+
+		return transition(ctx, saga.ID, deletions.Failed, &message)
+	}
+
+	slog.InfoContext(ctx, "Successfully Compensated Deletion Saga", slog.Int64("deletion", saga.ID), slog.Int64("id", saga.User))
+
+	return transition(ctx, saga.ID, deletions.Compensated, saga.Error)
+}
+
+// attempt records the step's failed attempt. A transient failure with remaining attempt(s) reschedules the saga
+// according to [Backoff] and reports true.
+func attempt(ctx context.Context, saga deletions.Deletion, step deletions.DeletionStep, failure error) (retry bool, e error) {
+	message := failure.Error()
+
+	var record deletions.DeletionStep
+	if e := update(ctx, func(db deletions.DBTX) (e error) {
+		record, e = deletions.New().Attempt(ctx, db, &deletions.AttemptParams{ID: step.ID, Error: &message})
+		return e
+	}); e != nil {
+		return false, e
+	}
+
+	if Permanent(failure) || record.Attempts >= attempts {
+		return false, nil
+	}
+
+	delay := Backoff(int(record.Attempts))
+
+	slog.WarnContext(ctx, "Deletion Step Attempt Failed - Retrying", slog.Int64("deletion", saga.ID), slog.String("service", step.Service), slog.Int("attempt", int(record.Attempts)), slog.Duration("delay", delay), slog.String("error", message))
+
+	schedule := pgtype.Timestamptz{Time: time.Now().Add(delay), Valid: true}
+
+	return true, update(ctx, func(db deletions.DBTX) error {
+		return deletions.New().Schedule(ctx, db, &deletions.ScheduleParams{ID: saga.ID, Schedule: schedule})
+	})
+}
+
+// load retrieves the saga's step(s) in execution order.
+func load(ctx context.Context, id int64) (steps []deletions.DeletionStep, e error) {
+	e = update(ctx, func(db deletions.DBTX) (e error) {
+		steps, e = deletions.New().Steps(ctx, db, id)
+		return e
+	})
+
+	return steps, e
+}
+
+// transition updates the saga's status.
+func transition(ctx context.Context, id int64, status string, message *string) error {
+	return update(ctx, func(db deletions.DBTX) error {
+		return deletions.New().Transition(ctx, db, &deletions.TransitionParams{ID: id, Status: status, Error: message})
+	})
+}
+
+// progress updates the step's status.
+func progress(ctx context.Context, id int64, status string) error {
+	return update(ctx, func(db deletions.DBTX) error {
+		return deletions.New().Progress(ctx, db, &deletions.ProgressParams{ID: id, Status: status})
+	})
+}
+
+// update executes the function against a pooled database connection.
+func update(ctx context.Context, fn func(db deletions.DBTX) error) error {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	return fn(connection)
+}
+
+func deref(v *string) string {
+	if v == nil {
+		return ""
+	}
+
+	return *v
+}
@@ -0,0 +1,177 @@
+package deletion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/models/deletions"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 0, expected: base},
+		{failures: 1, expected: base},
+		{failures: 2, expected: 2 * base},
+		{failures: 4, expected: 8 * base},
+		{failures: 64, expected: ceiling},
+	}
+
+	for _, test := range tests {
+		if v := Backoff(test.failures); v != test.expected {
+			t.Errorf("Backoff(%d): Expected (%s), Received (%s)", test.failures, test.expected, v)
+		}
+	}
+}
+
+func TestCompensable(t *testing.T) {
+	for _, a := range Actions {
+		if a.Compensable(deletions.Deletion{Type: deletions.Hard}) {
+			t.Errorf("Expected Hard Deletion to be Irreversible (%s)", a.Service)
+		}
+	}
+
+	if a, _ := action("verification-service"); a.Compensable(deletions.Deletion{Type: deletions.Soft}) {
+		t.Errorf("Expected Verification-Service Step to be Irreversible")
+	}
+
+	if a, _ := action("authentication-service"); !(a.Compensable(deletions.Deletion{Type: deletions.Soft})) {
+		t.Errorf("Expected Soft Authentication-Service Step to be Compensable")
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	saga := deletions.Deletion{ID: 1, User: 1, Email: "test-deletion-saga@x-ethr.gg", Type: deletions.Soft}
+
+	// endpoints serves the authentication-service session and delete endpoint(s) with the given status(es), counting the
+	// delete endpoint's call(s).
+	endpoints := func(session, removal int) (*httptest.Server, *int) {
+		var calls int
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /session", func(w http.ResponseWriter, r *http.Request) {
+			if !(strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			if session != http.StatusOK {
+				http.Error(w, http.StatusText(session), session)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":7,"email":"test-deletion-saga@x-ethr.gg"}`))
+		})
+
+		mux.HandleFunc("DELETE /users/7", func(w http.ResponseWriter, r *http.Request) {
+			calls++
+
+			if r.URL.Query().Get("type") != deletions.Soft {
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+
+			w.WriteHeader(removal)
+		})
+
+		return httptest.NewServer(mux), &calls
+	}
+
+	overrides := func(url string) context.Context {
+		ctx := context.WithValue(context.Background(), "authentication-service-session-endpoint", url+"/session")
+		return context.WithValue(ctx, "authentication-service-user-delete-endpoint", url+"/users/7?type="+saga.Type)
+	}
+
+	t.Run("Deleted", func(t *testing.T) {
+		server, calls := endpoints(http.StatusOK, http.StatusNoContent)
+		defer server.Close()
+
+		if e := credentials(overrides(server.URL), saga); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if *calls != 1 {
+			t.Errorf("Expected a Single Delete Call, Received (%d)", *calls)
+		}
+	})
+
+	t.Run("Already-Removed", func(t *testing.T) {
+		server, calls := endpoints(http.StatusNotFound, http.StatusNoContent)
+		defer server.Close()
+
+		if e := credentials(overrides(server.URL), saga); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if *calls != 0 {
+			t.Errorf("Expected No Delete Call(s), Received (%d)", *calls)
+		}
+	})
+
+	t.Run("Transient", func(t *testing.T) {
+		server, _ := endpoints(http.StatusOK, http.StatusServiceUnavailable)
+		defer server.Close()
+
+		e := credentials(overrides(server.URL), saga)
+		if e == nil || Permanent(e) {
+			t.Errorf("Expected Transient Error, Received (%v)", e)
+		}
+	})
+
+	t.Run("Permanent", func(t *testing.T) {
+		server, _ := endpoints(http.StatusOK, http.StatusForbidden)
+		defer server.Close()
+
+		if e := credentials(overrides(server.URL), saga); !(Permanent(e)) {
+			t.Errorf("Expected Permanent Error, Received (%v)", e)
+		}
+	})
+}
+
+func TestVerification(t *testing.T) {
+	saga := deletions.Deletion{ID: 1, User: 1, Email: "test-deletion-saga@x-ethr.gg", Type: deletions.Hard}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || !(strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"deleted"}`))
+	}))
+
+	defer server.Close()
+
+	ctx := context.WithValue(context.Background(), "verification-service-delete-endpoint", server.URL)
+
+	if e := verification(ctx, saga); e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	server.Close()
+
+	if e := verification(ctx, saga); e == nil || Permanent(e) {
+		t.Errorf("Expected Transient Error for Unreachable Service, Received (%v)", e)
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	e := permanent{errors.New("example")}
+
+	if !(Permanent(e)) || !(Permanent(errors.Join(errors.New("wrapped"), e))) {
+		t.Errorf("Expected Permanent Error")
+	}
+
+	if Permanent(errors.New("example")) {
+		t.Errorf("Expected Transient Error")
+	}
+}
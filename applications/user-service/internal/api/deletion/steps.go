@@ -0,0 +1,322 @@
+package deletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"user-service/internal/database"
+	"user-service/internal/library/server/telemetry"
+	"user-service/internal/storage"
+	"user-service/internal/token"
+	"user-service/models/consents"
+	"user-service/models/deletions"
+	"user-service/models/exports"
+	"user-service/models/preferences"
+	"user-service/models/users"
+)
+
+// Action represents a single service's participation in a deletion saga. Both function(s) must be idempotent, as a step
+// may be attempted more than once.
+type Action struct {
+	// Service represents the participating service's name, as recorded against [deletions.DeletionStep.Service].
+	Service string
+
+	// Execute deletes the service's record(s).
+	Execute func(ctx context.Context, saga deletions.Deletion) error
+
+	// Compensate reverts a completed [Action.Execute]. A nil function, or a hard delete, denotes a step that can't be
+	// compensated.
+	Compensate func(ctx context.Context, saga deletions.Deletion) error
+}
+
+// Compensable reports whether the action can revert its execution for the given saga.
+func (a Action) Compensable(saga deletions.Deletion) bool {
+	return a.Compensate != nil && saga.Type == deletions.Soft
+}
+
+// Actions represents the saga's step(s) in execution order. The user-service step runs first so the account becomes
+// unusable immediately; the verification-service step runs last because it can't be compensated -- verification-service
+// re-creates a user's verification record upon their next status request.
+var Actions = []Action{
+	{Service: "user-service", Execute: local, Compensate: restore},
+	{Service: "authentication-service", Execute: credentials, Compensate: reinstate},
+	{Service: "verification-service", Execute: verification},
+}
+
+// action returns the [Action] associated with the service.
+func action(service string) (Action, bool) {
+	for _, a := range Actions {
+		if a.Service == service {
+			return a, true
+		}
+	}
+
+	return Action{}, false
+}
+
+// permanent represents a step failure that retrying can't resolve.
+type permanent struct {
+	error
+}
+
+func (p permanent) Unwrap() error {
+	return p.error
+}
+
+// Permanent reports whether the error can't be resolved by retrying.
+func Permanent(e error) bool {
+	var v permanent
+	return errors.As(e, &v)
+}
+
+// classify converts an unexpected response status into an error. Client error(s) -- other than rate-limiting and
+// timeouts -- are permanent.
+func classify(response *http.Response, content []byte) error {
+	e := fmt.Errorf("unexpected status (%s): %s", response.Status, string(content))
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests, response.StatusCode == http.StatusRequestTimeout:
+		return e
+	case response.StatusCode >= 400 && response.StatusCode < 500:
+		return permanent{e}
+	}
+
+	return e
+}
+
+// local deletes the user-service record(s). A hard delete additionally erases the user's consent ledger, preferences,
+// and export archive(s).
+func local(ctx context.Context, saga deletions.Deletion) error {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		database.Disconnect(ctx, connection, nil)
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	if saga.Type == deletions.Soft {
+		if e := users.New().DeleteSoft(ctx, tx, saga.User); e != nil {
+			return e
+		}
+
+		return tx.Commit(ctx)
+	}
+
+	if e := users.New().DeleteHard(ctx, tx, saga.User); e != nil {
+		return e
+	}
+
+	if e := consents.New().Purge(ctx, tx, saga.User); e != nil {
+		return e
+	}
+
+	if e := preferences.New().Purge(ctx, tx, saga.User); e != nil {
+		return e
+	}
+
+	keys, e := exports.New().Purge(ctx, tx, saga.User)
+	if e != nil {
+		return e
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		return e
+	}
+
+	// --> archive removal is best-effort; the database record(s) referencing them no longer exist
+	if len(keys) > 0 {
+		bucket, e := storage.Default(ctx)
+		if e != nil {
+			slog.WarnContext(ctx, "Unable to Establish Object Storage for Export Archive Removal", slog.String("error", e.Error()))
+			return nil
+		}
+
+		for _, key := range keys {
+			if key == nil {
+				continue
+			}
+
+			if e := bucket.Delete(ctx, *key); e != nil && !(errors.Is(e, storage.ErrNotFound)) {
+				slog.WarnContext(ctx, "Unable to Remove Export Archive", slog.String("key", *key), slog.String("error", e.Error()))
+			}
+		}
+	}
+
+	return nil
+}
+
+// restore compensates [local] by reverting the user-service record's soft delete.
+func restore(ctx context.Context, saga deletions.Deletion) error {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	return users.New().Restore(ctx, connection, saga.User)
+}
+
+// client returns a telemetry-capable client authorized on behalf of the saga's user.
+func client(ctx context.Context, saga deletions.Deletion) (*telemetry.Instance, error) {
+	jwt, e := token.Delegate(ctx, saga.Email)
+	if e != nil {
+		return nil, permanent{fmt.Errorf("unable to delegate authorization: %w", e)}
+	}
+
+	return telemetry.Client(map[string]string{"Authorization": fmt.Sprintf("Bearer %s", jwt)}), nil
+}
+
+// call sends a request, returning the response and its fully-read body.
+func call(ctx context.Context, c *telemetry.Instance, method, url string) (*http.Response, []byte, error) {
+	request, e := http.NewRequestWithContext(ctx, method, url, nil)
+	if e != nil {
+		return nil, nil, permanent{e}
+	}
+
+	response, e := c.Do(request)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	defer response.Body.Close()
+
+	content, e := io.ReadAll(response.Body)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	return response, content, nil
+}
+
+// identifier resolves the saga user's authentication-service identifier, regardless of soft delete. A false return
+// value denotes the authentication-service record no longer exists.
+func identifier(ctx context.Context, c *telemetry.Instance) (int64, bool, error) {
+	url := fmt.Sprintf("%s://%s:%d/session?force=true", "http", "authentication-service", 8080)
+	if override, ok := ctx.Value("authentication-service-session-endpoint").(string); ok {
+		url = override // currently used for overriding the endpoint during unit-testing
+	}
+
+	response, content, e := call(ctx, c, http.MethodGet, url)
+	if e != nil {
+		return 0, false, fmt.Errorf("unable to query authentication-service session: %w", e)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return 0, false, nil
+	default:
+		return 0, false, classify(response, content)
+	}
+
+	var datum struct {
+		ID int64 `json:"id"`
+	}
+
+	if e := json.Unmarshal(content, &datum); e != nil {
+		return 0, false, permanent{fmt.Errorf("unable to unmarshal authentication-service session: %w", e)}
+	}
+
+	return datum.ID, true, nil
+}
+
+// credentials deletes the authentication-service record.
+func credentials(ctx context.Context, saga deletions.Deletion) error {
+	c, e := client(ctx, saga)
+	if e != nil {
+		return e
+	}
+
+	id, exists, e := identifier(ctx, c)
+	if e != nil {
+		return e
+	} else if !(exists) {
+		slog.InfoContext(ctx, "Authentication-Service Record Already Removed", slog.Int64("deletion", saga.ID))
+		return nil
+	}
+
+	url := fmt.Sprintf("%s://%s:%d/users/%d?type=%s", "http", "authentication-service", 8080, id, saga.Type)
+	if override, ok := ctx.Value("authentication-service-user-delete-endpoint").(string); ok {
+		url = override // currently used for overriding the endpoint during unit-testing
+	}
+
+	response, content, e := call(ctx, c, http.MethodDelete, url)
+	if e != nil {
+		return fmt.Errorf("unable to delete authentication-service record: %w", e)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound: // --> not found: already deleted
+		return nil
+	}
+
+	return classify(response, content)
+}
+
+// reinstate compensates [credentials] by reverting the authentication-service record's soft delete.
+func reinstate(ctx context.Context, saga deletions.Deletion) error {
+	c, e := client(ctx, saga)
+	if e != nil {
+		return e
+	}
+
+	id, exists, e := identifier(ctx, c)
+	if e != nil {
+		return e
+	} else if !(exists) {
+		return permanent{fmt.Errorf("authentication-service record no longer exists")}
+	}
+
+	url := fmt.Sprintf("%s://%s:%d/users/%d/restore", "http", "authentication-service", 8080, id)
+	if override, ok := ctx.Value("authentication-service-user-restore-endpoint").(string); ok {
+		url = override // currently used for overriding the endpoint during unit-testing
+	}
+
+	response, content, e := call(ctx, c, http.MethodPost, url)
+	if e != nil {
+		return fmt.Errorf("unable to restore authentication-service record: %w", e)
+	}
+
+	if response.StatusCode == http.StatusOK || response.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return classify(response, content)
+}
+
+// verification deletes the verification-service record(s) associated with the saga user's email address.
+func verification(ctx context.Context, saga deletions.Deletion) error {
+	c, e := client(ctx, saga)
+	if e != nil {
+		return e
+	}
+
+	url := fmt.Sprintf("%s://%s:%d/", "http", "verification-service", 8080)
+	if override, ok := ctx.Value("verification-service-delete-endpoint").(string); ok {
+		url = override // currently used for overriding the endpoint during unit-testing
+	}
+
+	response, content, e := call(ctx, c, http.MethodDelete, url)
+	if e != nil {
+		return fmt.Errorf("unable to delete verification-service record(s): %w", e)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+
+	return classify(response, content)
+}
@@ -0,0 +1,125 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// key returns the archive signing key, established via the EXPORT_SIGNING_KEY environment variable. The development key
+// is only substituted when ENVIRONMENT is "local" or "development"; otherwise, an unset key is an error.
+func key() ([]byte, error) {
+	value := os.Getenv("EXPORT_SIGNING_KEY")
+	if value == "" {
+		switch strings.ToLower(strings.TrimSpace(os.Getenv("ENVIRONMENT"))) {
+		case "local", "development":
+			slog.Warn("No EXPORT_SIGNING_KEY Environment Variable Set... Defaulting to Development Key")
+
+			value = "7m0yJdJ3xYh0m8Qq7F6o1w9vYbU4zT2cLkPzX5aRn1E"
+		default:
+			return nil, errors.New("EXPORT_SIGNING_KEY environment variable is required outside of development")
+		}
+	}
+
+	return []byte(value), nil
+}
+
+// Manifest represents an archive's table of contents. It's included in every archive as "manifest.json".
+type Manifest struct {
+	Generated time.Time         `json:"generated"`
+	User      int64             `json:"user"`
+	Files     map[string]string `json:"files"` // Files maps each archived file name to its hex-encoded SHA-256 digest.
+}
+
+// Archive packages the file(s) into a ZIP archive alongside a [Manifest]. File name(s) are written in sorted order so
+// that identical input(s) produce identical archive(s).
+func Archive(user int64, generated time.Time, files map[string][]byte) ([]byte, error) {
+	manifest := Manifest{Generated: generated.UTC(), User: user, Files: make(map[string]string, len(files))}
+
+	names := make([]string, 0, len(files))
+	for name, content := range files {
+		digest := sha256.Sum256(content)
+		manifest.Files[name] = hex.EncodeToString(digest[:])
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	var buffer bytes.Buffer
+
+	writer := zip.NewWriter(&buffer)
+
+	write := func(name string, content []byte) error {
+		w, e := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: manifest.Generated})
+		if e != nil {
+			return e
+		}
+
+		_, e = w.Write(content)
+
+		return e
+	}
+
+	for _, name := range names {
+		if e := write(name, files[name]); e != nil {
+			return nil, e
+		}
+	}
+
+	content, e := json.MarshalIndent(manifest, "", "    ")
+	if e != nil {
+		return nil, e
+	}
+
+	if e := write("manifest.json", content); e != nil {
+		return nil, e
+	}
+
+	if e := writer.Close(); e != nil {
+		return nil, e
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Sign returns the base64-encoded HMAC-SHA256 signature of the archive.
+func Sign(archive []byte) (string, error) {
+	secret, e := key()
+	if e != nil {
+		return "", e
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(archive)
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Verify reports whether the signature is valid for the archive.
+func Verify(archive []byte, signature string) bool {
+	decoded, e := base64.StdEncoding.DecodeString(signature)
+	if e != nil {
+		return false
+	}
+
+	secret, e := key()
+	if e != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(archive)
+
+	return hmac.Equal(mac.Sum(nil), decoded)
+}
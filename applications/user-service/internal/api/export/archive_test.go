@@ -0,0 +1,111 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestArchive(t *testing.T) {
+	generated := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	files := map[string][]byte{
+		"user.json":           []byte(`{"id": 1}`),
+		"authentication.json": []byte(`{"account": {}}`),
+	}
+
+	archive, e := Archive(1, generated, files)
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	reader, e := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if e != nil {
+		t.Fatalf("Unable to Read Archive: %v", e)
+	}
+
+	contents := make(map[string][]byte)
+	for _, file := range reader.File {
+		handle, e := file.Open()
+		if e != nil {
+			t.Fatalf("Unable to Open Archived File (%s): %v", file.Name, e)
+		}
+
+		contents[file.Name], _ = io.ReadAll(handle)
+		handle.Close()
+	}
+
+	var manifest Manifest
+	if e := json.Unmarshal(contents["manifest.json"], &manifest); e != nil {
+		t.Fatalf("Unable to Unmarshal Manifest: %v", e)
+	}
+
+	if manifest.User != 1 || !(manifest.Generated.Equal(generated)) || len(manifest.Files) != len(files) {
+		t.Errorf("Unexpected Manifest: %+v", manifest)
+	}
+
+	for name, content := range files {
+		digest := sha256.Sum256(content)
+		if manifest.Files[name] != hex.EncodeToString(digest[:]) {
+			t.Errorf("Manifest Digest Mismatch (%s)", name)
+		}
+
+		if !(bytes.Equal(contents[name], content)) {
+			t.Errorf("Archived Content Mismatch (%s)", name)
+		}
+	}
+
+	t.Run("Deterministic", func(t *testing.T) {
+		again, _ := Archive(1, generated, files)
+		if !(bytes.Equal(archive, again)) {
+			t.Errorf("Expected Identical Archive(s)")
+		}
+	})
+
+	t.Run("Signature", func(t *testing.T) {
+		t.Setenv("EXPORT_SIGNING_KEY", "")
+		t.Setenv("ENVIRONMENT", "development")
+
+		signature, e := Sign(archive)
+		if e != nil {
+			t.Fatalf("Unable to Sign Archive: %v", e)
+		}
+
+		if !(Verify(archive, signature)) {
+			t.Errorf("Expected Valid Signature")
+		}
+
+		tampered := bytes.Clone(archive)
+		tampered[len(tampered)/2] ^= 0xFF
+
+		if Verify(tampered, signature) {
+			t.Errorf("Expected Invalid Signature for Tampered Archive")
+		}
+
+		if Verify(archive, "invalid") {
+			t.Errorf("Expected Invalid Signature for Malformed Input")
+		}
+	})
+
+	t.Run("Signing-Key-Required", func(t *testing.T) {
+		t.Setenv("EXPORT_SIGNING_KEY", "")
+
+		for _, environment := range []string{"", "staging", "production"} {
+			t.Setenv("ENVIRONMENT", environment)
+
+			if _, e := Sign(archive); e == nil {
+				t.Errorf("Expected Error for Unset Signing Key in Environment %q", environment)
+			}
+		}
+
+		t.Setenv("EXPORT_SIGNING_KEY", "secret")
+		if _, e := Sign(archive); e != nil {
+			t.Errorf("Unexpected Error for Configured Signing Key: %v", e)
+		}
+	})
+}
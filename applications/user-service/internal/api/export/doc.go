@@ -0,0 +1,4 @@
+// Package export provides HTTP Handler(s) for data-subject access request(s). An export job collects the user's record(s)
+// from the user-service, authentication-service, and verification-service, and packages them as a signed ZIP archive of
+// JSON document(s).
+package export
@@ -0,0 +1,341 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/api/ownership"
+	"user-service/internal/database"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/middleware/telemetrics"
+	"user-service/internal/storage"
+	"user-service/models/exports"
+)
+
+// Status represents an export's progress and download readiness.
+type Status struct {
+	ID         int64      `json:"id"`
+	Status     string     `json:"status"`
+	Progress   int16      `json:"progress"`
+	Download   *string    `json:"download"`  // Download represents the archive's url; only present once the export is complete.
+	Signature  *string    `json:"signature"` // Signature represents the archive's base64-encoded HMAC-SHA256 signature.
+	Error      *string    `json:"error"`
+	Creation   *time.Time `json:"creation"`
+	Completion *time.Time `json:"completion"`
+}
+
+func status(record exports.Export) Status {
+	v := Status{ID: record.ID, Status: record.Status, Progress: record.Progress, Error: record.Error}
+
+	if record.Creation.Valid {
+		v.Creation = &record.Creation.Time
+	}
+
+	if record.Completion.Valid {
+		v.Completion = &record.Completion.Time
+	}
+
+	if record.Status == exports.Complete {
+		download := fmt.Sprintf("/users/%d/export/%d/download", record.User, record.ID)
+
+		v.Download = &download
+		v.Signature = record.Signature
+	}
+
+	return v
+}
+
+// Handler is an HTTP handler that starts a data-subject access export [Job] for the authenticated user. If an export is
+// already pending or running, its [Status] is returned instead of starting another; an abandoned export is resumed, or
+// failed, by [Reclaim] once its lease lapses.
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "export"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	token := authentication.New().Value(ctx).Token
+
+	claims := token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Initialize a database transaction in the event of rollback.
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	if !(ownership.Authorize(ctx, w, tx, id, email, "export the user's data")) {
+		return
+	}
+
+	// --> the insert is skipped should an export already be in-flight; return it rather than starting a duplicate
+	record, e := exports.New().Create(ctx, tx, id)
+	if errors.Is(e, pgx.ErrNoRows) {
+		active, e := exports.New().Active(ctx, tx, id)
+		if e != nil {
+			slog.ErrorContext(ctx, "Unable to Query Active Export(s)", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		} else if len(active) == 0 {
+			// --> the conflicting export concluded between statement(s); the client may retry
+			slog.WarnContext(ctx, "In-Progress Export Concluded During Request", slog.Int64("id", id))
+
+			http.Error(w, "Export Already In-Progress", http.StatusConflict)
+			return
+		}
+
+		slog.InfoContext(ctx, "Export Already In-Progress", slog.Int64("id", id), slog.Int64("export", active[0].ID))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", fmt.Sprintf("/users/%d/export/%d", id, active[0].ID))
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(status(active[0]))
+
+		return
+	} else if e != nil {
+		slog.ErrorContext(ctx, "Unable to Create Export Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> commit the transaction
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	// --> the job outlives the request; retain the context's value(s) without its cancellation
+	go Run(context.WithoutCancel(ctx), Job{
+		ID:      record.ID,
+		User:    id,
+		Email:   email,
+		Headers: headers(telemetrics.New().Value(ctx).Headers, token.Raw),
+	})
+
+	slog.InfoContext(ctx, "Successfully Started Export", slog.Int64("id", id), slog.Int64("export", record.ID))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/users/%d/export/%d", id, record.ID))
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(status(record))
+
+	return
+})
+
+// lookup authorizes the request and retrieves the path's [exports.Export] database record, writing the applicable error
+// response and returning false otherwise.
+func lookup(w http.ResponseWriter, r *http.Request) (context.Context, *exports.Export, bool) {
+	ctx := r.Context()
+
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return ctx, nil, false
+	}
+
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return ctx, nil, false
+	}
+
+	identifier, e := strconv.ParseInt(r.PathValue("export"), 10, 64)
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Export Identifier", slog.String("export", r.PathValue("export")))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return ctx, nil, false
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return ctx, nil, false
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	if !(ownership.Authorize(ctx, w, connection, id, email, "export the user's data")) {
+		return ctx, nil, false
+	}
+
+	record, e := exports.New().Get(ctx, connection, &exports.GetParams{ID: identifier, UserID: id})
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Export Record Not Found", slog.Int64("id", id), slog.Int64("export", identifier))
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return ctx, nil, false
+		}
+
+		slog.ErrorContext(ctx, "Unable to Query Export Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return ctx, nil, false
+	}
+
+	return ctx, &record, true
+}
+
+// Progress is an HTTP handler that reports an export's [Status], including its progress and download readiness.
+var Progress = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "export-status"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+
+	defer span.End()
+
+	_, record, ok := lookup(w, r.WithContext(ctx))
+	if !(ok) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(status(*record))
+
+	return
+})
+
+// Download is an HTTP handler that returns a completed export's signed ZIP archive. The archive's signature is included
+// in the X-Signature response header.
+var Download = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "export-download"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	ctx, record, ok := lookup(w, r.WithContext(ctx))
+	if !(ok) {
+		return
+	}
+
+	if record.Status != exports.Complete || record.Key == nil {
+		slog.WarnContext(ctx, "Export Not Ready for Download", slog.Int64("export", record.ID), slog.String("status", record.Status))
+		http.Error(w, "Export Not Ready for Download", http.StatusConflict)
+		return
+	}
+
+	bucket, e := storage.Default(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Establish Object Storage", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	reader, object, e := bucket.Get(ctx, *record.Key)
+	if e != nil {
+		if errors.Is(e, storage.ErrNotFound) {
+			slog.WarnContext(ctx, "Export Archive Not Found", slog.String("key", *record.Key))
+			http.Error(w, http.StatusText(http.StatusGone), http.StatusGone)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Retrieve Export Archive", slog.String("key", *record.Key), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer reader.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Length", strconv.FormatInt(object.Size, 10))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"export-%d.zip\"", record.ID))
+	w.Header().Set("Cache-Control", "no-store")
+	if record.Signature != nil {
+		w.Header().Set("X-Signature", *record.Signature)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, e := io.Copy(w, reader); e != nil {
+		slog.ErrorContext(ctx, "Unable to Write Export Archive", slog.Int64("export", record.ID), slog.String("error", e.Error()))
+	}
+
+	return
+})
@@ -0,0 +1,260 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"user-service/internal/database"
+	"user-service/internal/library/server/telemetry"
+	"user-service/internal/storage"
+	"user-service/models/consents"
+	"user-service/models/exports"
+	"user-service/models/preferences"
+	"user-service/models/users"
+)
+
+// timeout represents the maximum duration of an export job.
+const timeout = 5 * time.Minute
+
+// Job represents a single data-subject access export.
+type Job struct {
+	ID      int64             // ID represents the [exports.Export] database record's identifier.
+	User    int64             // User represents the requesting [users.User] database record's identifier.
+	Email   string            // Email represents the requesting user's email address.
+	Headers map[string]string // Headers represents the telemetry and authorization header(s) forwarded to internal endpoint(s).
+}
+
+// endpoint represents an internal service endpoint that contributes a file to the export archive.
+type endpoint struct {
+	file     string // file represents the archived JSON document's name.
+	service  string // service represents the internal service's hostname.
+	override string // override represents the context key used to override the endpoint's url during unit-testing.
+}
+
+var endpoints = []endpoint{
+	{file: "authentication.json", service: "authentication-service", override: "authentication-service-export-endpoint"},
+	{file: "verification.json", service: "verification-service", override: "verification-service-export-endpoint"},
+}
+
+// Key returns the export archive's object-storage key.
+func Key(id int64) string {
+	return fmt.Sprintf("exports/%d.zip", id)
+}
+
+// Run executes the export job, recording progress against the [exports.Export] database record. Run is intended to be
+// called in its own goroutine with a context that outlives the originating request. Each recorded step renews the
+// export's lease; a job interrupted by a restart is resumed by [Reclaim].
+func Run(ctx context.Context, job Job) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	slog.InfoContext(ctx, "Starting Export Job", slog.Int64("export", job.ID), slog.Int64("id", job.User))
+
+	if e := run(ctx, job); e != nil {
+		slog.ErrorContext(ctx, "Export Job Failed", slog.Int64("export", job.ID), slog.Int64("id", job.User), slog.String("error", e.Error()))
+
+		fail(ctx, job.ID, e.Error())
+
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Completed Export Job", slog.Int64("export", job.ID), slog.Int64("id", job.User))
+}
+
+func run(ctx context.Context, job Job) error {
+	// progress increments evenly across the user-service record, each internal endpoint, and packaging.
+	step := int16(100 / (len(endpoints) + 2))
+
+	var progress int16
+	advance := func() error {
+		progress += step
+
+		return update(ctx, func(db exports.DBTX) error {
+			return exports.New().Progress(ctx, db, &exports.ProgressParams{ID: job.ID, Progress: progress})
+		})
+	}
+
+	files := make(map[string][]byte, len(endpoints)+3)
+
+	// --> user-service record(s)
+	{
+		var record users.User
+		if e := update(ctx, func(db exports.DBTX) (e error) {
+			record, e = users.New().Extract(ctx, db, &users.ExtractParams{ID: job.User, Email: job.Email})
+			return e
+		}); e != nil {
+			return fmt.Errorf("unable to extract user-service record: %w", e)
+		}
+
+		content, e := json.MarshalIndent(record, "", "    ")
+		if e != nil {
+			return fmt.Errorf("unable to encode user-service record: %w", e)
+		}
+
+		files["user.json"] = content
+
+		var ledger []consents.Consent
+		if e := update(ctx, func(db exports.DBTX) (e error) {
+			ledger, e = consents.New().History(ctx, db, &consents.HistoryParams{UserID: job.User})
+			return e
+		}); e != nil {
+			return fmt.Errorf("unable to extract consent ledger: %w", e)
+		}
+
+		content, e = json.MarshalIndent(ledger, "", "    ")
+		if e != nil {
+			return fmt.Errorf("unable to encode consent ledger: %w", e)
+		}
+
+		files["consents.json"] = content
+
+		// --> the document is stored as raw JSON; embed it as-is rather than as base64-encoded bytes
+		settings := make([]map[string]any, 0, 1)
+		if e := update(ctx, func(db exports.DBTX) error {
+			record, e := preferences.New().Get(ctx, db, job.User)
+			if errors.Is(e, pgx.ErrNoRows) {
+				return nil
+			} else if e == nil {
+				settings = append(settings, map[string]any{
+					"version":      record.Version,
+					"preferences":  json.RawMessage(record.Document),
+					"creation":     record.Creation,
+					"modification": record.Modification,
+				})
+			}
+
+			return e
+		}); e != nil {
+			return fmt.Errorf("unable to extract preferences: %w", e)
+		}
+
+		content, e = json.MarshalIndent(settings, "", "    ")
+		if e != nil {
+			return fmt.Errorf("unable to encode preferences: %w", e)
+		}
+
+		files["preferences.json"] = content
+
+		if e := advance(); e != nil {
+			return fmt.Errorf("unable to record progress: %w", e)
+		}
+	}
+
+	// --> internal service record(s)
+	client := telemetry.Client(job.Headers)
+	for _, target := range endpoints {
+		url := fmt.Sprintf("%s://%s:%d/export", "http", target.service, 8080)
+		if override, ok := ctx.Value(target.override).(string); ok {
+			url = override // currently used for overriding the endpoint during unit-testing
+		}
+
+		content, e := fetch(ctx, client, url)
+		if e != nil {
+			return fmt.Errorf("unable to export %s record(s): %w", target.service, e)
+		}
+
+		files[target.file] = content
+
+		if e := advance(); e != nil {
+			return fmt.Errorf("unable to record progress: %w", e)
+		}
+	}
+
+	// --> package, sign, and store
+	archive, e := Archive(job.User, time.Now(), files)
+	if e != nil {
+		return fmt.Errorf("unable to package archive: %w", e)
+	}
+
+	signature, e := Sign(archive)
+	if e != nil {
+		return fmt.Errorf("unable to sign archive: %w", e)
+	}
+
+	bucket, e := storage.Default(ctx)
+	if e != nil {
+		return fmt.Errorf("unable to establish object storage: %w", e)
+	}
+
+	key := Key(job.ID)
+	if e := bucket.Put(ctx, key, archive, "application/zip"); e != nil {
+		return fmt.Errorf("unable to store archive: %w", e)
+	}
+
+	return update(ctx, func(db exports.DBTX) error {
+		return exports.New().Complete(ctx, db, &exports.CompleteParams{ID: job.ID, Key: &key, Signature: &signature})
+	})
+}
+
+// fetch retrieves an internal endpoint's JSON response, re-indented for readability.
+func fetch(ctx context.Context, client *telemetry.Instance, url string) ([]byte, error) {
+	request, e := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if e != nil {
+		return nil, e
+	}
+
+	response, e := client.Do(request)
+	if e != nil {
+		return nil, e
+	}
+
+	defer response.Body.Close()
+
+	content, e := io.ReadAll(response.Body)
+	if e != nil {
+		return nil, e
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status (%s)", response.Status)
+	}
+
+	var document json.RawMessage
+	if e := json.Unmarshal(content, &document); e != nil {
+		return nil, fmt.Errorf("invalid json response: %w", e)
+	}
+
+	return json.MarshalIndent(document, "", "    ")
+}
+
+// fail records the export's failure, logging any error doing so.
+func fail(ctx context.Context, id int64, message string) {
+	if e := update(ctx, func(db exports.DBTX) error {
+		return exports.New().Fail(ctx, db, &exports.FailParams{ID: id, Error: &message})
+	}); e != nil {
+		slog.ErrorContext(ctx, "Unable to Record Export Failure", slog.Int64("export", id), slog.String("error", e.Error()))
+	}
+}
+
+// update executes the function against a pooled database connection.
+func update(ctx context.Context, fn func(db exports.DBTX) error) error {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	return fn(connection)
+}
+
+// headers returns a copy of the telemetry header(s) with the caller's authorization attached.
+func headers(telemetry map[string]string, raw string) map[string]string {
+	v := maps.Clone(telemetry)
+	if v == nil {
+		v = make(map[string]string)
+	}
+
+	v["Authorization"] = fmt.Sprintf("Bearer %s", raw)
+
+	return v
+}
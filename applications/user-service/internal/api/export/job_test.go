@@ -0,0 +1,56 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"user-service/internal/library/server/telemetry"
+	"user-service/models/exports"
+)
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"verifications":[]}`))
+	}))
+
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("Authorized", func(t *testing.T) {
+		content, e := fetch(ctx, telemetry.Client(headers(nil, "token")), server.URL)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if expectation := "{\n    \"verifications\": []\n}"; string(content) != expectation {
+			t.Errorf("Expected (%s), Received (%s)", expectation, content)
+		}
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		if _, e := fetch(ctx, telemetry.Client(headers(nil, "invalid")), server.URL); e == nil {
+			t.Errorf("Expected Error for Non-200 Status")
+		}
+	})
+}
+
+func TestExhausted(t *testing.T) {
+	for value, expectation := range map[int16]bool{1: false, attempts: false, attempts + 1: true} {
+		if v := Exhausted(exports.Export{Attempts: value}); v != expectation {
+			t.Errorf("Exhausted(%d) = %v, expected %v", value, v, expectation)
+		}
+	}
+
+	if lease <= timeout {
+		t.Errorf("Lease (%s) Must Exceed the Job Timeout (%s)", lease, timeout)
+	}
+}
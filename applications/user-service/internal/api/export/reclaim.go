@@ -0,0 +1,104 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"user-service/internal/token"
+	"user-service/models/exports"
+	"user-service/models/users"
+)
+
+const (
+	lease    = 2 * timeout // lease represents the duration without progress after which an in-flight export is abandoned; it exceeds the job's timeout, such that a live job is never reclaimed.
+	interval = time.Minute // interval represents the delay between polling for abandoned export(s).
+	batch    = 10          // batch represents the maximum number of export(s) claimed at once.
+	attempts = 3           // attempts represents the maximum number of time(s) an export job is started before it's failed.
+)
+
+// Exhausted reports whether the claimed export has been started more than the maximum number of time(s), such that it's
+// failed rather than resumed.
+func Exhausted(record exports.Export) bool {
+	return record.Attempts > attempts
+}
+
+// Reclaim claims abandoned export(s) -- pending or running without progress for the lease, e.g. following a restart --
+// until the context is cancelled, resuming each, or failing it once [Exhausted]. Because claims skip locked row(s), any
+// number of replicas may reclaim concurrently.
+func Reclaim(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "Starting Export Reclaimer")
+
+	for {
+		drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Stopping Export Reclaimer")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain resumes claimed export(s) until none are abandoned.
+func drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		var claimed []exports.Export
+		if e := update(ctx, func(db exports.DBTX) (e error) {
+			claimed, e = exports.New().Claim(ctx, db, &exports.ClaimParams{Lease: lease.Seconds(), Size: batch})
+			return e
+		}); e != nil {
+			slog.ErrorContext(ctx, "Unable to Claim Abandoned Export(s)", slog.String("error", e.Error()))
+			return
+		}
+
+		for _, record := range claimed {
+			resume(ctx, record)
+		}
+
+		if len(claimed) < batch {
+			return
+		}
+	}
+}
+
+// resume restarts the abandoned export's job. The originating request's token isn't persisted, so the job's internal
+// request(s) are authorized by a token delegated on behalf of the user.
+func resume(ctx context.Context, record exports.Export) {
+	if Exhausted(record) {
+		slog.WarnContext(ctx, "Failing Abandoned Export", slog.Int64("export", record.ID), slog.Int("attempts", int(record.Attempts)))
+
+		fail(ctx, record.ID, fmt.Sprintf("export abandoned after %d attempt(s)", attempts))
+		return
+	}
+
+	var row users.GetUserEmailAddressByIDRow
+	if e := update(ctx, func(db exports.DBTX) (e error) {
+		row, e = users.New().GetUserEmailAddressByID(ctx, db, record.User)
+		return e
+	}); errors.Is(e, pgx.ErrNoRows) {
+		fail(ctx, record.ID, "user record no longer exists")
+		return
+	} else if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query Abandoned Export's User", slog.Int64("export", record.ID), slog.String("error", e.Error()))
+		return // --> reclaimed once the lease lapses again
+	}
+
+	jwt, e := token.Delegate(ctx, row.Email)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Delegate Export Authorization", slog.Int64("export", record.ID), slog.String("error", e.Error()))
+		return
+	}
+
+	slog.InfoContext(ctx, "Resuming Abandoned Export", slog.Int64("export", record.ID), slog.Int("attempt", int(record.Attempts)))
+
+	go Run(ctx, Job{ID: record.ID, User: record.User, Email: row.Email, Headers: headers(nil, jwt)})
+}
@@ -62,6 +62,7 @@ var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", user.ETag())
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(user)
 
@@ -0,0 +1,3 @@
+// Package ownership provides the authorization check shared by HTTP Handler(s) that operate upon the authenticated
+// user's own [users.User] record.
+package ownership
@@ -0,0 +1,75 @@
+package ownership
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+
+	"user-service/internal/library/server"
+	"user-service/models/users"
+)
+
+// Authorize ensures the user's database record exists and belongs to the authenticated user, writing the applicable
+// error response and returning false otherwise. The action completes the forbidden response's message (e.g. "export the
+// user's data").
+func Authorize(ctx context.Context, w http.ResponseWriter, db users.DBTX, id int64, email string, action string) bool {
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	exists, e := users.New().Exists(ctx, db, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Check if User Exists", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return false
+	} else if !(exists) {
+		slog.WarnContext(ctx, "Active User Record Not Found", slog.String("email", email), slog.Int64("id", id))
+
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return false
+	}
+
+	row, e := users.New().GetUserEmailAddressByID(ctx, db, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Query User for Email & ID Information",
+			slog.Int64("id", id),
+			slog.String("email", email),
+			slog.String("error", e.Error()),
+			slog.String("error-type", reflect.TypeOf(e).String()),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return false
+	}
+
+	if email != row.Email {
+		slog.ErrorContext(ctx, "Potential Hijack, Token Forgery Event",
+			slog.Int64("id", id),
+			slog.String("authentication-email", email),
+			slog.String("database-record-email", row.Email),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		labeler.Add(attribute.Bool("security-risk", true))
+
+		exception := server.Exception{
+			Code:    http.StatusForbidden,
+			Message: fmt.Sprintf("Email mismatch: You are not authorized to %s.", action),
+			Internal: &server.Internal{
+				Error:   fmt.Errorf("email mismatch: user is not authorized to access the target database record"),
+				Message: "Potential Database Inconsistency, Hijack, or Token Forgery Event Detected",
+			},
+		}
+
+		exception.Response(w)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,7 @@
+// Package preferences provides HTTP Handler(s) for reading and writing a [users.User]'s preferences document (e.g. theme,
+// locale, and notification toggles).
+//
+// Writes are validated against a versioned JSON Schema embedded in the binary as "schemas/<version>.json"; the highest
+// version is [Current]. Documents stored under an older version are migrated on access by applying each subsequent
+// version's default(s) in order, then validating against [Current]. See [Migrate].
+package preferences
@@ -0,0 +1,279 @@
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/api/ownership"
+	"user-service/internal/database"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/server"
+	"user-service/models/preferences"
+)
+
+// limit represents the maximum size, in bytes, of a preferences request-body.
+const limit = 64 << 10
+
+// Response represents the handlers' response body.
+type Response struct {
+	Version     int      `json:"version"`     // Version represents the JSON Schema version the document satisfies.
+	Preferences Document `json:"preferences"` // Preferences represents the user's preferences document.
+}
+
+// load locks and retrieves the user's stored document, migrated to [Current]. A user without a stored document receives
+// [Current]'s default(s). The stale return value reports whether the stored document must be persisted.
+func load(ctx context.Context, tx pgx.Tx, id int64) (document Document, stale bool, e error) {
+	record, e := preferences.New().Lock(ctx, tx, id)
+	if errors.Is(e, pgx.ErrNoRows) {
+		document, e = Defaults(Current(), nil)
+		return document, false, e
+	} else if e != nil {
+		return nil, false, e
+	}
+
+	if document, e = Decode(record.Document); e != nil {
+		return nil, false, e
+	}
+
+	if int(record.Version) == Current() {
+		return document, false, nil
+	}
+
+	slog.InfoContext(ctx, "Migrating Preferences Document", slog.Int64("id", id), slog.Int("from", int(record.Version)), slog.Int("to", Current()))
+
+	document, e = Migrate(int(record.Version), document)
+
+	return document, true, e
+}
+
+// store validates and persists the document under [Current].
+func store(ctx context.Context, tx pgx.Tx, id int64, document Document) (*Response, error) {
+	if e := Validate(Current(), document); e != nil {
+		return nil, e
+	}
+
+	content, e := Encode(document)
+	if e != nil {
+		return nil, e
+	}
+
+	record, e := preferences.New().Upsert(ctx, tx, &preferences.UpsertParams{UserID: id, Document: content, Version: int32(Current())})
+	if e != nil {
+		return nil, e
+	}
+
+	persisted, e := Decode(record.Document)
+	if e != nil {
+		return nil, e
+	}
+
+	return &Response{Version: int(record.Version), Preferences: persisted}, nil
+}
+
+// handle is the shared implementation of the preferences handler(s). The mutate function derives the document to
+// persist from the user's current document; a nil mutate function only reads (and, if required, migrates) the document.
+func handle(w http.ResponseWriter, r *http.Request, name string, mutate func(current Document) (Document, error)) {
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Initialize a database transaction in the event of rollback.
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	if !(ownership.Authorize(ctx, w, tx, id, email, "access the user's preferences")) {
+		return
+	}
+
+	current, stale, e := load(ctx, tx, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Load Preferences Document", slog.Int64("id", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response := &Response{Version: Current(), Preferences: current}
+
+	if mutate != nil || stale {
+		document := current
+		if mutate != nil {
+			if document, e = mutate(current); e != nil {
+				slog.ErrorContext(ctx, "Unable to Derive Preferences Document", slog.Int64("id", id), slog.String("error", e.Error()))
+
+				labeler.Add(attribute.Bool("error", true))
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		if response, e = store(ctx, tx, id, document); e != nil {
+			var invalid Invalid
+			if errors.As(e, &invalid) {
+				slog.WarnContext(ctx, "Invalid Preferences Document", slog.Int64("id", id), slog.String("error", e.Error()))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(invalid.Help())
+
+				return
+			}
+
+			slog.ErrorContext(ctx, "Unable to Store Preferences Document", slog.Int64("id", id), slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		// --> commit the transaction
+		if e := tx.Commit(ctx); e != nil {
+			const message = "Unable to Commit Transaction"
+
+			slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, message, http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+
+	return
+}
+
+// body reads and decodes the request-body into a [Document], writing the applicable error response and returning false
+// on failure.
+func body(w http.ResponseWriter, r *http.Request) (Document, bool) {
+	ctx := r.Context()
+
+	content, e := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Read Request Body", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return nil, false
+	}
+
+	document, e := Decode(content)
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Preferences Request Body", slog.String("error", e.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(server.Validators{
+			"/": {Valid: false, Message: "(Required) A JSON object satisfying the preferences JSON Schema."},
+		})
+
+		return nil, false
+	}
+
+	return document, true
+}
+
+// Get is an HTTP handler that returns the authenticated user's preferences document, migrated to [Current].
+var Get = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r, "preferences-get", nil)
+
+	return
+})
+
+// Put is an HTTP handler that replaces the authenticated user's preferences document. Absent member(s) are assigned
+// [Current]'s default(s).
+var Put = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	document, ok := body(w, r)
+	if !(ok) {
+		return
+	}
+
+	handle(w, r, "preferences-put", func(Document) (Document, error) {
+		return Defaults(Current(), document)
+	})
+
+	return
+})
+
+// Patch is an HTTP handler that applies a JSON Merge Patch (RFC 7396) to the authenticated user's preferences document.
+// Member(s) removed by the patch revert to [Current]'s default(s).
+var Patch = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if header := r.Header.Get("Content-Type"); header != "" {
+		media, _, e := mime.ParseMediaType(header)
+		if e != nil || (media != "application/merge-patch+json" && media != "application/json") {
+			slog.WarnContext(r.Context(), "Unsupported Media Type", slog.String("content-type", header))
+
+			w.Header().Set("Accept-Patch", "application/merge-patch+json")
+			http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+			return
+		}
+	}
+
+	patch, ok := body(w, r)
+	if !(ok) {
+		return
+	}
+
+	handle(w, r, "preferences-patch", func(current Document) (Document, error) {
+		return Defaults(Current(), Merge(current, patch))
+	})
+
+	return
+})
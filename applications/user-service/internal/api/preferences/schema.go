@@ -0,0 +1,333 @@
+package preferences
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"user-service/internal/library/server"
+)
+
+//go:embed schemas/*.json
+var files embed.FS
+
+// Document represents a decoded preferences document.
+type Document = map[string]any
+
+// Violation represents a single schema validation failure.
+type Violation struct {
+	Location string // Location represents the JSON Pointer of the invalid value within the document.
+	Message  string // Message represents a human-readable description of the failure.
+}
+
+// Invalid is returned when a document doesn't satisfy its schema.
+type Invalid []Violation
+
+func (v Invalid) Error() string {
+	messages := make([]string, 0, len(v))
+	for _, violation := range v {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Location, violation.Message))
+	}
+
+	return fmt.Sprintf("invalid preferences document: %s", strings.Join(messages, "; "))
+}
+
+// Help converts the violation(s) into a validation response keyed by JSON Pointer location.
+func (v Invalid) Help() server.Validators {
+	mapping := make(server.Validators, len(v))
+	for _, violation := range v {
+		if existing, ok := mapping[violation.Location]; ok {
+			violation.Message = existing.Message + "; " + violation.Message
+		}
+
+		mapping[violation.Location] = server.Validator{Valid: false, Message: violation.Message}
+	}
+
+	return mapping
+}
+
+// ErrUnknownVersion is returned when a document references a schema version that isn't embedded.
+var ErrUnknownVersion = errors.New("unknown preferences schema version")
+
+// version represents a single embedded schema.
+type version struct {
+	raw      map[string]any     // raw represents the decoded schema, used to resolve default(s).
+	compiled *jsonschema.Schema // compiled represents the schema used for validation.
+}
+
+var (
+	once     sync.Once
+	versions map[int]*version
+	latest   int
+	failure  error
+)
+
+// compile compiles every embedded schema exactly once.
+func compile() error {
+	once.Do(func() {
+		entries, e := files.ReadDir("schemas")
+		if e != nil {
+			failure = e
+			return
+		}
+
+		versions = make(map[int]*version, len(entries))
+
+		compiler := jsonschema.NewCompiler()
+		for _, entry := range entries {
+			number, e := strconv.Atoi(strings.TrimSuffix(entry.Name(), ".json"))
+			if e != nil {
+				failure = fmt.Errorf("invalid schema file name (%s): %w", entry.Name(), e)
+				return
+			}
+
+			content, e := files.ReadFile(path.Join("schemas", entry.Name()))
+			if e != nil {
+				failure = e
+				return
+			}
+
+			document, e := jsonschema.UnmarshalJSON(bytes.NewReader(content))
+			if e != nil {
+				failure = fmt.Errorf("invalid schema (%s): %w", entry.Name(), e)
+				return
+			}
+
+			location := fmt.Sprintf("https://user-service/schemas/preferences/%d.json", number)
+			if e := compiler.AddResource(location, document); e != nil {
+				failure = e
+				return
+			}
+
+			compiled, e := compiler.Compile(location)
+			if e != nil {
+				failure = fmt.Errorf("unable to compile schema (%s): %w", entry.Name(), e)
+				return
+			}
+
+			raw, _ := document.(map[string]any)
+
+			versions[number] = &version{raw: raw, compiled: compiled}
+
+			latest = max(latest, number)
+		}
+	})
+
+	return failure
+}
+
+// Current returns the latest embedded schema version.
+func Current() int {
+	if e := compile(); e != nil {
+		panic(e)
+	}
+
+	return latest
+}
+
+// Schema returns the raw, embedded JSON Schema of the given version.
+func Schema(v int) ([]byte, error) {
+	content, e := files.ReadFile(path.Join("schemas", fmt.Sprintf("%d.json", v)))
+	if e != nil {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, v)
+	}
+
+	return content, nil
+}
+
+// Decode parses a JSON object into a [Document]. Numbers are preserved as [json.Number].
+func Decode(content []byte) (Document, error) {
+	value, e := jsonschema.UnmarshalJSON(bytes.NewReader(content))
+	if e != nil {
+		return nil, e
+	}
+
+	document, ok := value.(Document)
+	if !(ok) {
+		return nil, Invalid{{Location: "/", Message: "document must be a JSON object"}}
+	}
+
+	return document, nil
+}
+
+// Defaults fills absent member(s) of the document with the default value(s) declared by the given schema version,
+// including member(s) of nested object(s). Present member(s) are never overwritten.
+func Defaults(v int, document Document) (Document, error) {
+	if e := compile(); e != nil {
+		return nil, e
+	}
+
+	schema, ok := versions[v]
+	if !(ok) {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, v)
+	}
+
+	if document == nil {
+		document = make(Document)
+	}
+
+	apply(schema.raw, document)
+
+	return document, nil
+}
+
+// apply recursively assigns the schema's default(s) to the document's absent member(s).
+func apply(schema map[string]any, document Document) {
+	properties, _ := schema["properties"].(map[string]any)
+	for name, value := range properties {
+		property, ok := value.(map[string]any)
+		if !(ok) {
+			continue
+		}
+
+		current, present := document[name]
+		if !(present) {
+			if fallback, ok := property["default"]; ok {
+				document[name] = clone(fallback)
+				continue
+			}
+
+			if property["type"] == "object" {
+				current = make(Document)
+				document[name] = current
+			}
+		}
+
+		if nested, ok := current.(Document); ok {
+			apply(property, nested)
+		}
+	}
+}
+
+// clone deep-copies a decoded JSON value so that schema default(s) are never shared with, or mutated through, a document.
+func clone(value any) any {
+	switch typecast := value.(type) {
+	case map[string]any:
+		v := make(map[string]any, len(typecast))
+		for key, item := range typecast {
+			v[key] = clone(item)
+		}
+
+		return v
+	case []any:
+		v := make([]any, len(typecast))
+		for index, item := range typecast {
+			v[index] = clone(item)
+		}
+
+		return v
+	default:
+		return value
+	}
+}
+
+// Validate checks the document against the given schema version. An [Invalid] error lists every violation.
+func Validate(v int, document Document) error {
+	if e := compile(); e != nil {
+		return e
+	}
+
+	schema, ok := versions[v]
+	if !(ok) {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, v)
+	}
+
+	e := schema.compiled.Validate(document)
+	if e == nil {
+		return nil
+	}
+
+	var exception *jsonschema.ValidationError
+	if !(errors.As(e, &exception)) {
+		return e
+	}
+
+	printer := message.NewPrinter(language.English)
+
+	var violations Invalid
+
+	var walk func(exception *jsonschema.ValidationError)
+	walk = func(exception *jsonschema.ValidationError) {
+		if len(exception.Causes) == 0 {
+			violations = append(violations, Violation{
+				Location: "/" + strings.Join(exception.InstanceLocation, "/"),
+				Message:  exception.ErrorKind.LocalizedString(printer),
+			})
+
+			return
+		}
+
+		for _, cause := range exception.Causes {
+			walk(cause)
+		}
+	}
+
+	walk(exception)
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Location < violations[j].Location })
+
+	return violations
+}
+
+// Migrate upgrades a document stored under the given schema version to [Current]. Each subsequent version's default(s)
+// are applied in order, and the result is validated against [Current].
+func Migrate(from int, document Document) (Document, error) {
+	current := Current()
+	if from > current || from < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, from)
+	}
+
+	var e error
+	for v := from; v <= current; v++ {
+		if document, e = Defaults(v, document); e != nil {
+			return nil, e
+		}
+	}
+
+	if e := Validate(current, document); e != nil {
+		return nil, e
+	}
+
+	return document, nil
+}
+
+// Merge applies a JSON Merge Patch (RFC 7396) to the target document, returning the result. Null member(s) in the
+// patch remove the corresponding member from the target.
+func Merge(target Document, patch Document) Document {
+	if target == nil {
+		target = make(Document)
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		if nested, ok := value.(Document); ok {
+			existing, _ := target[key].(Document)
+			target[key] = Merge(existing, nested)
+			continue
+		}
+
+		target[key] = clone(value)
+	}
+
+	return target
+}
+
+// Encode serializes the document.
+func Encode(document Document) ([]byte, error) {
+	return json.Marshal(document)
+}
@@ -0,0 +1,125 @@
+package preferences
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCurrent(t *testing.T) {
+	if v := Current(); v != 2 {
+		t.Fatalf("Expected Current Version (2), Received (%d)", v)
+	}
+
+	if _, e := Schema(Current()); e != nil {
+		t.Errorf("Unexpected Error: %v", e)
+	}
+
+	if _, e := Schema(0); !(errors.Is(e, ErrUnknownVersion)) {
+		t.Errorf("Expected (%v), Received (%v)", ErrUnknownVersion, e)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	document, e := Defaults(Current(), nil)
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	expectation := Document{
+		"theme":    "system",
+		"locale":   "en",
+		"timezone": "UTC",
+		"notifications": Document{
+			"email": true,
+			"push":  false,
+			"sms":   false,
+		},
+	}
+
+	if !(reflect.DeepEqual(document, expectation)) {
+		t.Errorf("Expected (%v), Received (%v)", expectation, document)
+	}
+
+	t.Run("Present-Members-Preserved", func(t *testing.T) {
+		document, _ := Defaults(Current(), Document{"theme": "dark", "notifications": Document{"email": false}})
+		if document["theme"] != "dark" || document["notifications"].(Document)["email"] != false {
+			t.Errorf("Present Member(s) Were Overwritten: %v", document)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	document, _ := Defaults(Current(), nil)
+	if e := Validate(Current(), document); e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	cases := map[string]Document{
+		"/theme":               {"theme": "neon"},
+		"/locale":              {"locale": "English"},
+		"/notifications/email": {"notifications": Document{"email": "yes"}},
+		"/":                    {"unknown": true},
+	}
+
+	for location, document := range cases {
+		var invalid Invalid
+		if e := Validate(Current(), document); !(errors.As(e, &invalid)) {
+			t.Errorf("Document (%v): Expected Invalid Error, Received (%v)", document, e)
+			continue
+		}
+
+		if invalid[0].Location != location {
+			t.Errorf("Document (%v): Expected Location (%s), Received (%+v)", document, location, invalid)
+		}
+	}
+
+	t.Run("Version-1-Rejects-Version-2-Members", func(t *testing.T) {
+		if e := Validate(1, Document{"timezone": "UTC"}); e == nil {
+			t.Errorf("Expected Validation Error")
+		}
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	stored := Document{"theme": "dark", "notifications": Document{"email": false}}
+
+	document, e := Migrate(1, stored)
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	if document["timezone"] != "UTC" || document["theme"] != "dark" {
+		t.Errorf("Unexpected Migrated Document: %v", document)
+	}
+
+	notifications := document["notifications"].(Document)
+	if notifications["sms"] != false || notifications["email"] != false || notifications["push"] != false {
+		t.Errorf("Unexpected Migrated Notifications: %v", notifications)
+	}
+
+	if _, e := Migrate(Current()+1, Document{}); !(errors.Is(e, ErrUnknownVersion)) {
+		t.Errorf("Expected (%v), Received (%v)", ErrUnknownVersion, e)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	target := Document{"theme": "dark", "locale": "en", "notifications": Document{"email": true, "push": true}}
+	patch := Document{"theme": "light", "locale": nil, "notifications": Document{"push": false}}
+
+	expectation := Document{"theme": "light", "notifications": Document{"email": true, "push": false}}
+	if result := Merge(target, patch); !(reflect.DeepEqual(result, expectation)) {
+		t.Errorf("Expected (%v), Received (%v)", expectation, result)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	if _, e := Decode([]byte(`[]`)); e == nil {
+		t.Errorf("Expected Error for Non-Object Document")
+	}
+
+	document, e := Decode([]byte(`{"theme": "dark"}`))
+	if e != nil || document["theme"] != "dark" {
+		t.Errorf("Unexpected Result: %v, %v", document, e)
+	}
+}
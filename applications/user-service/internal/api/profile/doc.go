@@ -0,0 +1,2 @@
+// Package profile provides HTTP Handler(s) for updating a [users.User] profile's attribute(s) using JSON Merge Patch (RFC 7396) semantics.
+package profile
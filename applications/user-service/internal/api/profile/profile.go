@@ -0,0 +1,269 @@
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/database"
+	"user-service/internal/library/events"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/server"
+	"user-service/models/consents"
+	"user-service/models/users"
+)
+
+// limit represents the maximum size, in bytes, of a merge-patch request-body.
+const limit = 64 << 10
+
+// Handler is an HTTP handler that applies a JSON Merge Patch (RFC 7396) to an authenticated user's profile. Optimistic
+// concurrency is supported through the [users.User.ETag] value: when an If-Match header is provided and doesn't match
+// the record's current entity-tag, the update is rejected with a 412 (Precondition Failed) status code.
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "profile"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	slog.DebugContext(ctx, "Executing Profile Handler", slog.String("email", email))
+
+	// Ensure the request's media-type is a merge-patch document.
+	if header := r.Header.Get("Content-Type"); header != "" {
+		media, _, e := mime.ParseMediaType(header)
+		if e != nil || (media != "application/merge-patch+json" && media != "application/json") {
+			slog.WarnContext(ctx, "Unsupported Media Type", slog.String("content-type", header))
+
+			w.Header().Set("Accept-Patch", "application/merge-patch+json")
+			http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+			return
+		}
+	}
+
+	content, e := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Read Request Body", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var input Body
+	if e := input.Members(content); e != nil {
+		slog.WarnContext(ctx, "Invalid Merge-Patch Document", slog.String("error", e.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(input.Help())
+
+		return
+	}
+
+	if validator, e := server.Validate(ctx, v, bytes.NewReader(content), &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	slog.DebugContext(ctx, "Input", slog.Any("request", input))
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Initialize a database transaction in the event of rollback.
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	// Check if the database record exists.
+	exists, e := users.New().Exists(ctx, tx, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Check if User Exists", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !(exists) {
+		slog.WarnContext(ctx, "Active User Record Not Found", slog.String("email", email), slog.Int64("id", id))
+
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	// Lock the database record for the remainder of the transaction; prevents concurrent writes between the
+	// precondition evaluation and the update.
+	record, e := users.New().Lock(ctx, tx, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Lock User Record",
+			slog.Int64("id", id),
+			slog.String("email", email),
+			slog.String("error", e.Error()),
+			slog.String("error-type", reflect.TypeOf(e).String()),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Ensure database record's email-address matches authenticated user.
+	if email != record.Email {
+		slog.ErrorContext(ctx, "Potential Hijack, Token Forgery Event",
+			slog.Int64("id", id),
+			slog.String("authentication-email", email),
+			slog.String("database-record-email", record.Email),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		labeler.Add(attribute.Bool("security-risk", true))
+
+		exception := server.Exception{
+			Code:    http.StatusForbidden,
+			Message: "Email mismatch: You are not authorized to update the user-profile.",
+			Internal: &server.Internal{
+				Error:   fmt.Errorf("email mismatch: user is not authorized to update the target database record"),
+				Message: "Potential Database Inconsistency, Hijack, or Token Forgery Event Detected",
+			},
+		}
+
+		exception.Response(w)
+		return
+	}
+
+	// Evaluate optimistic-concurrency precondition(s).
+	if header := r.Header.Get("If-Match"); header != "" && !(match(header, record.ETag())) {
+		slog.WarnContext(ctx, "Precondition Failed - Stale Entity-Tag", slog.Int64("id", id), slog.String("if-match", header), slog.String("etag", record.ETag()))
+
+		w.Header().Set("ETag", record.ETag())
+		http.Error(w, http.StatusText(http.StatusPreconditionFailed), http.StatusPreconditionFailed)
+		return
+	}
+
+	// --> marketing consent is appended to the ledger, from which the user's marketing attribute is derived
+	if parameters := input.Consent(id, consents.Address(middleware.New().RIP().Value(ctx).Real, r.RemoteAddr)); parameters != nil {
+		if _, e := consents.New().Record(ctx, tx, parameters); e != nil {
+			slog.ErrorContext(ctx, "Unable to Record Marketing Consent",
+				slog.Int64("id", id),
+				slog.String("email", email),
+				slog.String("error", e.Error()),
+				slog.String("error-type", reflect.TypeOf(e).String()),
+			)
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	result, e := users.New().UpdateProfile(ctx, tx, input.Parameters(id))
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Update User's Profile",
+			slog.Int64("id", id),
+			slog.String("email", email),
+			slog.String("error", e.Error()),
+			slog.String("error-type", reflect.TypeOf(e).String()),
+		)
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> commit the transaction
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.DebugContext(ctx, "Successfully Updated User's Profile", slog.String("email", email), slog.Int64("id", id))
+
+	events.Emit(ctx, events.Updated, strconv.FormatInt(id, 10), events.UserUpdated{ID: id, Attributes: input.Attributes()})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", result.ETag())
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+
+	return
+})
+
+// match evaluates an If-Match header against the current entity-tag using strong comparison. Weak entity-tags never match.
+//
+//   - See RFC 9110, Section 13.1.1.
+func match(header string, etag string) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimSpace(candidate) == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,129 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+
+	"user-service/internal/library/server"
+	"user-service/models/consents"
+	"user-service/models/users"
+)
+
+var (
+	ErrInvalidDocument = errors.New("invalid merge-patch document") // ErrInvalidDocument is returned when the request-body isn't a JSON object, or when a non-nullable member is null.
+	ErrUnknownMember   = errors.New("unknown merge-patch member")   // ErrUnknownMember is returned when the request-body contains a member that cannot be patched.
+)
+
+// members represents the set of attribute(s) a client is permitted to patch.
+var members = []string{"name", "display-name", "marketing"}
+
+// Body represents the handler's structured, JSON Merge Patch request-body. A nil attribute is either absent from the
+// request or was explicitly set to null; see [Body.Present] to distinguish between the two.
+type Body struct {
+	server.Helper `json:"-"`
+
+	Name        *string `json:"name" validate:"omitnil,min=1,max=255"`         // Name represents the user's optional full name. A null value removes the attribute.
+	DisplayName *string `json:"display-name" validate:"omitnil,min=1,max=255"` // DisplayName represents the user's optional public display name. A null value removes the attribute.
+	Marketing   *bool   `json:"marketing"`                                     // Marketing represents the user's marketing consent decision, recorded to the consent ledger. The attribute isn't nullable.
+
+	raw map[string]json.RawMessage
+}
+
+// Members decodes the top-level member(s) of a merge-patch document. The document must be a JSON object; only
+// [members] are accepted, and "marketing" cannot be null.
+func (b *Body) Members(content []byte) error {
+	if e := json.Unmarshal(content, &b.raw); e != nil || b.raw == nil {
+		return ErrInvalidDocument
+	}
+
+	for key, value := range b.raw {
+		if !(slices.Contains(members, key)) {
+			return ErrUnknownMember
+		}
+
+		if key == "marketing" && string(value) == "null" {
+			return ErrInvalidDocument
+		}
+	}
+
+	return nil
+}
+
+// Present reports whether the merge-patch document contained the provided member, including explicit null values.
+func (b *Body) Present(member string) bool {
+	_, ok := b.raw[member]
+
+	return ok
+}
+
+// Attributes returns the name(s) of the [members] present in the merge-patch document, in declaration order.
+func (b *Body) Attributes() []string {
+	v := make([]string, 0, len(members))
+	for _, member := range members {
+		if b.Present(member) {
+			v = append(v, member)
+		}
+	}
+
+	return v
+}
+
+// Parameters converts the merge-patch document into database update parameters for the given [users.User] identifier.
+func (b *Body) Parameters(id int64) *users.UpdateProfileParams {
+	return &users.UpdateProfileParams{
+		SetName:        b.Present("name"),
+		Name:           b.Name,
+		SetDisplayName: b.Present("display-name"),
+		DisplayName:    b.DisplayName,
+		ID:             id,
+	}
+}
+
+// Consent converts the merge-patch document's "marketing" member into a consent ledger entry for the given [users.User]
+// identifier. Nil is returned if the member is absent.
+func (b *Body) Consent(id int64, address *netip.Addr) *consents.RecordParams {
+	if b.Marketing == nil {
+		return nil
+	}
+
+	return &consents.RecordParams{
+		UserID:  id,
+		Purpose: consents.Marketing,
+		Version: consents.Version(),
+		Granted: *(b.Marketing),
+		Source:  consents.Profile,
+		Address: address,
+	}
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"name": {
+			Value:   b.Name,
+			Valid:   b.Name == nil || (len(*(b.Name)) >= 1 && len(*(b.Name)) <= 255),
+			Message: "(Optional) The user's full name. Must be between 1 and 255 characters in length; null removes the attribute.",
+		},
+		"display-name": {
+			Value:   b.DisplayName,
+			Valid:   b.DisplayName == nil || (len(*(b.DisplayName)) >= 1 && len(*(b.DisplayName)) <= 255),
+			Message: "(Optional) The user's display name. Must be between 1 and 255 characters in length; null removes the attribute.",
+		},
+		"marketing": {
+			Value:   b.Marketing,
+			Valid:   !(b.Present("marketing")) || b.Marketing != nil,
+			Message: "(Optional) A boolean representing the user's marketing consent. Cannot be null.",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
@@ -0,0 +1,103 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"user-service/models/consents"
+)
+
+func TestBody(t *testing.T) {
+	t.Run("Members", func(t *testing.T) {
+		t.Run("Absent", func(t *testing.T) {
+			var input Body
+			if e := input.Members([]byte(`{"display-name": "example"}`)); e != nil {
+				t.Fatalf("Unexpected Error: %v", e)
+			}
+
+			parameters := input.Parameters(1)
+			if parameters.SetName {
+				t.Errorf("Absent Member(s) Shouldn't be Updated: %+v", parameters)
+			}
+
+			if consent := input.Consent(1, nil); consent != nil {
+				t.Errorf("Absent Marketing Member Shouldn't Record Consent: %+v", consent)
+			}
+
+			if !(parameters.SetDisplayName) {
+				t.Errorf("Present Member Should be Updated: %+v", parameters)
+			}
+		})
+
+		t.Run("Null", func(t *testing.T) {
+			var input Body
+			if e := input.Members([]byte(`{"name": null}`)); e != nil {
+				t.Fatalf("Unexpected Error: %v", e)
+			}
+
+			parameters := input.Parameters(1)
+			if !(parameters.SetName) || parameters.Name != nil {
+				t.Errorf("Null Member Should Remove the Attribute: %+v", parameters)
+			}
+		})
+
+		t.Run("Marketing", func(t *testing.T) {
+			var input Body
+			if e := input.Members([]byte(`{"marketing": false}`)); e != nil {
+				t.Fatalf("Unexpected Error: %v", e)
+			}
+
+			if e := json.Unmarshal([]byte(`{"marketing": false}`), &input); e != nil {
+				t.Fatalf("Unexpected Error: %v", e)
+			}
+
+			consent := input.Consent(1, nil)
+			if consent == nil || consent.Granted || consent.Purpose != consents.Marketing || consent.Source != consents.Profile {
+				t.Errorf("Expected Withdrawn Marketing Consent: %+v", consent)
+			}
+		})
+
+		t.Run("Non-Nullable", func(t *testing.T) {
+			var input Body
+			if e := input.Members([]byte(`{"marketing": null}`)); !(errors.Is(e, ErrInvalidDocument)) {
+				t.Errorf("Expected (%v), Received (%v)", ErrInvalidDocument, e)
+			}
+		})
+
+		t.Run("Unknown", func(t *testing.T) {
+			var input Body
+			if e := input.Members([]byte(`{"email": "user@example.com"}`)); !(errors.Is(e, ErrUnknownMember)) {
+				t.Errorf("Expected (%v), Received (%v)", ErrUnknownMember, e)
+			}
+		})
+
+		t.Run("Non-Object", func(t *testing.T) {
+			for _, document := range []string{`[]`, `null`, `"value"`, `{`} {
+				var input Body
+				if e := input.Members([]byte(document)); !(errors.Is(e, ErrInvalidDocument)) {
+					t.Errorf("Document (%s): Expected (%v), Received (%v)", document, ErrInvalidDocument, e)
+				}
+			}
+		})
+	})
+}
+
+func TestMatch(t *testing.T) {
+	const etag = `"abc"`
+
+	cases := map[string]bool{
+		`"abc"`:        true,
+		`*`:            true,
+		`"xyz", "abc"`: true,
+		`"xyz"`:        false,
+		`W/"abc"`:      false,
+		`"abc-suffix"`: false,
+	}
+
+	for header, expectation := range cases {
+		if v := match(header, etag); v != expectation {
+			t.Errorf("If-Match (%s): Expected (%t), Received (%t)", header, expectation, v)
+		}
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"user-service/internal/database"
+	"user-service/internal/library/events"
 	"user-service/internal/library/middleware"
 	"user-service/models/users"
 
@@ -435,6 +437,8 @@ func handle(w http.ResponseWriter, r *http.Request) {
 
 	slog.DebugContext(ctx, "Successfully Created User")
 
+	events.Emit(ctx, events.Created, strconv.FormatInt(result.ID, 10), events.UserCreated{ID: result.ID, Email: result.Email, Creation: result.Creation.Time})
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(result)
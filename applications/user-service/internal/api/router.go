@@ -6,9 +6,15 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"user-service/internal/api/avatar"
+	"user-service/internal/api/consent"
 	"user-service/internal/api/delete"
+	"user-service/internal/api/deletion"
+	"user-service/internal/api/export"
 	"user-service/internal/api/me"
+	"user-service/internal/api/preferences"
+	"user-service/internal/api/profile"
 	"user-service/internal/api/registration"
+	"user-service/internal/api/username"
 	"user-service/internal/library/server"
 
 	"user-service/internal/middleware/authentication"
@@ -18,10 +24,27 @@ func Router(parent *http.ServeMux) {
 	{ // --> authentication endpoints
 		parent.Handle("GET /@me", authentication.Middleware(otelhttp.WithRouteTag("/@me", me.Handler)))
 
+		parent.Handle("PATCH /users/{id}", authentication.Middleware(otelhttp.WithRouteTag("/{id}", profile.Handler)))
 		parent.Handle("DELETE /users/{id}", authentication.Middleware(otelhttp.WithRouteTag("/{id}", delete.Handler)))
+		parent.Handle("GET /deletions/{id}", authentication.Middleware(otelhttp.WithRouteTag("/deletions/{id}", deletion.Status)))
 		parent.Handle("PATCH /users/{id}/avatar", authentication.Middleware(otelhttp.WithRouteTag("/avatar", avatar.Handler)))
+		parent.Handle("GET /users/{id}/preferences", authentication.Middleware(otelhttp.WithRouteTag("/preferences", preferences.Get)))
+		parent.Handle("PUT /users/{id}/preferences", authentication.Middleware(otelhttp.WithRouteTag("/preferences", preferences.Put)))
+		parent.Handle("PATCH /users/{id}/preferences", authentication.Middleware(otelhttp.WithRouteTag("/preferences", preferences.Patch)))
+		parent.Handle("GET /users/{id}/consents", authentication.Middleware(otelhttp.WithRouteTag("/consents", consent.History)))
+		parent.Handle("POST /users/{id}/consents", authentication.Middleware(otelhttp.WithRouteTag("/consents", consent.Record)))
+		parent.Handle("POST /users/{id}/export", authentication.Middleware(otelhttp.WithRouteTag("/export", export.Handler)))
+		parent.Handle("GET /users/{id}/export/{export}", authentication.Middleware(otelhttp.WithRouteTag("/export/{export}", export.Progress)))
+		parent.Handle("GET /users/{id}/export/{export}/download", authentication.Middleware(otelhttp.WithRouteTag("/export/{export}/download", export.Download)))
+		parent.Handle("PUT /users/{id}/username", authentication.Middleware(otelhttp.WithRouteTag("/username", username.Handler)))
+		parent.Handle("POST /users/{id}/avatar", authentication.Middleware(otelhttp.WithRouteTag("/avatar", avatar.Upload)))
 	}
 
+	parent.Handle("GET /usernames/{name}", otelhttp.WithRouteTag("/usernames/{name}", username.Resolve))
+	parent.Handle("GET /usernames/{name}/availability", otelhttp.WithRouteTag("/usernames/{name}/availability", username.Check))
+
+	parent.Handle("GET /avatars/{key}", otelhttp.WithRouteTag("/avatars/{key}", avatar.Serve))
+
 	parent.HandleFunc("GET /health", server.Health)
 
 	parent.Handle("POST /register", otelhttp.WithRouteTag("/register", registration.Handler))
@@ -0,0 +1,101 @@
+package username
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/database"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/usernames"
+	"user-service/models/users"
+)
+
+// Availability reason(s).
+const (
+	Invalid  = "invalid"  // Invalid represents a username that doesn't satisfy the format requirement(s).
+	Reserved = "reserved" // Reserved represents a username on the reserved-word blocklist.
+	Taken    = "taken"    // Taken represents a username held by another user, or within another user's cooldown.
+)
+
+// Availability represents the availability handler's response body.
+type Availability struct {
+	Username  string `json:"username"`          // Username represents the evaluated username.
+	Available bool   `json:"available"`         // Available reports whether the username can be claimed.
+	Reason    string `json:"reason,omitempty"`  // Reason represents why the username can't be claimed.
+	Message   string `json:"message,omitempty"` // Message describes the reason.
+}
+
+// evaluate determines the username's availability on behalf of the specified user; a zero id represents an anonymous
+// user.
+func evaluate(ctx context.Context, db users.DBTX, username string, id int64) (Availability, error) {
+	availability := Availability{Username: username}
+
+	if e := usernames.Validate(username); e != nil {
+		availability.Reason, availability.Message = Invalid, e.Error()
+		if errors.Is(e, usernames.ErrReserved) {
+			availability.Reason = Reserved
+		}
+
+		return availability, nil
+	}
+
+	claimed, e := users.New().Claimed(ctx, db, &users.ClaimedParams{Username: username, ID: id})
+	if e != nil {
+		return availability, e
+	} else if claimed {
+		availability.Reason, availability.Message = Taken, "username is unavailable"
+		return availability, nil
+	}
+
+	availability.Available = true
+
+	return availability, nil
+}
+
+// Check is a public HTTP handler that reports whether a username can be claimed.
+var Check = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "username-availability"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	availability, e := evaluate(ctx, connection, r.PathValue("name"), 0)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Evaluate Username Availability", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(availability)
+
+	return
+})
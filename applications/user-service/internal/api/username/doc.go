@@ -0,0 +1,3 @@
+// Package username claims, checks the availability of, and resolves usernames. A changed username redirects to the
+// user's current username for a cooldown period (see [usernames.Cooldown]), during which no other user can claim it.
+package username
@@ -0,0 +1,46 @@
+package username
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+
+	"user-service/internal/library/server"
+	"user-service/internal/library/usernames"
+)
+
+// Body represents the handler's structured request-body
+type Body struct {
+	server.Helper `json:"-"`
+
+	Username string `json:"username" validate:"required,username"` // Username represents the user's requested username.
+}
+
+func (b *Body) Help() server.Validators {
+	message := fmt.Sprintf("(Required) A unique username between %d and %d characters in length. Letters, digits, and single \".\", \"_\", or \"-\" separators; must start and end with a letter or digit.", usernames.Minimum, usernames.Maximum)
+	if e := usernames.Validate(b.Username); e != nil && b.Username != "" {
+		message = fmt.Sprintf("(Required) %s.", e.Error())
+	}
+
+	var mapping = server.Validators{
+		"username": {
+			Value:   b.Username,
+			Valid:   usernames.Validate(b.Username) == nil,
+			Message: message,
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+func init() {
+	v.RegisterValidation("username", func(fl validator.FieldLevel) bool {
+		return usernames.Validate(fl.Field().String()) == nil
+	})
+}
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
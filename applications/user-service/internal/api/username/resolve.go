@@ -0,0 +1,92 @@
+package username
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/database"
+	"user-service/internal/library/middleware"
+	"user-service/models/users"
+)
+
+// Resolution represents the resolve handler's response body.
+type Resolution struct {
+	ID       int64  `json:"id"`       // ID represents the user's identifier.
+	Username string `json:"username"` // Username represents the user's current username.
+}
+
+// Resolve is a public HTTP handler that resolves a username to its user. A previous username within its cooldown
+// responds with a temporary redirect to the user's current username, as the
+// redirect lapses once the cooldown expires.
+var Resolve = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "username-resolve"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	username := r.PathValue("name")
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	current, e := users.New().Resolve(ctx, connection, username)
+	if e == nil && current.Username != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Resolution{ID: current.ID, Username: *current.Username})
+
+		return
+	} else if e != nil && !(errors.Is(e, pgx.ErrNoRows)) {
+		slog.ErrorContext(ctx, "Unable to Resolve Username", slog.String("username", username), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	redirect, e := users.New().Redirect(ctx, connection, username)
+	if errors.Is(e, pgx.ErrNoRows) || (e == nil && redirect.Username == nil) {
+		slog.DebugContext(ctx, "Username Not Found", slog.String("username", username))
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	} else if e != nil {
+		slog.ErrorContext(ctx, "Unable to Resolve Username Redirect", slog.String("username", username), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Redirecting Previous Username", slog.String("username", username), slog.String("current", *redirect.Username))
+
+	w.Header().Set("Location", fmt.Sprintf("/usernames/%s", url.PathEscape(*redirect.Username)))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTemporaryRedirect)
+	json.NewEncoder(w).Encode(Resolution{ID: redirect.ID, Username: *redirect.Username})
+
+	return
+})
@@ -0,0 +1,78 @@
+package username
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"strings"
+
+	"user-service/internal/library/server"
+	"user-service/internal/library/server/telemetry"
+	"user-service/internal/token"
+)
+
+// synchronize assigns the username to the user's authentication-service record, enabling login by username. The
+// request is authorized by a token delegated on behalf of the email (see [token.Delegate]): authentication-service
+// accepts only user-service's delegated token(s), such that the username history & availability check(s) owned here
+// can't be bypassed. A non-nil [*server.Exception] is returned for client error(s) relayed from authentication-service.
+func synchronize(ctx context.Context, headers map[string]string, email string, username string) error {
+	jwt, e := token.Delegate(ctx, email)
+	if e != nil {
+		return fmt.Errorf("unable to delegate authorization: %w", e)
+	}
+
+	headers = maps.Clone(headers)
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	headers["Authorization"] = fmt.Sprintf("Bearer %s", jwt)
+
+	var body bytes.Buffer
+	if e := json.NewEncoder(&body).Encode(map[string]string{"username": username}); e != nil {
+		return fmt.Errorf("unable to encode username: %w", e)
+	}
+
+	url := fmt.Sprintf("%s://%s:%d/username", "http", "authentication-service", 8080)
+	if override, ok := ctx.Value("authentication-service-username-endpoint").(string); ok {
+		url = override // currently used for overriding the endpoint during unit-testing
+	}
+
+	request, e := http.NewRequestWithContext(ctx, http.MethodPut, url, &body)
+	if e != nil {
+		return e
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, e := telemetry.Client(headers).Do(request)
+	if e != nil {
+		if strings.Contains(e.Error(), "no such host") {
+			// --> occurs during local testing due to lack of internal kubernetes networking
+			slog.WarnContext(ctx, "Authentication-Service Username Endpoint Not Found", slog.String("error", e.Error()))
+			return nil
+		}
+
+		return fmt.Errorf("unable to send authentication-service request: %w", e)
+	}
+
+	defer response.Body.Close()
+
+	content, _ := io.ReadAll(response.Body)
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return nil
+	case response.StatusCode == http.StatusConflict:
+		return &server.Exception{Code: http.StatusConflict, Message: "Username is Unavailable"}
+	case response.StatusCode >= 400 && response.StatusCode < 500:
+		return &server.Exception{Code: response.StatusCode, Message: strings.TrimSpace(string(content))}
+	default:
+		return fmt.Errorf("authentication-service returned an unexpected status (%s): %s", response.Status, strings.TrimSpace(string(content)))
+	}
+}
@@ -0,0 +1,215 @@
+package username
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"user-service/internal/api/ownership"
+	"user-service/internal/database"
+	"user-service/internal/library/events"
+	"user-service/internal/library/middleware"
+	"user-service/internal/library/middleware/authentication"
+	"user-service/internal/library/middleware/telemetrics"
+	"user-service/internal/library/server"
+	"user-service/internal/library/usernames"
+	"user-service/models/users"
+)
+
+// Handler is an HTTP handler that claims a username for the authenticated user. The user's previous username, if any,
+// redirects to the new username for the [usernames.Cooldown] period, during which only the user can reclaim it. The
+// username is synchronized to authentication-service within the same transaction to enable login by username.
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "username"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the user's identifier.
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Typecast ID from Path Value to Int64", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Establish connection to database.
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Initialize a database transaction in the event of rollback.
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	if !(ownership.Authorize(ctx, w, tx, id, email, "change the user's username")) {
+		return
+	}
+
+	user, e := users.New().Lock(ctx, tx, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Lock User Record", slog.Int64("id", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	previous := user.Username
+	if previous != nil && *previous == input.Username {
+		slog.DebugContext(ctx, "Username Unchanged", slog.Int64("id", id))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(user)
+
+		return
+	}
+
+	availability, e := evaluate(ctx, tx, input.Username, id)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Evaluate Username Availability", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !(availability.Available) {
+		slog.WarnContext(ctx, "Username Unavailable", slog.Int64("id", id), slog.String("username", input.Username), slog.String("reason", availability.Reason))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(availability)
+
+		return
+	}
+
+	user, e = users.New().Rename(ctx, tx, &users.RenameParams{ID: id, Username: input.Username})
+	if e != nil {
+		var exception *pgconn.PgError
+		if errors.As(e, &exception) && exception.Code == "23505" { // --> unique_violation; a concurrent claim won
+			slog.WarnContext(ctx, "Username Claimed Concurrently", slog.Int64("id", id), slog.String("username", input.Username))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(Availability{Username: input.Username, Reason: Taken, Message: "username is unavailable"})
+
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Rename User", slog.Int64("id", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if e := users.New().Reclaim(ctx, tx, &users.ReclaimParams{ID: id, Username: input.Username}); e != nil {
+		slog.ErrorContext(ctx, "Unable to Reclaim Username History", slog.Int64("id", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> a change of casing alone doesn't retire the previous username
+	if previous != nil && usernames.Normalize(*previous) != usernames.Normalize(input.Username) {
+		cooldown := usernames.Cooldown()
+		if e := users.New().Retire(ctx, tx, &users.RetireParams{ID: id, Username: *previous, Cooldown: cooldown.Seconds()}); e != nil {
+			slog.ErrorContext(ctx, "Unable to Retire Previous Username", slog.Int64("id", id), slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if e := synchronize(ctx, telemetrics.New().Value(ctx).Headers, email, input.Username); e != nil {
+		var exception *server.Exception
+		if errors.As(e, &exception) {
+			slog.WarnContext(ctx, "Authentication-Service Rejected Username", slog.Int64("id", id), slog.String("error", e.Error()))
+			exception.Response(w)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Synchronize Username", slog.Int64("id", id), slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	// --> commit the transaction
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Updated Username", slog.Int64("id", id), slog.String("username", input.Username))
+
+	events.Emit(ctx, events.Updated, strconv.FormatInt(id, 10), events.UserUpdated{ID: id, Attributes: []string{"username"}})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(user)
+
+	return
+})
@@ -0,0 +1,104 @@
+package username
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"user-service/internal/library/server"
+	"user-service/internal/token"
+)
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+
+	// --> format and blocklist evaluation(s) don't require a database
+	tests := map[string]string{
+		"ab":       Invalid,
+		"bad name": Invalid,
+		"Admin":    Reserved,
+	}
+
+	for username, reason := range tests {
+		t.Run(username, func(t *testing.T) {
+			availability, e := evaluate(ctx, nil, username, 0)
+			if e != nil {
+				t.Fatalf("Unexpected Error: %v", e)
+			}
+
+			if availability.Available || availability.Reason != reason {
+				t.Errorf("Unexpected Availability: %+v, Expected Reason %q", availability, reason)
+			}
+		})
+	}
+}
+
+func TestSynchronize(t *testing.T) {
+	var received struct {
+		Username      string
+		Authorization string
+	}
+
+	status := http.StatusNoContent
+
+	instance := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+
+		received.Username = body["username"]
+		received.Authorization = r.Header.Get("Authorization")
+
+		w.WriteHeader(status)
+	}))
+
+	defer instance.Close()
+
+	ctx := context.WithValue(context.Background(), "authentication-service-username-endpoint", instance.URL)
+
+	t.Run("Successful", func(t *testing.T) {
+		if e := synchronize(ctx, nil, "user@x-ethr.gg", "segmentational"); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if received.Username != "segmentational" || !(strings.HasPrefix(received.Authorization, "Bearer ")) {
+			t.Fatalf("Unexpected Request: %+v", received)
+		}
+
+		// --> authentication-service accepts only delegated token(s)
+		delegated, _, e := jwt.NewParser().ParseUnverified(strings.TrimPrefix(received.Authorization, "Bearer "), jwt.MapClaims{})
+		if e != nil {
+			t.Fatalf("Unable to Parse Delegated Token: %v", e)
+		}
+
+		subject, _ := delegated.Claims.GetSubject()
+		audiences, _ := delegated.Claims.GetAudience()
+		if subject != "user@x-ethr.gg" || !(slices.Contains(audiences, token.Delegated)) {
+			t.Errorf("Unexpected Delegated Token Claims: %v", delegated.Claims)
+		}
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		status = http.StatusConflict
+
+		var exception *server.Exception
+		if e := synchronize(ctx, nil, "user@x-ethr.gg", "segmentational"); !(errors.As(e, &exception)) || exception.Code != http.StatusConflict {
+			t.Errorf("Expected Conflict Exception, Received: %v", e)
+		}
+	})
+
+	t.Run("Server-Error", func(t *testing.T) {
+		status = http.StatusInternalServerError
+
+		var exception *server.Exception
+		if e := synchronize(ctx, nil, "user@x-ethr.gg", "segmentational"); e == nil || errors.As(e, &exception) {
+			t.Errorf("Expected Non-Exception Error, Received: %v", e)
+		}
+	})
+}
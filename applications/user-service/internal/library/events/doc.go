@@ -0,0 +1,4 @@
+// Package events publishes user lifecycle domain events -- formatted as CloudEvents (v1.0) with versioned payloads -- to a
+// pluggable message bus. A NATS JetStream [Publisher] is provided for deployed environments, and an in-memory [Publisher]
+// for local development and unit-testing.
+package events
@@ -0,0 +1,83 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Stream is a NATS JetStream [Publisher]. Events are published to [Subject] using CloudEvents' structured content mode,
+// and [Event.ID] is used as the JetStream message identifier so the stream discards duplicate publication(s).
+type Stream struct {
+	connection *nats.Conn
+	js         jetstream.JetStream
+}
+
+// JetStream connects to the NATS server(s) and ensures the named stream captures all event subject(s).
+func JetStream(ctx context.Context, url string, stream string) (*Stream, error) {
+	connection, e := nats.Connect(url,
+		nats.Name(source()),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2*time.Second),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, e error) {
+			if e != nil {
+				slog.Warn("Disconnected from NATS", slog.String("error", e.Error()))
+			}
+		}),
+	)
+
+	if e != nil {
+		return nil, fmt.Errorf("events: unable to connect to nats: %w", e)
+	}
+
+	js, e := jetstream.New(connection)
+	if e != nil {
+		connection.Close()
+		return nil, fmt.Errorf("events: unable to establish jetstream context: %w", e)
+	}
+
+	if _, e := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       stream,
+		Subjects:   []string{Subject(">")},
+		Storage:    jetstream.FileStorage,
+		Retention:  jetstream.LimitsPolicy,
+		MaxAge:     7 * 24 * time.Hour,
+		Duplicates: 2 * time.Minute,
+	}); e != nil {
+		connection.Close()
+		return nil, fmt.Errorf("events: unable to establish stream (%s): %w", stream, e)
+	}
+
+	return &Stream{connection: connection, js: js}, nil
+}
+
+func (s *Stream) Publish(ctx context.Context, event Event) error {
+	if event.ID == "" {
+		return errors.New("events: event id is required")
+	}
+
+	content, e := json.Marshal(event)
+	if e != nil {
+		return fmt.Errorf("events: unable to encode event: %w", e)
+	}
+
+	message := nats.NewMsg(Subject(event.Type))
+	message.Header.Set("Content-Type", "application/cloudevents+json")
+	message.Data = content
+
+	if _, e := s.js.PublishMsg(ctx, message, jetstream.WithMsgID(event.ID)); e != nil {
+		return fmt.Errorf("events: unable to publish %s: %w", event.Type, e)
+	}
+
+	return nil
+}
+
+func (s *Stream) Close() error {
+	return s.connection.Drain()
+}
@@ -0,0 +1,3 @@
+// Package usernames validates and normalizes usernames. Usernames are unique case-insensitively: the [Normalize] form is
+// used for comparison, while the user's chosen casing is retained for display.
+package usernames
@@ -0,0 +1,89 @@
+package usernames
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	Minimum = 3  // Minimum represents a username's minimum length.
+	Maximum = 32 // Maximum represents a username's maximum length.
+)
+
+var (
+	ErrInvalid  = errors.New("invalid username")  // ErrInvalid is returned when a username doesn't satisfy the format requirement(s).
+	ErrReserved = errors.New("reserved username") // ErrReserved is returned when a username is on the reserved-word blocklist.
+)
+
+// format requires a username to start and end with a letter or digit, permitting single ".", "_", or "-" separator(s).
+var format = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+
+// reserved represents the blocklist of normalized username(s) that can't be claimed: route segment(s), service and
+// brand name(s), and role(s) that could be mistaken for official account(s).
+var reserved = []string{
+	"about", "abuse", "account", "accounts", "admin", "administrator", "admins", "api", "app", "assets", "auth",
+	"authentication", "billing", "blog", "contact", "dev", "developer", "docs", "ethr", "everyone", "help", "here",
+	"info", "internal", "invitation", "invitations", "login", "logout", "me", "moderator", "mod", "news", "no-reply",
+	"noreply", "null", "official", "operator", "polygun", "postmaster", "privacy", "register", "registration", "root",
+	"security", "service", "settings", "signin", "signup", "staff", "status", "support", "system", "team", "terms",
+	"test", "undefined", "user", "username", "usernames", "users", "verification", "verify", "webmaster", "www",
+}
+
+// Normalize returns the username's comparison form.
+func Normalize(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// Reserved reports whether the username is on the reserved-word blocklist, including "-service" suffixed variant(s).
+func Reserved(username string) bool {
+	normalized := Normalize(username)
+
+	return slices.Contains(reserved, normalized) || slices.Contains(reserved, strings.TrimSuffix(normalized, "-service"))
+}
+
+// Validate returns an error wrapping [ErrInvalid] or [ErrReserved] when the username can't be claimed.
+func Validate(username string) error {
+	if username != strings.TrimSpace(username) {
+		return fmt.Errorf("%w: username can't contain leading or trailing whitespace", ErrInvalid)
+	}
+
+	normalized := Normalize(username)
+
+	switch {
+	case len(normalized) < Minimum || len(normalized) > Maximum:
+		return fmt.Errorf("%w: username must be between %d and %d characters in length", ErrInvalid, Minimum, Maximum)
+	case !(format.MatchString(normalized)):
+		return fmt.Errorf("%w: username may only contain letters, digits, and single \".\", \"_\", or \"-\" separators, and must start and end with a letter or digit", ErrInvalid)
+	case Reserved(normalized):
+		return fmt.Errorf("%w: %q can't be claimed", ErrReserved, username)
+	}
+
+	return nil
+}
+
+// Cooldown returns the duration a previous username redirects to its user's current username, during which no other
+// user can claim it. Configured via the USERNAME_COOLDOWN environment variable (a [time.ParseDuration] string);
+// defaults to 30 days.
+func Cooldown() time.Duration {
+	const fallback = 30 * 24 * time.Hour
+
+	value := os.Getenv("USERNAME_COOLDOWN")
+	if value == "" {
+		return fallback
+	}
+
+	duration, e := time.ParseDuration(value)
+	if e != nil || duration < 0 {
+		slog.Warn("Invalid USERNAME_COOLDOWN Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", fallback))
+
+		return fallback
+	}
+
+	return duration
+}
@@ -0,0 +1,2 @@
+// Package storage provides an object-storage abstraction, alongside local-filesystem and S3-compatible implementations.
+package storage
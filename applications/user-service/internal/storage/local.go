@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+)
+
+// filesystem is a [Storage] implementation backed by a local directory.
+type filesystem struct {
+	root string
+}
+
+// Local returns a [Storage] implementation that writes object(s) beneath the root directory. The directory is created
+// if it doesn't already exist.
+func Local(root string) (Storage, error) {
+	if e := os.MkdirAll(root, 0o750); e != nil {
+		return nil, e
+	}
+
+	return &filesystem{root: root}, nil
+}
+
+func (f *filesystem) path(key string) (string, error) {
+	if !(Valid(key)) {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(f.root, filepath.FromSlash(key)), nil
+}
+
+func (f *filesystem) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	path, e := f.path(key)
+	if e != nil {
+		return e
+	}
+
+	if e := os.MkdirAll(filepath.Dir(path), 0o750); e != nil {
+		return e
+	}
+
+	// --> write to a temporary file and rename to avoid partially-written object(s)
+	temporary, e := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if e != nil {
+		return e
+	}
+
+	defer os.Remove(temporary.Name())
+
+	if _, e := temporary.Write(content); e != nil {
+		temporary.Close()
+		return e
+	}
+
+	if e := temporary.Close(); e != nil {
+		return e
+	}
+
+	return os.Rename(temporary.Name(), path)
+}
+
+func (f *filesystem) Get(ctx context.Context, key string) (io.ReadCloser, *Object, error) {
+	path, e := f.path(key)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	file, e := os.Open(path)
+	if errors.Is(e, fs.ErrNotExist) {
+		return nil, nil, ErrNotFound
+	} else if e != nil {
+		return nil, nil, e
+	}
+
+	information, e := file.Stat()
+	if e != nil {
+		file.Close()
+		return nil, nil, e
+	}
+
+	object := &Object{
+		Key:          key,
+		Size:         information.Size(),
+		ContentType:  mime.TypeByExtension(filepath.Ext(path)),
+		Modification: information.ModTime(),
+	}
+
+	if object.ContentType == "" {
+		object.ContentType = "application/octet-stream"
+	}
+
+	return file, object, nil
+}
+
+func (f *filesystem) Delete(ctx context.Context, key string) error {
+	path, e := f.path(key)
+	if e != nil {
+		return e
+	}
+
+	if e := os.Remove(path); e != nil && !(errors.Is(e, fs.ErrNotExist)) {
+		return e
+	}
+
+	return nil
+}
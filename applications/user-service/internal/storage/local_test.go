@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+
+	bucket, e := Local(t.TempDir())
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	const key = "example-512.png"
+
+	if e := bucket.Put(ctx, key, []byte("content"), "image/png"); e != nil {
+		t.Fatalf("Unable to Put Object: %v", e)
+	}
+
+	reader, object, e := bucket.Get(ctx, key)
+	if e != nil {
+		t.Fatalf("Unable to Get Object: %v", e)
+	}
+
+	content, _ := io.ReadAll(reader)
+	reader.Close()
+
+	if string(content) != "content" || object.Size != int64(len(content)) || object.ContentType != "image/png" {
+		t.Errorf("Unexpected Object: %+v (%s)", object, content)
+	}
+
+	if e := bucket.Delete(ctx, key); e != nil {
+		t.Fatalf("Unable to Delete Object: %v", e)
+	}
+
+	if _, _, e := bucket.Get(ctx, key); !(errors.Is(e, ErrNotFound)) {
+		t.Errorf("Expected (%v), Received (%v)", ErrNotFound, e)
+	}
+
+	for _, key := range []string{"", "../escape", "a/../../b", "/absolute", ".hidden", "a//b"} {
+		if e := bucket.Put(ctx, key, nil, ""); !(errors.Is(e, ErrInvalidKey)) {
+			t.Errorf("Key (%q): Expected (%v), Received (%v)", key, ErrInvalidKey, e)
+		}
+	}
+}
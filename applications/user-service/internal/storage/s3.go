@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"user-service/internal/library/server/telemetry"
+)
+
+// bucket is a [Storage] implementation backed by an S3-compatible bucket.
+type bucket struct {
+	name   string
+	client *s3.Client
+}
+
+// S3 returns a [Storage] implementation that writes object(s) to an S3-compatible bucket. If endpoint is non-empty,
+// requests are sent to the given endpoint using path-style addressing (e.g. MinIO, LocalStack).
+func S3(ctx context.Context, name, region, endpoint string) (Storage, error) {
+	settings, e := config.LoadDefaultConfig(ctx,
+		config.WithRegion(region),
+		config.WithDefaultsMode(aws.DefaultsModeStandard),
+		config.WithRetryMode(aws.RetryModeAdaptive),
+		config.WithHTTPClient(telemetry.Client(map[string]string{})),
+	)
+
+	if e != nil {
+		return nil, e
+	}
+
+	client := s3.NewFromConfig(settings, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	return &bucket{name: name, client: client}, nil
+}
+
+func (b *bucket) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	if !(Valid(key)) {
+		return ErrInvalidKey
+	}
+
+	_, e := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(b.name),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(content),
+		ContentLength: aws.Int64(int64(len(content))),
+		ContentType:   aws.String(contentType),
+	})
+
+	return e
+}
+
+func (b *bucket) Get(ctx context.Context, key string) (io.ReadCloser, *Object, error) {
+	if !(Valid(key)) {
+		return nil, nil, ErrInvalidKey
+	}
+
+	output, e := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.name),
+		Key:    aws.String(key),
+	})
+
+	if e != nil {
+		var missing *types.NoSuchKey
+		var ae smithy.APIError
+		if errors.As(e, &missing) || (errors.As(e, &ae) && ae.ErrorCode() == "NotFound") {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, e
+	}
+
+	object := &Object{
+		Key:          key,
+		Size:         aws.ToInt64(output.ContentLength),
+		ContentType:  aws.ToString(output.ContentType),
+		Modification: aws.ToTime(output.LastModified),
+	}
+
+	return output.Body, object, nil
+}
+
+func (b *bucket) Delete(ctx context.Context, key string) error {
+	if !(Valid(key)) {
+		return ErrInvalidKey
+	}
+
+	_, e := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.name),
+		Key:    aws.String(key),
+	})
+
+	return e
+}
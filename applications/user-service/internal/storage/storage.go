@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")   // ErrNotFound is returned when a requested object doesn't exist.
+	ErrInvalidKey = errors.New("invalid object key") // ErrInvalidKey is returned when an object key contains unsupported character(s) or path traversal(s).
+)
+
+// pattern represents the set of valid object key(s).
+var pattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]{0,254}$`)
+
+// Object represents an object's metadata.
+type Object struct {
+	Key          string    // Key represents the object's unique identifier.
+	Size         int64     // Size represents the object's size in bytes.
+	ContentType  string    // ContentType represents the object's media-type.
+	Modification time.Time // Modification represents the object's last-modified timestamp.
+}
+
+// Storage represents an object-storage backend.
+type Storage interface {
+	// Put writes the content to the given key, overwriting any existing object.
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	// Get opens the object stored at the given key. Callers are responsible for closing the returned [io.ReadCloser].
+	// [ErrNotFound] is returned if the object doesn't exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, *Object, error)
+	// Delete removes the object stored at the given key. Deleting a nonexistent object isn't an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Valid reports whether the key is a valid object key.
+func Valid(key string) bool {
+	if !(pattern.MatchString(key)) {
+		return false
+	}
+
+	for _, partial := range strings.Split(key, "/") {
+		if partial == "" || partial == "." || partial == ".." {
+			return false
+		}
+	}
+
+	return true
+}
+
+var (
+	mutex    sync.Mutex
+	instance Storage
+)
+
+// Default returns the runtime's [Storage] implementation, established according to environment variable(s):
+//
+//   - STORAGE_BACKEND: either "local" (default) or "s3".
+//   - STORAGE_PATH: the local backend's root directory. Defaults to a directory within [os.TempDir].
+//   - STORAGE_BUCKET: the s3 backend's bucket name (required when using s3).
+//   - STORAGE_REGION: the s3 backend's region. Defaults to "us-east-2".
+//   - STORAGE_ENDPOINT: an optional S3-compatible endpoint (e.g. MinIO). Enables path-style addressing.
+func Default(ctx context.Context) (Storage, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if instance != nil {
+		return instance, nil
+	}
+
+	backend := strings.ToLower(os.Getenv("STORAGE_BACKEND"))
+	if backend == "" {
+		backend = "local"
+	}
+
+	slog.InfoContext(ctx, "Establishing Object Storage", slog.String("backend", backend))
+
+	switch backend {
+	case "local":
+		root := os.Getenv("STORAGE_PATH")
+		if root == "" {
+			root = filepath.Join(os.TempDir(), "user-service", "storage")
+		}
+
+		v, e := Local(root)
+		if e != nil {
+			return nil, e
+		}
+
+		instance = v
+	case "s3":
+		bucket := os.Getenv("STORAGE_BUCKET")
+		if bucket == "" {
+			return nil, fmt.Errorf("storage: STORAGE_BUCKET environment variable is required for the s3 backend")
+		}
+
+		region := os.Getenv("STORAGE_REGION")
+		if region == "" {
+			region = "us-east-2"
+		}
+
+		v, e := S3(ctx, bucket, region, os.Getenv("STORAGE_ENDPOINT"))
+		if e != nil {
+			return nil, e
+		}
+
+		instance = v
+	default:
+		return nil, fmt.Errorf("storage: unsupported backend (%s)", backend)
+	}
+
+	return instance, nil
+}
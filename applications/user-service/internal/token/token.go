@@ -8,8 +8,10 @@ import (
 	"log/slog"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"user-service/internal/library/middleware"
 )
@@ -36,6 +38,47 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
+// Delegated represents the audience marking a token minted by [Delegate]. Endpoint(s) reserved for user-service's
+// background work -- e.g. the deletion saga's step(s) & compensation(s) -- accept only token(s) carrying it.
+const Delegated = "user-service/delegated"
+
+// Delegate generates a short-lived, signed JWT token on behalf of the specified email, using HS512 signing. Delegated tokens
+// authorize background work -- such as a deletion saga -- that outlives the user's originating request and token.
+func Delegate(ctx context.Context, email string) (string, error) {
+	now := time.Now()
+	expiration := now.Add(time.Minute * 5)
+
+	issuer := os.Getenv("SERVICE")
+	if issuer == "" {
+		issuer = "user-service"
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:  issuer,
+			Subject: email,
+			Audience: jwt.ClaimStrings{
+				"authentication-service",
+				"user-service",
+				"verification-service",
+				Delegated,
+			},
+			ExpiresAt: jwt.NewNumericDate(expiration),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
+		},
+	})
+
+	jwt, e := token.SignedString(signer)
+	if e != nil {
+		slog.WarnContext(ctx, "Error Signing Delegated JWT Token", slog.String("email", email), slog.String("error", e.Error()))
+
+		return "", e
+	}
+
+	return jwt, nil
+}
+
 func Verify(ctx context.Context, t string) (*jwt.Token, error) {
 	token, e := jwt.Parse(t, func(token *jwt.Token) (interface{}, error) {
 		v, ok := token.Method.(*jwt.SigningMethodHMAC)
@@ -23,6 +23,9 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"user-service/internal/api"
+	"user-service/internal/api/deletion"
+	"user-service/internal/api/export"
+	"user-service/internal/library/events"
 	"user-service/internal/library/middleware"
 	"user-service/internal/library/middleware/logs"
 	"user-service/internal/library/middleware/name"
@@ -304,6 +307,16 @@ func main() {
 
 	api.Router(mux)
 
+	// --> Event Publisher; a missing or unsafe EVENTS_BACKEND is fatal rather than silently discarding event(s)
+	if _, e := events.Default(ctx); e != nil {
+		slog.ErrorContext(ctx, "Unable to Establish Event Publisher", slog.String("error", e.Error()))
+		panic(e)
+	}
+
+	// --> Background Worker(s)
+	go deletion.Orchestrate(ctx)
+	go export.Reclaim(ctx)
+
 	// --> Start the HTTP server
 	slog.Info("Starting Server ...", slog.String("port", *(port)))
 
@@ -0,0 +1,23 @@
+--
+-- Consent Backfill
+--
+
+-- One-time migration seeding the consent ledger from the pre-ledger "User".marketing attribute: every user without a
+-- "marketing" entry receives one reflecting their current value. The statement is idempotent, and is applied once after
+-- schema.sql; it isn't part of the sqlc schema.
+--
+-- The derivation trigger is suspended for the duration, as the seeded entries already match "User".marketing and
+-- re-deriving them would only disturb each user's modification timestamp.
+
+BEGIN;
+
+ALTER TABLE "Consent" DISABLE TRIGGER "consent-marketing-trigger";
+
+INSERT INTO "Consent" ("user", purpose, version, granted, source, creation)
+SELECT u.id, 'marketing', 'legacy', u.marketing, 'migration', coalesce(u.modification, u.creation, now())
+FROM "User" u
+WHERE NOT EXISTS (SELECT 1 FROM "Consent" c WHERE (c."user") = u.id AND (c.purpose) = 'marketing');
+
+ALTER TABLE "Consent" ENABLE TRIGGER "consent-marketing-trigger";
+
+COMMIT;
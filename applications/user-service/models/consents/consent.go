@@ -0,0 +1,51 @@
+package consents
+
+import (
+	"net"
+	"net/netip"
+	"os"
+)
+
+// Marketing represents the purpose whose latest [Consent] entry derives the "User".marketing attribute.
+const Marketing = "marketing"
+
+// [Consent.Source] value(s) recorded by the user-service itself.
+const (
+	Profile = "profile" // Profile represents a decision captured through the profile merge-patch endpoint.
+	API     = "api"     // API represents a decision captured through the consent endpoint without an explicit source.
+
+	// Migration represents an entry seeded by backfill.sql from the pre-ledger "User".marketing attribute, recorded
+	// with the "legacy" version.
+	Migration = "migration"
+)
+
+// Version returns the current version of the terms text, established via the CONSENT_TERMS_VERSION environment
+// variable. It's used when a caller doesn't specify the version it presented to the user.
+func Version() string {
+	if v := os.Getenv("CONSENT_TERMS_VERSION"); v != "" {
+		return v
+	}
+
+	return "1"
+}
+
+// Address resolves the client's IP address, preferring the proxy-derived real address over the connection's remote
+// address. Nil is returned if neither can be parsed.
+func Address(real, remote string) *netip.Addr {
+	for _, candidate := range []string{real, remote} {
+		if candidate == "" {
+			continue
+		}
+
+		if host, _, e := net.SplitHostPort(candidate); e == nil {
+			candidate = host
+		}
+
+		if address, e := netip.ParseAddr(candidate); e == nil {
+			address = address.Unmap()
+			return &address
+		}
+	}
+
+	return nil
+}
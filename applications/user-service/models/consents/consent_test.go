@@ -0,0 +1,29 @@
+package consents
+
+import (
+	"testing"
+)
+
+func TestAddress(t *testing.T) {
+	cases := []struct {
+		real, remote string
+		expectation  string
+	}{
+		{"203.0.113.7", "10.0.0.1:5431", "203.0.113.7"},
+		{"", "10.0.0.1:5431", "10.0.0.1"},
+		{"", "[2001:db8::1]:443", "2001:db8::1"},
+		{"", "[::ffff:192.0.2.1]:80", "192.0.2.1"},
+		{"invalid", "192.0.2.1", "192.0.2.1"},
+	}
+
+	for _, c := range cases {
+		address := Address(c.real, c.remote)
+		if address == nil || address.String() != c.expectation {
+			t.Errorf("Address(%q, %q): Expected (%s), Received (%v)", c.real, c.remote, c.expectation, address)
+		}
+	}
+
+	if address := Address("", ""); address != nil {
+		t.Errorf("Expected Nil Address, Received (%v)", address)
+	}
+}
@@ -0,0 +1,3 @@
+package consents
+
+//go:generate sqlc generate
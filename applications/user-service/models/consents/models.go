@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package consents
+
+import (
+	"net/netip"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Consent represents an append-only ledger of a user's consent decision(s). Entries are never updated; the latest entry per purpose is authoritative.
+type Consent struct {
+	ID int64 `db:"id" json:"id"`
+	// User represents the "User" record's identifier the consent decision belongs to.
+	User int64 `db:"user" json:"user"`
+	// Purpose represents the processing purpose the decision applies to (e.g. "marketing").
+	Purpose string `db:"purpose" json:"purpose"`
+	// Version represents the version of the terms text presented to the user.
+	Version string `db:"version" json:"version"`
+	// Granted is true when consent was given, and false when consent was withdrawn.
+	Granted bool `db:"granted" json:"granted"`
+	// Source represents the channel through which the decision was captured (e.g. "registration", "profile", "api").
+	Source string `db:"source" json:"source"`
+	// Address represents the client IP address that submitted the decision, when known.
+	Address  *netip.Addr        `db:"address" json:"address"`
+	Creation pgtype.Timestamptz `db:"creation" json:"creation"`
+}
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package consents
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Current retrieves the latest [Consent] entry for every purpose the user has recorded a decision for.
+	Current(ctx context.Context, db DBTX, userID int64) ([]Consent, error)
+	// History retrieves the user's [Consent] ledger, most recent first, optionally filtered by purpose.
+	History(ctx context.Context, db DBTX, arg *HistoryParams) ([]Consent, error)
+	// Purge removes all of the user's [Consent] ledger entries. Purge is only used to erase a hard-deleted user.
+	Purge(ctx context.Context, db DBTX, userID int64) error
+	// Record appends a new [Consent] entry to the ledger. A "marketing" entry updates the user's derived marketing attribute.
+	Record(ctx context.Context, db DBTX, arg *RecordParams) (Consent, error)
+}
+
+var _ Querier = (*Queries)(nil)
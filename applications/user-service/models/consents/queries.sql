@@ -0,0 +1,21 @@
+-- name: Record :one
+-- Record appends a new [Consent] entry to the ledger. A "marketing" entry updates the user's derived marketing attribute.
+INSERT INTO "Consent" ("user", purpose, version, granted, source, address)
+VALUES (sqlc.arg(user_id), sqlc.arg(purpose), sqlc.arg(version), sqlc.arg(granted), sqlc.arg(source), sqlc.narg(address))
+RETURNING *;
+
+-- name: History :many
+-- History retrieves the user's [Consent] ledger, most recent first, optionally filtered by purpose.
+SELECT * FROM "Consent"
+WHERE ("user") = sqlc.arg(user_id) AND (sqlc.narg(purpose)::varchar IS NULL OR (purpose) = sqlc.narg(purpose)::varchar)
+ORDER BY creation DESC, id DESC;
+
+-- name: Current :many
+-- Current retrieves the latest [Consent] entry for every purpose the user has recorded a decision for.
+SELECT DISTINCT ON (purpose) * FROM "Consent"
+WHERE ("user") = sqlc.arg(user_id)
+ORDER BY purpose, creation DESC, id DESC;
+
+-- name: Purge :exec
+-- Purge removes all of the user's [Consent] ledger entries. Purge is only used to erase a hard-deleted user.
+DELETE FROM "Consent" WHERE ("user") = sqlc.arg(user_id);
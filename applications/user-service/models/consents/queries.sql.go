@@ -0,0 +1,137 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package consents
+
+import (
+	"context"
+	"net/netip"
+)
+
+const current = `-- name: Current :many
+SELECT DISTINCT ON (purpose) id, "user", purpose, version, granted, source, address, creation FROM "Consent"
+WHERE ("user") = $1
+ORDER BY purpose, creation DESC, id DESC
+`
+
+// Current retrieves the latest [Consent] entry for every purpose the user has recorded a decision for.
+func (q *Queries) Current(ctx context.Context, db DBTX, userID int64) ([]Consent, error) {
+	rows, err := db.Query(ctx, current, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Consent{}
+	for rows.Next() {
+		var i Consent
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.Purpose,
+			&i.Version,
+			&i.Granted,
+			&i.Source,
+			&i.Address,
+			&i.Creation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const history = `-- name: History :many
+SELECT id, "user", purpose, version, granted, source, address, creation FROM "Consent"
+WHERE ("user") = $1 AND ($2::varchar IS NULL OR (purpose) = $2::varchar)
+ORDER BY creation DESC, id DESC
+`
+
+type HistoryParams struct {
+	UserID  int64   `db:"user_id" json:"user_id"`
+	Purpose *string `db:"purpose" json:"purpose"`
+}
+
+// History retrieves the user's [Consent] ledger, most recent first, optionally filtered by purpose.
+func (q *Queries) History(ctx context.Context, db DBTX, arg *HistoryParams) ([]Consent, error) {
+	rows, err := db.Query(ctx, history, arg.UserID, arg.Purpose)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Consent{}
+	for rows.Next() {
+		var i Consent
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.Purpose,
+			&i.Version,
+			&i.Granted,
+			&i.Source,
+			&i.Address,
+			&i.Creation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purge = `-- name: Purge :exec
+DELETE FROM "Consent" WHERE ("user") = $1
+`
+
+// Purge removes all of the user's [Consent] ledger entries. Purge is only used to erase a hard-deleted user.
+func (q *Queries) Purge(ctx context.Context, db DBTX, userID int64) error {
+	_, err := db.Exec(ctx, purge, userID)
+	return err
+}
+
+const record = `-- name: Record :one
+INSERT INTO "Consent" ("user", purpose, version, granted, source, address)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, "user", purpose, version, granted, source, address, creation
+`
+
+type RecordParams struct {
+	UserID  int64       `db:"user_id" json:"user_id"`
+	Purpose string      `db:"purpose" json:"purpose"`
+	Version string      `db:"version" json:"version"`
+	Granted bool        `db:"granted" json:"granted"`
+	Source  string      `db:"source" json:"source"`
+	Address *netip.Addr `db:"address" json:"address"`
+}
+
+// Record appends a new [Consent] entry to the ledger. A "marketing" entry updates the user's derived marketing attribute.
+func (q *Queries) Record(ctx context.Context, db DBTX, arg *RecordParams) (Consent, error) {
+	row := db.QueryRow(ctx, record,
+		arg.UserID,
+		arg.Purpose,
+		arg.Version,
+		arg.Granted,
+		arg.Source,
+		arg.Address,
+	)
+	var i Consent
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Purpose,
+		&i.Version,
+		&i.Granted,
+		&i.Source,
+		&i.Address,
+		&i.Creation,
+	)
+	return i, err
+}
@@ -0,0 +1,61 @@
+--
+-- Consent
+--
+
+CREATE TABLE "Consent"
+(
+    "id"       bigserial
+        CONSTRAINT "consent-id-primary-key" primary key,
+
+    "user"     bigint                                 not null,
+
+    "purpose"  varchar(64)                            not null
+        CONSTRAINT "consent-purpose-constraint" CHECK ("Consent"."purpose" ~ '^[a-z][a-z0-9-]{0,63}$'),
+
+    "version"  varchar(64)                            not null,
+    "granted"  boolean                                not null,
+    "source"   varchar(64)                            not null,
+    "address"  inet                     default NULL,
+
+    "creation" timestamp with time zone default now() not null
+);
+
+COMMENT ON TABLE "Consent" IS 'Consent represents an append-only ledger of a user''s consent decision(s). Entries are never updated; the latest entry per purpose is authoritative.';
+COMMENT ON COLUMN "Consent"."user" IS 'User represents the "User" record''s identifier the consent decision belongs to.';
+COMMENT ON COLUMN "Consent"."purpose" IS 'Purpose represents the processing purpose the decision applies to (e.g. "marketing").';
+COMMENT ON COLUMN "Consent"."version" IS 'Version represents the version of the terms text presented to the user.';
+COMMENT ON COLUMN "Consent"."granted" IS 'Granted is true when consent was given, and false when consent was withdrawn.';
+COMMENT ON COLUMN "Consent"."source" IS 'Source represents the channel through which the decision was captured (e.g. "registration", "profile", "api").';
+COMMENT ON COLUMN "Consent"."address" IS 'Address represents the client IP address that submitted the decision, when known.';
+
+CREATE INDEX IF NOT EXISTS "consent-user-purpose-index" on "Consent" ("user", purpose, creation DESC, id DESC);
+
+-- Entries are immutable; deletion is only permitted for erasure of the owning user.
+CREATE OR REPLACE FUNCTION "consent-immutable"() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'consent ledger entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER "consent-immutable-trigger"
+    BEFORE UPDATE
+    ON "Consent"
+    FOR EACH ROW
+EXECUTE FUNCTION "consent-immutable"();
+
+-- The "User".marketing attribute is derived from the latest "marketing" ledger entry.
+CREATE OR REPLACE FUNCTION "consent-marketing"() RETURNS trigger AS
+$$
+BEGIN
+    UPDATE "User" SET marketing = NEW.granted, modification = now() WHERE (id) = NEW."user";
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER "consent-marketing-trigger"
+    AFTER INSERT
+    ON "Consent"
+    FOR EACH ROW
+    WHEN (NEW.purpose = 'marketing')
+EXECUTE FUNCTION "consent-marketing"();
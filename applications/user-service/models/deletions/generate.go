@@ -0,0 +1,3 @@
+package deletions
+
+//go:generate sqlc generate
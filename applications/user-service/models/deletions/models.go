@@ -0,0 +1,41 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package deletions
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Deletion represents an account deletion saga orchestrated across the authentication-service, user-service, and verification-service.
+type Deletion struct {
+	ID int64 `db:"id" json:"id"`
+	// User represents the "User" record's identifier being deleted.
+	User int64 `db:"user" json:"user"`
+	// Email represents the deleted user's email address, retained so the saga can resume after the "User" record is removed.
+	Email  string  `db:"email" json:"email"`
+	Type   string  `db:"type" json:"type"`
+	Status string  `db:"status" json:"status"`
+	Error  *string `db:"error" json:"error"`
+	// Schedule represents the earliest time the saga may next be advanced; claiming a saga leases it by moving the schedule forward.
+	Schedule     pgtype.Timestamptz `db:"schedule" json:"schedule"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	Completion   pgtype.Timestamptz `db:"completion" json:"completion"`
+}
+
+// Deletion-Step represents a single service's participation in a "Deletion" saga. Steps execute in ascending sequence and compensate in descending sequence.
+type DeletionStep struct {
+	ID       int64  `db:"id" json:"id"`
+	Deletion int64  `db:"deletion" json:"deletion"`
+	Sequence int16  `db:"sequence" json:"sequence"`
+	Service  string `db:"service" json:"service"`
+	Status   string `db:"status" json:"status"`
+	// Attempts represents the number of failed attempt(s) of the step's current action (execution or compensation).
+	Attempts int32 `db:"attempts" json:"attempts"`
+	// Error represents the most recent attempt's failure reason.
+	Error        *string            `db:"error" json:"error"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	Completion   pgtype.Timestamptz `db:"completion" json:"completion"`
+}
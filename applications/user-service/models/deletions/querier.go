@@ -0,0 +1,39 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package deletions
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Active retrieves the user's in-flight [Deletion] saga, if any.
+	Active(ctx context.Context, db DBTX, userID int64) (Deletion, error)
+	// Attempt records a [DeletionStep]'s failed attempt, returning the updated record.
+	Attempt(ctx context.Context, db DBTX, arg *AttemptParams) (DeletionStep, error)
+	// Claim leases up to size due, in-flight [Deletion] saga(s) by moving each schedule forward by the lease duration.
+	// Concurrent claimants skip locked row(s), and a saga whose claimant exits before releasing it becomes due once the
+	// lease expires.
+	Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Deletion, error)
+	// Create establishes a new, pending [Deletion] saga.
+	Create(ctx context.Context, db DBTX, arg *CreateParams) (Deletion, error)
+	// Fail marks a [DeletionStep] as failed, retaining its attempt count and recording the failure's reason.
+	Fail(ctx context.Context, db DBTX, arg *FailParams) error
+	// Get retrieves a [Deletion] saga by its identifier.
+	Get(ctx context.Context, db DBTX, id int64) (Deletion, error)
+	// Progress updates a [DeletionStep]'s status, resetting its attempt(s) and error, and recording completion upon a
+	// terminal status.
+	Progress(ctx context.Context, db DBTX, arg *ProgressParams) error
+	// Schedule sets the earliest time a [Deletion] saga may next be advanced.
+	Schedule(ctx context.Context, db DBTX, arg *ScheduleParams) error
+	// Step establishes a pending [DeletionStep] for the specified saga.
+	Step(ctx context.Context, db DBTX, arg *StepParams) (DeletionStep, error)
+	// Steps retrieves a saga's [DeletionStep] record(s) in execution order.
+	Steps(ctx context.Context, db DBTX, deletion int64) ([]DeletionStep, error)
+	// Transition updates a [Deletion] saga's status and error, recording completion upon a terminal status.
+	Transition(ctx context.Context, db DBTX, arg *TransitionParams) error
+}
+
+var _ Querier = (*Queries)(nil)
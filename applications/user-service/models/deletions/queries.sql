@@ -0,0 +1,65 @@
+-- name: Create :one
+-- Create establishes a new, pending [Deletion] saga.
+INSERT INTO "Deletion" ("user", email, type) VALUES (sqlc.arg(user_id), sqlc.arg(email), sqlc.arg(type)) RETURNING *;
+
+-- name: Step :one
+-- Step establishes a pending [DeletionStep] for the specified saga.
+INSERT INTO "Deletion-Step" (deletion, sequence, service) VALUES (sqlc.arg(deletion), sqlc.arg(sequence), sqlc.arg(service)) RETURNING *;
+
+-- name: Get :one
+-- Get retrieves a [Deletion] saga by its identifier.
+SELECT * FROM "Deletion" WHERE (id) = sqlc.arg(id);
+
+-- name: Active :one
+-- Active retrieves the user's in-flight [Deletion] saga, if any.
+SELECT * FROM "Deletion" WHERE ("user") = sqlc.arg(user_id) AND (status) IN ('PENDING', 'RUNNING', 'COMPENSATING') LIMIT 1;
+
+-- name: Steps :many
+-- Steps retrieves a saga's [DeletionStep] record(s) in execution order.
+SELECT * FROM "Deletion-Step" WHERE (deletion) = sqlc.arg(deletion) ORDER BY sequence;
+
+-- name: Claim :many
+-- Claim leases up to size due, in-flight [Deletion] saga(s) by moving each schedule forward by the lease duration.
+-- Concurrent claimants skip locked row(s), and a saga whose claimant exits before releasing it becomes due once the
+-- lease expires.
+UPDATE "Deletion"
+SET schedule = now() + make_interval(secs => sqlc.arg(lease)::float8)
+WHERE (id) IN (SELECT d.id
+               FROM "Deletion" d
+               WHERE (d.status) IN ('PENDING', 'RUNNING', 'COMPENSATING')
+                 AND (d.schedule) <= now()
+               ORDER BY d.schedule
+               LIMIT sqlc.arg(size)::int FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: Transition :exec
+-- Transition updates a [Deletion] saga's status and error, recording completion upon a terminal status.
+UPDATE "Deletion"
+SET status       = sqlc.arg(status),
+    error        = sqlc.narg(error),
+    modification = now(),
+    completion   = CASE WHEN sqlc.arg(status) IN ('COMPLETE', 'COMPENSATED', 'FAILED') THEN now() END
+WHERE (id) = sqlc.arg(id);
+
+-- name: Schedule :exec
+-- Schedule sets the earliest time a [Deletion] saga may next be advanced.
+UPDATE "Deletion" SET schedule = sqlc.arg(schedule), modification = now() WHERE (id) = sqlc.arg(id);
+
+-- name: Progress :exec
+-- Progress updates a [DeletionStep]'s status, resetting its attempt(s) and error, and recording completion upon a
+-- terminal status.
+UPDATE "Deletion-Step"
+SET status       = sqlc.arg(status),
+    attempts     = 0,
+    error        = NULL,
+    modification = now(),
+    completion   = CASE WHEN sqlc.arg(status) IN ('COMPLETE', 'FAILED', 'COMPENSATED') THEN now() END
+WHERE (id) = sqlc.arg(id);
+
+-- name: Attempt :one
+-- Attempt records a [DeletionStep]'s failed attempt, returning the updated record.
+UPDATE "Deletion-Step" SET attempts = attempts + 1, error = sqlc.arg(error), modification = now() WHERE (id) = sqlc.arg(id) RETURNING *;
+
+-- name: Fail :exec
+-- Fail marks a [DeletionStep] as failed, retaining its attempt count and recording the failure's reason.
+UPDATE "Deletion-Step" SET status = 'FAILED', error = sqlc.arg(error), modification = now(), completion = now() WHERE (id) = sqlc.arg(id);
@@ -0,0 +1,301 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package deletions
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const active = `-- name: Active :one
+SELECT id, "user", email, type, status, error, schedule, creation, modification, completion FROM "Deletion" WHERE ("user") = $1 AND (status) IN ('PENDING', 'RUNNING', 'COMPENSATING') LIMIT 1
+`
+
+// Active retrieves the user's in-flight [Deletion] saga, if any.
+func (q *Queries) Active(ctx context.Context, db DBTX, userID int64) (Deletion, error) {
+	row := db.QueryRow(ctx, active, userID)
+	var i Deletion
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Email,
+		&i.Type,
+		&i.Status,
+		&i.Error,
+		&i.Schedule,
+		&i.Creation,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const attempt = `-- name: Attempt :one
+UPDATE "Deletion-Step" SET attempts = attempts + 1, error = $1, modification = now() WHERE (id) = $2 RETURNING id, deletion, sequence, service, status, attempts, error, modification, completion
+`
+
+type AttemptParams struct {
+	Error *string `db:"error" json:"error"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Attempt records a [DeletionStep]'s failed attempt, returning the updated record.
+func (q *Queries) Attempt(ctx context.Context, db DBTX, arg *AttemptParams) (DeletionStep, error) {
+	row := db.QueryRow(ctx, attempt, arg.Error, arg.ID)
+	var i DeletionStep
+	err := row.Scan(
+		&i.ID,
+		&i.Deletion,
+		&i.Sequence,
+		&i.Service,
+		&i.Status,
+		&i.Attempts,
+		&i.Error,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const claim = `-- name: Claim :many
+UPDATE "Deletion"
+SET schedule = now() + make_interval(secs => $1::float8)
+WHERE (id) IN (SELECT d.id
+               FROM "Deletion" d
+               WHERE (d.status) IN ('PENDING', 'RUNNING', 'COMPENSATING')
+                 AND (d.schedule) <= now()
+               ORDER BY d.schedule
+               LIMIT $2::int FOR UPDATE SKIP LOCKED)
+RETURNING id, "user", email, type, status, error, schedule, creation, modification, completion
+`
+
+type ClaimParams struct {
+	Lease float64 `db:"lease" json:"lease"`
+	Size  int32   `db:"size" json:"size"`
+}
+
+// Claim leases up to size due, in-flight [Deletion] saga(s) by moving each schedule forward by the lease duration.
+// Concurrent claimants skip locked row(s), and a saga whose claimant exits before releasing it becomes due once the
+// lease expires.
+func (q *Queries) Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Deletion, error) {
+	rows, err := db.Query(ctx, claim, arg.Lease, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Deletion{}
+	for rows.Next() {
+		var i Deletion
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.Email,
+			&i.Type,
+			&i.Status,
+			&i.Error,
+			&i.Schedule,
+			&i.Creation,
+			&i.Modification,
+			&i.Completion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const create = `-- name: Create :one
+INSERT INTO "Deletion" ("user", email, type) VALUES ($1, $2, $3) RETURNING id, "user", email, type, status, error, schedule, creation, modification, completion
+`
+
+type CreateParams struct {
+	UserID int64  `db:"user_id" json:"user_id"`
+	Email  string `db:"email" json:"email"`
+	Type   string `db:"type" json:"type"`
+}
+
+// Create establishes a new, pending [Deletion] saga.
+func (q *Queries) Create(ctx context.Context, db DBTX, arg *CreateParams) (Deletion, error) {
+	row := db.QueryRow(ctx, create, arg.UserID, arg.Email, arg.Type)
+	var i Deletion
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Email,
+		&i.Type,
+		&i.Status,
+		&i.Error,
+		&i.Schedule,
+		&i.Creation,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const fail = `-- name: Fail :exec
+UPDATE "Deletion-Step" SET status = 'FAILED', error = $1, modification = now(), completion = now() WHERE (id) = $2
+`
+
+type FailParams struct {
+	Error *string `db:"error" json:"error"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Fail marks a [DeletionStep] as failed, retaining its attempt count and recording the failure's reason.
+func (q *Queries) Fail(ctx context.Context, db DBTX, arg *FailParams) error {
+	_, err := db.Exec(ctx, fail, arg.Error, arg.ID)
+	return err
+}
+
+const get = `-- name: Get :one
+SELECT id, "user", email, type, status, error, schedule, creation, modification, completion FROM "Deletion" WHERE (id) = $1
+`
+
+// Get retrieves a [Deletion] saga by its identifier.
+func (q *Queries) Get(ctx context.Context, db DBTX, id int64) (Deletion, error) {
+	row := db.QueryRow(ctx, get, id)
+	var i Deletion
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Email,
+		&i.Type,
+		&i.Status,
+		&i.Error,
+		&i.Schedule,
+		&i.Creation,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const progress = `-- name: Progress :exec
+UPDATE "Deletion-Step"
+SET status       = $1,
+    attempts     = 0,
+    error        = NULL,
+    modification = now(),
+    completion   = CASE WHEN $1 IN ('COMPLETE', 'FAILED', 'COMPENSATED') THEN now() END
+WHERE (id) = $2
+`
+
+type ProgressParams struct {
+	Status string `db:"status" json:"status"`
+	ID     int64  `db:"id" json:"id"`
+}
+
+// Progress updates a [DeletionStep]'s status, resetting its attempt(s) and error, and recording completion upon a
+// terminal status.
+func (q *Queries) Progress(ctx context.Context, db DBTX, arg *ProgressParams) error {
+	_, err := db.Exec(ctx, progress, arg.Status, arg.ID)
+	return err
+}
+
+const schedule = `-- name: Schedule :exec
+UPDATE "Deletion" SET schedule = $1, modification = now() WHERE (id) = $2
+`
+
+type ScheduleParams struct {
+	Schedule pgtype.Timestamptz `db:"schedule" json:"schedule"`
+	ID       int64              `db:"id" json:"id"`
+}
+
+// Schedule sets the earliest time a [Deletion] saga may next be advanced.
+func (q *Queries) Schedule(ctx context.Context, db DBTX, arg *ScheduleParams) error {
+	_, err := db.Exec(ctx, schedule, arg.Schedule, arg.ID)
+	return err
+}
+
+const step = `-- name: Step :one
+INSERT INTO "Deletion-Step" (deletion, sequence, service) VALUES ($1, $2, $3) RETURNING id, deletion, sequence, service, status, attempts, error, modification, completion
+`
+
+type StepParams struct {
+	Deletion int64  `db:"deletion" json:"deletion"`
+	Sequence int16  `db:"sequence" json:"sequence"`
+	Service  string `db:"service" json:"service"`
+}
+
+// Step establishes a pending [DeletionStep] for the specified saga.
+func (q *Queries) Step(ctx context.Context, db DBTX, arg *StepParams) (DeletionStep, error) {
+	row := db.QueryRow(ctx, step, arg.Deletion, arg.Sequence, arg.Service)
+	var i DeletionStep
+	err := row.Scan(
+		&i.ID,
+		&i.Deletion,
+		&i.Sequence,
+		&i.Service,
+		&i.Status,
+		&i.Attempts,
+		&i.Error,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const steps = `-- name: Steps :many
+SELECT id, deletion, sequence, service, status, attempts, error, modification, completion FROM "Deletion-Step" WHERE (deletion) = $1 ORDER BY sequence
+`
+
+// Steps retrieves a saga's [DeletionStep] record(s) in execution order.
+func (q *Queries) Steps(ctx context.Context, db DBTX, deletion int64) ([]DeletionStep, error) {
+	rows, err := db.Query(ctx, steps, deletion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DeletionStep{}
+	for rows.Next() {
+		var i DeletionStep
+		if err := rows.Scan(
+			&i.ID,
+			&i.Deletion,
+			&i.Sequence,
+			&i.Service,
+			&i.Status,
+			&i.Attempts,
+			&i.Error,
+			&i.Modification,
+			&i.Completion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transition = `-- name: Transition :exec
+UPDATE "Deletion"
+SET status       = $1,
+    error        = $2,
+    modification = now(),
+    completion   = CASE WHEN $1 IN ('COMPLETE', 'COMPENSATED', 'FAILED') THEN now() END
+WHERE (id) = $3
+`
+
+type TransitionParams struct {
+	Status string  `db:"status" json:"status"`
+	Error  *string `db:"error" json:"error"`
+	ID     int64   `db:"id" json:"id"`
+}
+
+// Transition updates a [Deletion] saga's status and error, recording completion upon a terminal status.
+func (q *Queries) Transition(ctx context.Context, db DBTX, arg *TransitionParams) error {
+	_, err := db.Exec(ctx, transition, arg.Status, arg.Error, arg.ID)
+	return err
+}
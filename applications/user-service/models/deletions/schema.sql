@@ -0,0 +1,68 @@
+--
+-- Deletion
+--
+
+CREATE TABLE "Deletion"
+(
+    "id"           bigserial
+        CONSTRAINT "deletion-id-primary-key" primary key,
+
+    "user"         bigint                                     not null,
+    "email"        varchar(255)                               not null,
+
+    "type"         varchar(4)                                 not null
+        CONSTRAINT "deletion-type-constraint" CHECK ("Deletion"."type" IN ('soft', 'hard')),
+
+    "status"       varchar(16)              default 'PENDING' not null
+        CONSTRAINT "deletion-status-constraint" CHECK ("Deletion"."status" IN ('PENDING', 'RUNNING', 'COMPENSATING', 'COMPLETE', 'COMPENSATED', 'FAILED')),
+
+    "error"        text                     default NULL,
+
+    "schedule"     timestamp with time zone default now()     not null,
+
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone,
+    "completion"   timestamp with time zone
+);
+
+COMMENT ON TABLE "Deletion" IS 'Deletion represents an account deletion saga orchestrated across the authentication-service, user-service, and verification-service.';
+COMMENT ON COLUMN "Deletion"."user" IS 'User represents the "User" record''s identifier being deleted.';
+COMMENT ON COLUMN "Deletion"."email" IS 'Email represents the deleted user''s email address, retained so the saga can resume after the "User" record is removed.';
+COMMENT ON COLUMN "Deletion"."schedule" IS 'Schedule represents the earliest time the saga may next be advanced; claiming a saga leases it by moving the schedule forward.';
+
+CREATE INDEX IF NOT EXISTS "deletion-user-index" on "Deletion" ("user");
+CREATE INDEX IF NOT EXISTS "deletion-schedule-index" on "Deletion" (schedule) WHERE (status) IN ('PENDING', 'RUNNING', 'COMPENSATING');
+
+-- At most a single in-flight saga per user.
+CREATE UNIQUE INDEX IF NOT EXISTS "deletion-active-user-index" on "Deletion" ("user") WHERE (status) IN ('PENDING', 'RUNNING', 'COMPENSATING');
+
+--
+-- Deletion-Step
+--
+
+CREATE TABLE "Deletion-Step"
+(
+    "id"           bigserial
+        CONSTRAINT "deletion-step-id-primary-key" primary key,
+
+    "deletion"     bigint                                     not null
+        CONSTRAINT "deletion-step-deletion-foreign-key" REFERENCES "Deletion" (id) ON DELETE CASCADE,
+
+    "sequence"     smallint                                   not null,
+    "service"      varchar(64)                                not null,
+
+    "status"       varchar(16)              default 'PENDING' not null
+        CONSTRAINT "deletion-step-status-constraint" CHECK ("Deletion-Step"."status" IN ('PENDING', 'COMPLETE', 'FAILED', 'COMPENSATING', 'COMPENSATED')),
+
+    "attempts"     integer                  default 0         not null,
+    "error"        text                     default NULL,
+
+    "modification" timestamp with time zone,
+    "completion"   timestamp with time zone,
+
+    CONSTRAINT "deletion-step-service-unique" UNIQUE (deletion, service)
+);
+
+COMMENT ON TABLE "Deletion-Step" IS 'Deletion-Step represents a single service''s participation in a "Deletion" saga. Steps execute in ascending sequence and compensate in descending sequence.';
+COMMENT ON COLUMN "Deletion-Step"."attempts" IS 'Attempts represents the number of failed attempt(s) of the step''s current action (execution or compensation).';
+COMMENT ON COLUMN "Deletion-Step"."error" IS 'Error represents the most recent attempt''s failure reason.';
@@ -0,0 +1,18 @@
+package deletions
+
+// [Deletion.Status] value(s), as constrained by the "deletion-status-constraint" check. [DeletionStep.Status] shares the
+// same value(s), excluding [Running].
+const (
+	Pending      = "PENDING"      // Pending represents a saga that has been requested but not yet started.
+	Running      = "RUNNING"      // Running represents a saga that is executing its step(s).
+	Compensating = "COMPENSATING" // Compensating represents a failed saga that is reverting its completed step(s).
+	Complete     = "COMPLETE"     // Complete represents a saga whose step(s) have all completed.
+	Compensated  = "COMPENSATED"  // Compensated represents a failed saga whose completed step(s) have all been reverted.
+	Failed       = "FAILED"       // Failed represents a saga that could neither complete nor fully compensate; see [Deletion.Error].
+)
+
+// [Deletion.Type] value(s), as constrained by the "deletion-type-constraint" check.
+const (
+	Soft = "soft" // Soft represents a deletion that marks record(s) deleted, and can be compensated.
+	Hard = "hard" // Hard represents a deletion that permanently removes record(s).
+)
@@ -0,0 +1,3 @@
+package exports
+
+//go:generate sqlc generate
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package exports
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Export struct {
+	ID int64 `db:"id" json:"id"`
+	// User represents the "User" record's identifier that requested the data-subject access export.
+	User     int64  `db:"user" json:"user"`
+	Status   string `db:"status" json:"status"`
+	Progress int16  `db:"progress" json:"progress"`
+	// Key represents the object-storage key of the completed export archive.
+	Key *string `db:"key" json:"key"`
+	// Signature represents the base64-encoded HMAC-SHA256 signature of the completed export archive.
+	Signature *string `db:"signature" json:"signature"`
+	Error     *string `db:"error" json:"error"`
+	// Attempts represents the number of time(s) the export job was started, including reclaim(s) of an abandoned job.
+	Attempts int16              `db:"attempts" json:"attempts"`
+	Creation pgtype.Timestamptz `db:"creation" json:"creation"`
+	// Modification represents the export job's most recent progress; an in-flight export whose modification lapses beyond the lease is reclaimed.
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	Completion   pgtype.Timestamptz `db:"completion" json:"completion"`
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package exports
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Active retrieves the user's pending or running [Export] database record(s), most recent first.
+	Active(ctx context.Context, db DBTX, userID int64) ([]Export, error)
+	// Claim leases up to size abandoned [Export](s) -- pending or running, without progress for the lease duration -- by
+	// refreshing each modification, counting the attempt. Progress refreshes the modification, such that a live job's lease
+	// is renewed with each step. Concurrent claimants skip locked row(s).
+	Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Export, error)
+	// Complete marks an [Export] as complete, recording its archive's object-storage key and signature.
+	Complete(ctx context.Context, db DBTX, arg *CompleteParams) error
+	// Create establishes a new, pending [Export] database record. A user is limited to a single pending or running export;
+	// should one already exist, no record is created and no row is returned.
+	Create(ctx context.Context, db DBTX, userID int64) (Export, error)
+	// Fail marks an [Export] as failed, recording the failure's reason.
+	Fail(ctx context.Context, db DBTX, arg *FailParams) error
+	// Get retrieves an [Export] database record belonging to the specified user.
+	Get(ctx context.Context, db DBTX, arg *GetParams) (Export, error)
+	// Progress marks an [Export] as running and updates its completion percentage.
+	Progress(ctx context.Context, db DBTX, arg *ProgressParams) error
+	// Purge removes all of the user's [Export] database record(s), returning each removed archive's object-storage key. Purge
+	// is only used to erase a hard-deleted user.
+	Purge(ctx context.Context, db DBTX, userID int64) ([]*string, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,48 @@
+-- name: Create :one
+-- Create establishes a new, pending [Export] database record. A user is limited to a single pending or running export;
+-- should one already exist, no record is created and no row is returned.
+INSERT INTO "Export" ("user", modification) VALUES (sqlc.arg(user_id), now())
+ON CONFLICT ("user") WHERE status IN ('PENDING', 'RUNNING') DO NOTHING
+RETURNING *;
+
+-- name: Get :one
+-- Get retrieves an [Export] database record belonging to the specified user.
+SELECT * FROM "Export" WHERE (id) = sqlc.arg(id) AND ("user") = sqlc.arg(user_id);
+
+-- name: Active :many
+-- Active retrieves the user's pending or running [Export] database record(s), most recent first.
+SELECT * FROM "Export" WHERE ("user") = sqlc.arg(user_id) AND (status) IN ('PENDING', 'RUNNING') ORDER BY creation DESC;
+
+-- name: Claim :many
+-- Claim leases up to size abandoned [Export](s) -- pending or running, without progress for the lease duration -- by
+-- refreshing each modification, counting the attempt. Progress refreshes the modification, such that a live job's lease
+-- is renewed with each step. Concurrent claimants skip locked row(s).
+UPDATE "Export"
+SET attempts     = attempts + 1,
+    modification = now()
+WHERE (id) IN (SELECT e.id
+               FROM "Export" e
+               WHERE (e.status) IN ('PENDING', 'RUNNING')
+                 AND coalesce(e.modification, e.creation) <= now() - make_interval(secs => sqlc.arg(lease)::float8)
+               ORDER BY e.id
+               LIMIT sqlc.arg(size)::int FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: Progress :exec
+-- Progress marks an [Export] as running and updates its completion percentage.
+UPDATE "Export" SET status = 'RUNNING', progress = sqlc.arg(progress), modification = now() WHERE (id) = sqlc.arg(id);
+
+-- name: Complete :exec
+-- Complete marks an [Export] as complete, recording its archive's object-storage key and signature.
+UPDATE "Export"
+SET status = 'COMPLETE', progress = 100, key = sqlc.arg(key), signature = sqlc.arg(signature), modification = now(), completion = now()
+WHERE (id) = sqlc.arg(id);
+
+-- name: Fail :exec
+-- Fail marks an [Export] as failed, recording the failure's reason.
+UPDATE "Export" SET status = 'FAILED', error = sqlc.arg(error), modification = now(), completion = now() WHERE (id) = sqlc.arg(id);
+
+-- name: Purge :many
+-- Purge removes all of the user's [Export] database record(s), returning each removed archive's object-storage key. Purge
+-- is only used to erase a hard-deleted user.
+DELETE FROM "Export" WHERE ("user") = sqlc.arg(user_id) RETURNING key;
@@ -0,0 +1,230 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package exports
+
+import (
+	"context"
+)
+
+const active = `-- name: Active :many
+SELECT id, "user", status, progress, key, signature, error, attempts, creation, modification, completion FROM "Export" WHERE ("user") = $1 AND (status) IN ('PENDING', 'RUNNING') ORDER BY creation DESC
+`
+
+// Active retrieves the user's pending or running [Export] database record(s), most recent first.
+func (q *Queries) Active(ctx context.Context, db DBTX, userID int64) ([]Export, error) {
+	rows, err := db.Query(ctx, active, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Export{}
+	for rows.Next() {
+		var i Export
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.Status,
+			&i.Progress,
+			&i.Key,
+			&i.Signature,
+			&i.Error,
+			&i.Attempts,
+			&i.Creation,
+			&i.Modification,
+			&i.Completion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claim = `-- name: Claim :many
+UPDATE "Export"
+SET attempts     = attempts + 1,
+    modification = now()
+WHERE (id) IN (SELECT e.id
+               FROM "Export" e
+               WHERE (e.status) IN ('PENDING', 'RUNNING')
+                 AND coalesce(e.modification, e.creation) <= now() - make_interval(secs => $1::float8)
+               ORDER BY e.id
+               LIMIT $2::int FOR UPDATE SKIP LOCKED)
+RETURNING id, "user", status, progress, key, signature, error, attempts, creation, modification, completion
+`
+
+type ClaimParams struct {
+	Lease float64 `db:"lease" json:"lease"`
+	Size  int32   `db:"size" json:"size"`
+}
+
+// Claim leases up to size abandoned [Export](s) -- pending or running, without progress for the lease duration -- by
+// refreshing each modification, counting the attempt. Progress refreshes the modification, such that a live job's lease
+// is renewed with each step. Concurrent claimants skip locked row(s).
+func (q *Queries) Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Export, error) {
+	rows, err := db.Query(ctx, claim, arg.Lease, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Export{}
+	for rows.Next() {
+		var i Export
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.Status,
+			&i.Progress,
+			&i.Key,
+			&i.Signature,
+			&i.Error,
+			&i.Attempts,
+			&i.Creation,
+			&i.Modification,
+			&i.Completion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const complete = `-- name: Complete :exec
+UPDATE "Export"
+SET status = 'COMPLETE', progress = 100, key = $1, signature = $2, modification = now(), completion = now()
+WHERE (id) = $3
+`
+
+type CompleteParams struct {
+	Key       *string `db:"key" json:"key"`
+	Signature *string `db:"signature" json:"signature"`
+	ID        int64   `db:"id" json:"id"`
+}
+
+// Complete marks an [Export] as complete, recording its archive's object-storage key and signature.
+func (q *Queries) Complete(ctx context.Context, db DBTX, arg *CompleteParams) error {
+	_, err := db.Exec(ctx, complete, arg.Key, arg.Signature, arg.ID)
+	return err
+}
+
+const create = `-- name: Create :one
+INSERT INTO "Export" ("user", modification) VALUES ($1, now())
+ON CONFLICT ("user") WHERE status IN ('PENDING', 'RUNNING') DO NOTHING
+RETURNING id, "user", status, progress, key, signature, error, attempts, creation, modification, completion
+`
+
+// Create establishes a new, pending [Export] database record. A user is limited to a single pending or running export;
+// should one already exist, no record is created and no row is returned.
+func (q *Queries) Create(ctx context.Context, db DBTX, userID int64) (Export, error) {
+	row := db.QueryRow(ctx, create, userID)
+	var i Export
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Status,
+		&i.Progress,
+		&i.Key,
+		&i.Signature,
+		&i.Error,
+		&i.Attempts,
+		&i.Creation,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const fail = `-- name: Fail :exec
+UPDATE "Export" SET status = 'FAILED', error = $1, modification = now(), completion = now() WHERE (id) = $2
+`
+
+type FailParams struct {
+	Error *string `db:"error" json:"error"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Fail marks an [Export] as failed, recording the failure's reason.
+func (q *Queries) Fail(ctx context.Context, db DBTX, arg *FailParams) error {
+	_, err := db.Exec(ctx, fail, arg.Error, arg.ID)
+	return err
+}
+
+const get = `-- name: Get :one
+SELECT id, "user", status, progress, key, signature, error, attempts, creation, modification, completion FROM "Export" WHERE (id) = $1 AND ("user") = $2
+`
+
+type GetParams struct {
+	ID     int64 `db:"id" json:"id"`
+	UserID int64 `db:"user_id" json:"user_id"`
+}
+
+// Get retrieves an [Export] database record belonging to the specified user.
+func (q *Queries) Get(ctx context.Context, db DBTX, arg *GetParams) (Export, error) {
+	row := db.QueryRow(ctx, get, arg.ID, arg.UserID)
+	var i Export
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Status,
+		&i.Progress,
+		&i.Key,
+		&i.Signature,
+		&i.Error,
+		&i.Attempts,
+		&i.Creation,
+		&i.Modification,
+		&i.Completion,
+	)
+	return i, err
+}
+
+const progress = `-- name: Progress :exec
+UPDATE "Export" SET status = 'RUNNING', progress = $1, modification = now() WHERE (id) = $2
+`
+
+type ProgressParams struct {
+	Progress int16 `db:"progress" json:"progress"`
+	ID       int64 `db:"id" json:"id"`
+}
+
+// Progress marks an [Export] as running and updates its completion percentage.
+func (q *Queries) Progress(ctx context.Context, db DBTX, arg *ProgressParams) error {
+	_, err := db.Exec(ctx, progress, arg.Progress, arg.ID)
+	return err
+}
+
+const purge = `-- name: Purge :many
+DELETE FROM "Export" WHERE ("user") = $1 RETURNING key
+`
+
+// Purge removes all of the user's [Export] database record(s), returning each removed archive's object-storage key. Purge
+// is only used to erase a hard-deleted user.
+func (q *Queries) Purge(ctx context.Context, db DBTX, userID int64) ([]*string, error) {
+	rows, err := db.Query(ctx, purge, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*string{}
+	for rows.Next() {
+		var key *string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,37 @@
+--
+-- Export
+--
+
+CREATE TABLE "Export"
+(
+    "id"           bigserial
+        CONSTRAINT "export-id-primary-key" primary key,
+
+    "user"         bigint                                   not null,
+
+    "status"       varchar(16)              default 'PENDING' not null
+        CONSTRAINT "export-status-constraint" CHECK ("Export"."status" IN ('PENDING', 'RUNNING', 'COMPLETE', 'FAILED')),
+
+    "progress"     smallint                 default 0         not null
+        CONSTRAINT "export-progress-constraint" CHECK ("Export"."progress" BETWEEN 0 AND 100),
+
+    "key"          text                     default NULL,
+    "signature"    text                     default NULL,
+    "error"        text                     default NULL,
+    "attempts"     smallint                 default 1         not null,
+
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone,
+    "completion"   timestamp with time zone
+);
+
+COMMENT ON COLUMN "Export"."user" IS 'User represents the "User" record''s identifier that requested the data-subject access export.';
+COMMENT ON COLUMN "Export"."key" IS 'Key represents the object-storage key of the completed export archive.';
+COMMENT ON COLUMN "Export"."attempts" IS 'Attempts represents the number of time(s) the export job was started, including reclaim(s) of an abandoned job.';
+COMMENT ON COLUMN "Export"."modification" IS 'Modification represents the export job''s most recent progress; an in-flight export whose modification lapses beyond the lease is reclaimed.';
+COMMENT ON COLUMN "Export"."signature" IS 'Signature represents the base64-encoded HMAC-SHA256 signature of the completed export archive.';
+
+CREATE INDEX IF NOT EXISTS "export-user-index" on "Export" ("user");
+CREATE INDEX IF NOT EXISTS "export-status-index" on "Export" (status);
+CREATE INDEX IF NOT EXISTS "export-in-flight-index" on "Export" (coalesce(modification, creation)) WHERE status IN ('PENDING', 'RUNNING');
+CREATE UNIQUE INDEX IF NOT EXISTS "export-active-unique-index" on "Export" ("user") WHERE status IN ('PENDING', 'RUNNING');
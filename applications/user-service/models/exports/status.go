@@ -0,0 +1,9 @@
+package exports
+
+// [Export.Status] value(s), as constrained by the "export-status-constraint" check.
+const (
+	Pending  = "PENDING"  // Pending represents an export that has been requested but not yet started.
+	Running  = "RUNNING"  // Running represents an export that is actively collecting record(s).
+	Complete = "COMPLETE" // Complete represents an export whose signed archive is available for download.
+	Failed   = "FAILED"   // Failed represents an export that could not be completed; see [Export.Error].
+)
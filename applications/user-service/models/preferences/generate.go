@@ -0,0 +1,3 @@
+package preferences
+
+//go:generate sqlc generate
@@ -0,0 +1,20 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package preferences
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Preference struct {
+	// User represents the "User" record's identifier the preferences belong to.
+	User int64 `db:"user" json:"user"`
+	// Document represents the user's preferences, validated against the embedded JSON Schema of the associated version.
+	Document []byte `db:"document" json:"document"`
+	// Version represents the JSON Schema version the document was last validated against.
+	Version      int32              `db:"version" json:"version"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+}
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package preferences
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Get retrieves a user's [Preference] database record.
+	Get(ctx context.Context, db DBTX, userID int64) (Preference, error)
+	// Lock retrieves a user's [Preference] database record and acquires a row-level lock for the remainder of the transaction.
+	Lock(ctx context.Context, db DBTX, userID int64) (Preference, error)
+	// Purge removes the user's [Preference] database record. Purge is only used to erase a hard-deleted user.
+	Purge(ctx context.Context, db DBTX, userID int64) error
+	// Upsert creates or replaces a user's [Preference] document and its associated schema version.
+	Upsert(ctx context.Context, db DBTX, arg *UpsertParams) (Preference, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,19 @@
+-- name: Get :one
+-- Get retrieves a user's [Preference] database record.
+SELECT * FROM "Preference" WHERE ("user") = sqlc.arg(user_id);
+
+-- name: Lock :one
+-- Lock retrieves a user's [Preference] database record and acquires a row-level lock for the remainder of the transaction.
+SELECT * FROM "Preference" WHERE ("user") = sqlc.arg(user_id) FOR UPDATE;
+
+-- name: Upsert :one
+-- Upsert creates or replaces a user's [Preference] document and its associated schema version.
+INSERT INTO "Preference" ("user", document, version)
+VALUES (sqlc.arg(user_id), sqlc.arg(document), sqlc.arg(version))
+ON CONFLICT ("user") DO UPDATE SET document = excluded.document, version = excluded.version, modification = now()
+RETURNING *;
+
+
+-- name: Purge :exec
+-- Purge removes the user's [Preference] database record. Purge is only used to erase a hard-deleted user.
+DELETE FROM "Preference" WHERE ("user") = sqlc.arg(user_id);
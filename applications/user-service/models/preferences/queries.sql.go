@@ -0,0 +1,83 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package preferences
+
+import (
+	"context"
+)
+
+const get = `-- name: Get :one
+SELECT "user", document, version, creation, modification FROM "Preference" WHERE ("user") = $1
+`
+
+// Get retrieves a user's [Preference] database record.
+func (q *Queries) Get(ctx context.Context, db DBTX, userID int64) (Preference, error) {
+	row := db.QueryRow(ctx, get, userID)
+	var i Preference
+	err := row.Scan(
+		&i.User,
+		&i.Document,
+		&i.Version,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const lock = `-- name: Lock :one
+SELECT "user", document, version, creation, modification FROM "Preference" WHERE ("user") = $1 FOR UPDATE
+`
+
+// Lock retrieves a user's [Preference] database record and acquires a row-level lock for the remainder of the transaction.
+func (q *Queries) Lock(ctx context.Context, db DBTX, userID int64) (Preference, error) {
+	row := db.QueryRow(ctx, lock, userID)
+	var i Preference
+	err := row.Scan(
+		&i.User,
+		&i.Document,
+		&i.Version,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const purge = `-- name: Purge :exec
+DELETE FROM "Preference" WHERE ("user") = $1
+`
+
+// Purge removes the user's [Preference] database record. Purge is only used to erase a hard-deleted user.
+func (q *Queries) Purge(ctx context.Context, db DBTX, userID int64) error {
+	_, err := db.Exec(ctx, purge, userID)
+	return err
+}
+
+const upsert = `-- name: Upsert :one
+INSERT INTO "Preference" ("user", document, version)
+VALUES ($1, $2, $3)
+ON CONFLICT ("user") DO UPDATE SET document = excluded.document, version = excluded.version, modification = now()
+RETURNING "user", document, version, creation, modification
+`
+
+type UpsertParams struct {
+	UserID   int64  `db:"user_id" json:"user_id"`
+	Document []byte `db:"document" json:"document"`
+	Version  int32  `db:"version" json:"version"`
+}
+
+// Upsert creates or replaces a user's [Preference] document and its associated schema version.
+func (q *Queries) Upsert(ctx context.Context, db DBTX, arg *UpsertParams) (Preference, error) {
+	row := db.QueryRow(ctx, upsert, arg.UserID, arg.Document, arg.Version)
+	var i Preference
+	err := row.Scan(
+		&i.User,
+		&i.Document,
+		&i.Version,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
@@ -0,0 +1,24 @@
+--
+-- Preference
+--
+
+CREATE TABLE "Preference"
+(
+    "user"         bigint
+        CONSTRAINT "preference-user-primary-key" primary key,
+
+    "document"     jsonb                    default '{}'::jsonb not null
+        CONSTRAINT "preference-document-object-constraint" CHECK (jsonb_typeof("Preference"."document") = 'object'),
+
+    "version"      integer                                      not null
+        CONSTRAINT "preference-version-constraint" CHECK ("Preference"."version" > 0),
+
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone
+);
+
+COMMENT ON COLUMN "Preference"."user" IS 'User represents the "User" record''s identifier the preferences belong to.';
+COMMENT ON COLUMN "Preference"."document" IS 'Document represents the user''s preferences, validated against the embedded JSON Schema of the associated version.';
+COMMENT ON COLUMN "Preference"."version" IS 'Version represents the JSON Schema version the document was last validated against.';
+
+CREATE INDEX IF NOT EXISTS "preference-version-index" on "Preference" (version);
@@ -0,0 +1,23 @@
+package users
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ETag returns a strong entity-tag representing the current state of the [User] record. Any change to a record's
+// attribute(s) -- including the [User.Modification] timestamp -- results in a different value.
+//
+//   - See RFC 9110, Section 8.8.3 for entity-tag semantics.
+func (u User) ETag() string {
+	content, e := json.Marshal(u)
+	if e != nil { // --> only possible if the structure changes to include unsupported types
+		content = []byte(fmt.Sprintf("%d-%v-%v", u.ID, u.Creation.Time.UnixNano(), u.Modification.Time.UnixNano()))
+	}
+
+	sum := sha256.Sum256(content)
+
+	return fmt.Sprintf("%q", hex.EncodeToString(sum[:16]))
+}
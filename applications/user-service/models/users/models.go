@@ -9,13 +9,26 @@ import (
 )
 
 type User struct {
-	ID           int64              `db:"id" json:"id"`
-	Name         *string            `db:"name" json:"name"`
-	DisplayName  *string            `db:"display-name" json:"display-name"`
-	Email        string             `db:"email" json:"email"`
-	Avatar       *string            `db:"avatar" json:"avatar"`
+	ID          int64   `db:"id" json:"id"`
+	Name        *string `db:"name" json:"name"`
+	DisplayName *string `db:"display-name" json:"display-name"`
+	// Username represents the user's optional, unique login and profile handle. Uniqueness is case-insensitive; the chosen casing is retained for display.
+	Username *string `db:"username" json:"username"`
+	Email    string  `db:"email" json:"email"`
+	Avatar   *string `db:"avatar" json:"avatar"`
+	// Marketing is derived from the user's latest "marketing" consent ledger entry; absent any entry, consent is not assumed.
 	Marketing    bool               `db:"marketing" json:"marketing"`
 	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
 	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
 	Deletion     pgtype.Timestamptz `db:"deletion" json:"deletion"`
 }
+
+// Username-History represents a user's previous username(s). Until its expiration, a previous username redirects to the user's current username and can't be claimed by another user.
+type UsernameHistory struct {
+	ID   int64 `db:"id" json:"id"`
+	User int64 `db:"user" json:"user"`
+	// Username represents the previous username's normalized (lower-case) form.
+	Username   string             `db:"username" json:"username"`
+	Retirement pgtype.Timestamptz `db:"retirement" json:"retirement"`
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+}
@@ -13,6 +13,8 @@ type Querier interface {
 	All(ctx context.Context, db DBTX) (int64, error)
 	// Attributes will use the user's [User.ID] to hydrate all available [User] attribute(s). Note that the following call is more taxing on the database.
 	Attributes(ctx context.Context, db DBTX, id int64) (User, error)
+	// Claimed reports whether the username is unavailable to the specified user: either another [User] holds it (regardless of soft delete), or it's another user's previous username within its cooldown. Pass a zero id to check on behalf of an anonymous user.
+	Claimed(ctx context.Context, db DBTX, arg *ClaimedParams) (bool, error)
 	// Clean performs a hard delete on the [User] database record, regardless if a soft delete has been performed, and only by email. This function should only be used in test(s).
 	Clean(ctx context.Context, db DBTX, email string) error
 	// Count returns 0 or 1 depending on if a [User] record matching the provided email exists.
@@ -35,10 +37,26 @@ type Querier interface {
 	GetUserEmailAddressByIDForce(ctx context.Context, db DBTX, id int64) (GetUserEmailAddressByIDForceRow, error)
 	// List returns all active User record(s).
 	List(ctx context.Context, db DBTX) ([]User, error)
+	// Lock retrieves an active [User] database record and acquires a row-level lock for the remainder of the transaction.
+	Lock(ctx context.Context, db DBTX, id int64) (User, error)
 	// Me will return a [User] and all associated attribute(s) when provided the User's email address.
 	Me(ctx context.Context, db DBTX, email string) (User, error)
+	// Reclaim removes the [User]'s own history entries of the username, as well as all expired history entries.
+	Reclaim(ctx context.Context, db DBTX, arg *ReclaimParams) error
+	// Redirect retrieves the active [User] that most recently held the username, if the username's cooldown hasn't expired.
+	Redirect(ctx context.Context, db DBTX, username string) (RedirectRow, error)
+	// Rename assigns the [User]'s username.
+	Rename(ctx context.Context, db DBTX, arg *RenameParams) (User, error)
+	// Resolve retrieves the active [User] currently holding the username.
+	Resolve(ctx context.Context, db DBTX, username string) (ResolveRow, error)
+	// Restore reverts a soft delete on the [User] database record. Restore is used to compensate a failed deletion saga.
+	Restore(ctx context.Context, db DBTX, id int64) error
+	// Retire records the [User]'s previous username, redirecting to the user's current username until the expiration.
+	Retire(ctx context.Context, db DBTX, arg *RetireParams) error
 	// Total returns the total number of [User] records, excluding deleted record(s).
 	Total(ctx context.Context, db DBTX) (int64, error)
+	// UpdateProfile will update a provided [User]'s profile attribute(s). Only attribute(s) whose associated flag is true are written; all others remain unchanged. The marketing attribute is derived from the consent ledger and isn't writable here.
+	UpdateProfile(ctx context.Context, db DBTX, arg *UpdateProfileParams) (User, error)
 	// UpdateUserAvatar will update a provided [User] with their specified avatar.
 	UpdateUserAvatar(ctx context.Context, db DBTX, arg *UpdateUserAvatarParams) error
 	// Users returns all User record(s).
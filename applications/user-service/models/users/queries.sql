@@ -22,6 +22,19 @@ SELECT EXISTS (SELECT 1 FROM "User" WHERE (id) = sqlc.arg(id) AND (deletion) IS
 -- UpdateUserAvatar will update a provided [User] with their specified avatar.
 UPDATE "User" SET avatar = sqlc.arg(avatar)::text, modification = now() WHERE (id) = sqlc.arg(id) AND (deletion) IS NULL;
 
+-- name: Lock :one
+-- Lock retrieves an active [User] database record and acquires a row-level lock for the remainder of the transaction.
+SELECT * FROM "User" WHERE (id) = sqlc.arg(id) AND (deletion) IS NULL FOR UPDATE;
+
+-- name: UpdateProfile :one
+-- UpdateProfile will update a provided [User]'s profile attribute(s). Only attribute(s) whose associated flag is true are written; all others remain unchanged. The marketing attribute is derived from the consent ledger and isn't writable here.
+UPDATE "User"
+SET name           = CASE WHEN sqlc.arg(set_name)::bool THEN sqlc.narg(name)::varchar ELSE name END,
+    "display-name" = CASE WHEN sqlc.arg(set_display_name)::bool THEN sqlc.narg(display_name)::text ELSE "display-name" END,
+    modification   = now()
+WHERE (id) = sqlc.arg(id) AND (deletion) IS NULL
+RETURNING *;
+
 -- name: Me :one
 -- Me will return a [User] and all associated attribute(s) when provided the User's email address.
 SELECT * FROM "User" WHERE email = $1;
@@ -68,4 +81,41 @@ UPDATE "User" SET (modification, deletion) = (now(), now()) WHERE (id) = (sqlc.a
 
 -- name: Extract :one
 -- Extract retrieves a given [User] database record, regardless of its deletion status.
-SELECT * FROM "User" WHERE (id, email) = (sqlc.arg(id), sqlc.arg(email));
+SELECT * FROM "User" WHERE (id) = sqlc.arg(id) AND (email) = sqlc.arg(email)::text;
+
+-- name: Restore :exec
+-- Restore reverts a soft delete on the [User] database record. Restore is used to compensate a failed deletion saga.
+UPDATE "User" SET modification = now(), deletion = NULL WHERE (id) = sqlc.arg(id) AND (deletion) IS NOT NULL;
+
+-- name: Claimed :one
+-- Claimed reports whether the username is unavailable to the specified user: either another [User] holds it (regardless of soft delete), or it's another user's previous username within its cooldown. Pass a zero id to check on behalf of an anonymous user.
+SELECT (EXISTS (SELECT 1 FROM "User" WHERE lower(username) = lower(sqlc.arg(username)::text) AND (id) <> sqlc.arg(id)::bigint)
+    OR EXISTS (SELECT 1 FROM "Username-History" h WHERE (h.username) = lower(sqlc.arg(username)::text) AND (h.expiration) > now() AND (h."user") <> sqlc.arg(id)::bigint))::bool AS claimed;
+
+-- name: Rename :one
+-- Rename assigns the [User]'s username.
+UPDATE "User" SET username = sqlc.arg(username)::varchar, modification = now() WHERE (id) = sqlc.arg(id) AND (deletion) IS NULL RETURNING *;
+
+-- name: Retire :exec
+-- Retire records the [User]'s previous username, redirecting to the user's current username until the expiration.
+INSERT INTO "Username-History" ("user", username, expiration) VALUES (sqlc.arg(id), lower(sqlc.arg(username)::text), now() + make_interval(secs => sqlc.arg(cooldown)::float8));
+
+-- name: Reclaim :exec
+-- Reclaim removes the [User]'s own history entries of the username, as well as all expired history entries.
+DELETE FROM "Username-History" WHERE ((("user") = sqlc.arg(id) AND (username) = lower(sqlc.arg(username)::text)) OR (expiration) <= now());
+
+-- name: Resolve :one
+-- Resolve retrieves the active [User] currently holding the username.
+SELECT "id", "username" FROM "User" WHERE lower(username) = lower(sqlc.arg(username)::text) AND (deletion) IS NULL;
+
+-- name: Redirect :one
+-- Redirect retrieves the active [User] that most recently held the username, if the username's cooldown hasn't expired.
+SELECT u."id", u."username", h."expiration"
+FROM "Username-History" h
+    INNER JOIN "User" u ON (u.id) = (h."user")
+WHERE (h.username) = lower(sqlc.arg(username)::text)
+  AND (h.expiration) > now()
+  AND (u.deletion) IS NULL
+  AND (u.username) IS NOT NULL
+ORDER BY h.retirement DESC
+LIMIT 1;
@@ -7,6 +7,8 @@ package users
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const all = `-- name: All :one
@@ -22,7 +24,7 @@ func (q *Queries) All(ctx context.Context, db DBTX) (int64, error) {
 }
 
 const attributes = `-- name: Attributes :one
-SELECT id, name, "display-name", email, avatar, marketing, creation, modification, deletion
+SELECT id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion
 FROM "User"
 WHERE (id) = $1::bigserial
   AND (deletion) IS NULL
@@ -37,6 +39,7 @@ func (q *Queries) Attributes(ctx context.Context, db DBTX, id int64) (User, erro
 		&i.ID,
 		&i.Name,
 		&i.DisplayName,
+		&i.Username,
 		&i.Email,
 		&i.Avatar,
 		&i.Marketing,
@@ -47,6 +50,24 @@ func (q *Queries) Attributes(ctx context.Context, db DBTX, id int64) (User, erro
 	return i, err
 }
 
+const claimed = `-- name: Claimed :one
+SELECT (EXISTS (SELECT 1 FROM "User" WHERE lower(username) = lower($1::text) AND (id) <> $2::bigint)
+    OR EXISTS (SELECT 1 FROM "Username-History" h WHERE (h.username) = lower($1::text) AND (h.expiration) > now() AND (h."user") <> $2::bigint))::bool AS claimed
+`
+
+type ClaimedParams struct {
+	Username string `db:"username" json:"username"`
+	ID       int64  `db:"id" json:"id"`
+}
+
+// Claimed reports whether the username is unavailable to the specified user: either another [User] holds it (regardless of soft delete), or it's another user's previous username within its cooldown. Pass a zero id to check on behalf of an anonymous user.
+func (q *Queries) Claimed(ctx context.Context, db DBTX, arg *ClaimedParams) (bool, error) {
+	row := db.QueryRow(ctx, claimed, arg.Username, arg.ID)
+	var claimed bool
+	err := row.Scan(&claimed)
+	return claimed, err
+}
+
 const clean = `-- name: Clean :exec
 DELETE FROM "User" WHERE (email) = $1
 `
@@ -70,7 +91,7 @@ func (q *Queries) Count(ctx context.Context, db DBTX, email string) (int64, erro
 }
 
 const create = `-- name: Create :one
-INSERT INTO "User" (email) VALUES ($1) RETURNING id, name, "display-name", email, avatar, marketing, creation, modification, deletion
+INSERT INTO "User" (email) VALUES ($1) RETURNING id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion
 `
 
 // Create will create a new [User] record.
@@ -81,6 +102,7 @@ func (q *Queries) Create(ctx context.Context, db DBTX, email string) (User, erro
 		&i.ID,
 		&i.Name,
 		&i.DisplayName,
+		&i.Username,
 		&i.Email,
 		&i.Avatar,
 		&i.Marketing,
@@ -136,12 +158,12 @@ func (q *Queries) ExistsForce(ctx context.Context, db DBTX, id int64) (bool, err
 }
 
 const extract = `-- name: Extract :one
-SELECT id, name, "display-name", email, avatar, marketing, creation, modification, deletion FROM "User" WHERE (id, email) = ($1, $2)
+SELECT id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion FROM "User" WHERE (id) = $1 AND (email) = $2::text
 `
 
 type ExtractParams struct {
-	ID    int64 `db:"id" json:"id"`
-	Email int64 `db:"email" json:"email"`
+	ID    int64  `db:"id" json:"id"`
+	Email string `db:"email" json:"email"`
 }
 
 // Extract retrieves a given [User] database record, regardless of its deletion status.
@@ -152,6 +174,7 @@ func (q *Queries) Extract(ctx context.Context, db DBTX, arg *ExtractParams) (Use
 		&i.ID,
 		&i.Name,
 		&i.DisplayName,
+		&i.Username,
 		&i.Email,
 		&i.Avatar,
 		&i.Marketing,
@@ -197,7 +220,7 @@ func (q *Queries) GetUserEmailAddressByIDForce(ctx context.Context, db DBTX, id
 }
 
 const list = `-- name: List :many
-SELECT id, name, "display-name", email, avatar, marketing, creation, modification, deletion FROM "User" WHERE (deletion) IS NULL
+SELECT id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion FROM "User" WHERE (deletion) IS NULL
 `
 
 // List returns all active User record(s).
@@ -214,6 +237,7 @@ func (q *Queries) List(ctx context.Context, db DBTX) ([]User, error) {
 			&i.ID,
 			&i.Name,
 			&i.DisplayName,
+			&i.Username,
 			&i.Email,
 			&i.Avatar,
 			&i.Marketing,
@@ -231,8 +255,31 @@ func (q *Queries) List(ctx context.Context, db DBTX) ([]User, error) {
 	return items, nil
 }
 
+const lock = `-- name: Lock :one
+SELECT id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion FROM "User" WHERE (id) = $1 AND (deletion) IS NULL FOR UPDATE
+`
+
+// Lock retrieves an active [User] database record and acquires a row-level lock for the remainder of the transaction.
+func (q *Queries) Lock(ctx context.Context, db DBTX, id int64) (User, error) {
+	row := db.QueryRow(ctx, lock, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.DisplayName,
+		&i.Username,
+		&i.Email,
+		&i.Avatar,
+		&i.Marketing,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
 const me = `-- name: Me :one
-SELECT id, name, "display-name", email, avatar, marketing, creation, modification, deletion FROM "User" WHERE email = $1
+SELECT id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion FROM "User" WHERE email = $1
 `
 
 // Me will return a [User] and all associated attribute(s) when provided the User's email address.
@@ -243,6 +290,7 @@ func (q *Queries) Me(ctx context.Context, db DBTX, email string) (User, error) {
 		&i.ID,
 		&i.Name,
 		&i.DisplayName,
+		&i.Username,
 		&i.Email,
 		&i.Avatar,
 		&i.Marketing,
@@ -253,6 +301,118 @@ func (q *Queries) Me(ctx context.Context, db DBTX, email string) (User, error) {
 	return i, err
 }
 
+const reclaim = `-- name: Reclaim :exec
+DELETE FROM "Username-History" WHERE ((("user") = $1 AND (username) = lower($2::text)) OR (expiration) <= now())
+`
+
+type ReclaimParams struct {
+	ID       int64  `db:"id" json:"id"`
+	Username string `db:"username" json:"username"`
+}
+
+// Reclaim removes the [User]'s own history entries of the username, as well as all expired history entries.
+func (q *Queries) Reclaim(ctx context.Context, db DBTX, arg *ReclaimParams) error {
+	_, err := db.Exec(ctx, reclaim, arg.ID, arg.Username)
+	return err
+}
+
+const redirect = `-- name: Redirect :one
+SELECT u."id", u."username", h."expiration"
+FROM "Username-History" h
+    INNER JOIN "User" u ON (u.id) = (h."user")
+WHERE (h.username) = lower($1::text)
+  AND (h.expiration) > now()
+  AND (u.deletion) IS NULL
+  AND (u.username) IS NOT NULL
+ORDER BY h.retirement DESC
+LIMIT 1
+`
+
+type RedirectRow struct {
+	ID         int64              `db:"id" json:"id"`
+	Username   *string            `db:"username" json:"username"`
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+}
+
+// Redirect retrieves the active [User] that most recently held the username, if the username's cooldown hasn't expired.
+func (q *Queries) Redirect(ctx context.Context, db DBTX, username string) (RedirectRow, error) {
+	row := db.QueryRow(ctx, redirect, username)
+	var i RedirectRow
+	err := row.Scan(&i.ID, &i.Username, &i.Expiration)
+	return i, err
+}
+
+const rename = `-- name: Rename :one
+UPDATE "User" SET username = $1::varchar, modification = now() WHERE (id) = $2 AND (deletion) IS NULL RETURNING id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion
+`
+
+type RenameParams struct {
+	Username string `db:"username" json:"username"`
+	ID       int64  `db:"id" json:"id"`
+}
+
+// Rename assigns the [User]'s username.
+func (q *Queries) Rename(ctx context.Context, db DBTX, arg *RenameParams) (User, error) {
+	row := db.QueryRow(ctx, rename, arg.Username, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.DisplayName,
+		&i.Username,
+		&i.Email,
+		&i.Avatar,
+		&i.Marketing,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const resolve = `-- name: Resolve :one
+SELECT "id", "username" FROM "User" WHERE lower(username) = lower($1::text) AND (deletion) IS NULL
+`
+
+type ResolveRow struct {
+	ID       int64   `db:"id" json:"id"`
+	Username *string `db:"username" json:"username"`
+}
+
+// Resolve retrieves the active [User] currently holding the username.
+func (q *Queries) Resolve(ctx context.Context, db DBTX, username string) (ResolveRow, error) {
+	row := db.QueryRow(ctx, resolve, username)
+	var i ResolveRow
+	err := row.Scan(&i.ID, &i.Username)
+	return i, err
+}
+
+const restore = `-- name: Restore :exec
+UPDATE "User" SET modification = now(), deletion = NULL WHERE (id) = $1 AND (deletion) IS NOT NULL
+`
+
+// Restore reverts a soft delete on the [User] database record. Restore is used to compensate a failed deletion saga.
+func (q *Queries) Restore(ctx context.Context, db DBTX, id int64) error {
+	_, err := db.Exec(ctx, restore, id)
+	return err
+}
+
+const retire = `-- name: Retire :exec
+INSERT INTO "Username-History" ("user", username, expiration) VALUES ($1, lower($2::text), now() + make_interval(secs => $3::float8))
+`
+
+type RetireParams struct {
+	ID       int64   `db:"id" json:"id"`
+	Username string  `db:"username" json:"username"`
+	Cooldown float64 `db:"cooldown" json:"cooldown"`
+}
+
+// Retire records the [User]'s previous username, redirecting to the user's current username until the expiration.
+func (q *Queries) Retire(ctx context.Context, db DBTX, arg *RetireParams) error {
+	_, err := db.Exec(ctx, retire, arg.ID, arg.Username, arg.Cooldown)
+	return err
+}
+
 const total = `-- name: Total :one
 SELECT count(*) FROM "User" WHERE (deletion) IS NULL
 `
@@ -265,6 +425,48 @@ func (q *Queries) Total(ctx context.Context, db DBTX) (int64, error) {
 	return count, err
 }
 
+const updateProfile = `-- name: UpdateProfile :one
+UPDATE "User"
+SET name           = CASE WHEN $1::bool THEN $2::varchar ELSE name END,
+    "display-name" = CASE WHEN $3::bool THEN $4::text ELSE "display-name" END,
+    modification   = now()
+WHERE (id) = $5 AND (deletion) IS NULL
+RETURNING id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion
+`
+
+type UpdateProfileParams struct {
+	SetName        bool    `db:"set_name" json:"set_name"`
+	Name           *string `db:"name" json:"name"`
+	SetDisplayName bool    `db:"set_display_name" json:"set_display_name"`
+	DisplayName    *string `db:"display_name" json:"display_name"`
+	ID             int64   `db:"id" json:"id"`
+}
+
+// UpdateProfile will update a provided [User]'s profile attribute(s). Only attribute(s) whose associated flag is true are written; all others remain unchanged. The marketing attribute is derived from the consent ledger and isn't writable here.
+func (q *Queries) UpdateProfile(ctx context.Context, db DBTX, arg *UpdateProfileParams) (User, error) {
+	row := db.QueryRow(ctx, updateProfile,
+		arg.SetName,
+		arg.Name,
+		arg.SetDisplayName,
+		arg.DisplayName,
+		arg.ID,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.DisplayName,
+		&i.Username,
+		&i.Email,
+		&i.Avatar,
+		&i.Marketing,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
 const updateUserAvatar = `-- name: UpdateUserAvatar :exec
 UPDATE "User" SET avatar = $1::text, modification = now() WHERE (id) = $2 AND (deletion) IS NULL
 `
@@ -281,7 +483,7 @@ func (q *Queries) UpdateUserAvatar(ctx context.Context, db DBTX, arg *UpdateUser
 }
 
 const users = `-- name: Users :many
-SELECT id, name, "display-name", email, avatar, marketing, creation, modification, deletion FROM "User"
+SELECT id, name, "display-name", username, email, avatar, marketing, creation, modification, deletion FROM "User"
 `
 
 // Users returns all User record(s).
@@ -298,6 +500,7 @@ func (q *Queries) Users(ctx context.Context, db DBTX) ([]User, error) {
 			&i.ID,
 			&i.Name,
 			&i.DisplayName,
+			&i.Username,
 			&i.Email,
 			&i.Avatar,
 			&i.Marketing,
@@ -10,18 +10,50 @@ CREATE TABLE "User"
     "name"         varchar(255)             default NULL::character varying,
     "display-name" text                                  null,
 
+    "username"     varchar(32)              default NULL,
+
     "email"        varchar(255)                          not null
         CONSTRAINT "user-email-validation-constraint" CHECK ("User"."email" ~* '^[A-Za-z0-9._+%-]+@[A-Za-z0-9.-]+[.][A-Za-z]+$')
         CONSTRAINT "user-email-unique-constraint" unique,
 
     "avatar"       text                     default NULL,
 
-    "marketing"    boolean                  default true not null,
+    "marketing"    boolean                  default false not null,
 
     "creation"     timestamp with time zone default now(),
     "modification" timestamp with time zone,
     "deletion"     timestamp with time zone
 );
 
+COMMENT ON COLUMN "User".marketing IS 'Marketing is derived from the user''s latest "marketing" consent ledger entry; absent any entry, consent is not assumed.';
+
 CREATE INDEX IF NOT EXISTS "user-deletion-index" on "User" (deletion);
 CREATE INDEX IF NOT EXISTS "user-email-index" on "User" (email);
+
+COMMENT ON COLUMN "User".username IS 'Username represents the user''s optional, unique login and profile handle. Uniqueness is case-insensitive; the chosen casing is retained for display.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS "user-username-unique-index" on "User" (lower(username));
+
+--
+-- Username-History
+--
+
+CREATE TABLE "Username-History"
+(
+    "id"         bigserial
+        CONSTRAINT "username-history-id-primary-key" primary key,
+
+    "user"       bigint                                 not null
+        CONSTRAINT "username-history-user-foreign-key" REFERENCES "User" (id) ON DELETE CASCADE,
+
+    "username"   varchar(32)                            not null,
+
+    "retirement" timestamp with time zone default now() not null,
+    "expiration" timestamp with time zone               not null
+);
+
+COMMENT ON TABLE "Username-History" IS 'Username-History represents a user''s previous username(s). Until its expiration, a previous username redirects to the user''s current username and can''t be claimed by another user.';
+COMMENT ON COLUMN "Username-History"."username" IS 'Username represents the previous username''s normalized (lower-case) form.';
+
+CREATE INDEX IF NOT EXISTS "username-history-username-index" on "Username-History" (username, expiration);
+CREATE INDEX IF NOT EXISTS "username-history-user-index" on "Username-History" ("user");
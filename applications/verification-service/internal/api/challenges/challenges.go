@@ -0,0 +1,424 @@
+package challenges
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/events"
+	"verification-service/internal/library/mail"
+	"verification-service/internal/library/middleware"
+	"verification-service/internal/library/server"
+
+	"verification-service/internal/challenge"
+	"verification-service/internal/database"
+	"verification-service/internal/outbox"
+	"verification-service/internal/policy"
+	"verification-service/internal/token"
+	"verification-service/models/verifications"
+)
+
+// grant represents the lifetime of the grant returned upon confirmation.
+const grant = 10 * time.Minute
+
+// Issued represents the issue handler's response body. The code itself is only ever delivered via the channel.
+type Issued struct {
+	ID          string    `json:"id"`          // ID represents the challenge's identifier, for use with POST /challenges/{id}/confirm.
+	Purpose     string    `json:"purpose"`     // Purpose represents the action the challenge authorizes.
+	Channel     string    `json:"channel"`     // Channel represents how the code was delivered.
+	Destination string    `json:"destination"` // Destination represents the masked address or phone number the code was delivered to.
+	Expiration  time.Time `json:"expiration"`  // Expiration represents when the code expires.
+}
+
+// Confirmed represents the confirm handler's response body.
+type Confirmed struct {
+	ID           string    `json:"id"`           // ID represents the challenge's identifier.
+	Purpose      string    `json:"purpose"`      // Purpose represents the action the challenge authorized.
+	Status       string    `json:"status"`       // Status represents the challenge's lifecycle state: always CONFIRMED.
+	Confirmation time.Time `json:"confirmation"` // Confirmation represents when the challenge was confirmed.
+	Grant        string    `json:"grant"`        // Grant represents a short-lived, signed token authorizing the purpose for the challenge's subject.
+	Expiration   time.Time `json:"expiration"`   // Expiration represents when the grant expires.
+}
+
+// Issue supersedes the user's pending challenge of the requested purpose, if any, and delivers a new code.
+var Issue = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "challenge-issue"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	subject, authenticated, e := session(r.WithContext(ctx))
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Session Credential", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// --> verify input
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	purpose := challenge.Purpose(input.Purpose)
+
+	configuration, e := challenge.Lookup(purpose)
+	if e != nil {
+		http.Error(w, "Unknown Challenge Purpose", http.StatusBadRequest)
+		return
+	}
+
+	if !(authenticated) {
+		if !(configuration.Anonymous) {
+			slog.WarnContext(ctx, "Challenge Purpose Requires a Session", slog.String("purpose", input.Purpose))
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		} else if input.Email == "" {
+			http.Error(w, "An Email Address is Required Without a Session", http.StatusBadRequest)
+			return
+		}
+
+		subject = input.Email
+	}
+
+	// --> render email(s) in the user's preferred locale (supplied by the frontend), else the request's Accept-Language
+	ctx = mail.WithLocale(ctx, mail.Resolve(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language")))
+
+	// --> an anonymous request's response mustn't reveal whether the account exists, nor whether a code was delivered
+	decoy := func() {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(Issued{ID: uuid.NewString(), Purpose: input.Purpose, Channel: configuration.Channel, Destination: challenge.Mask(configuration.Channel, subject), Expiration: time.Now().UTC().Add(configuration.Lifetime)})
+	}
+
+	// --> establish connection, transaction
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	// --> lock the user's verification record to serialize concurrent issuance against the cooldown & daily cap
+	if _, e := verifications.New().Lock(ctx, tx, subject); e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			if !(authenticated) {
+				slog.InfoContext(ctx, "Anonymous Challenge Requested for an Unknown Account", slog.String("purpose", input.Purpose))
+				decoy()
+				return
+			}
+
+			slog.WarnContext(ctx, "Verification Record Not Found", slog.String("email", subject))
+			http.Error(w, "Verification Record Not Found - Use POST /register", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	wait, deliveries, e := challenge.Throttled(ctx, tx, subject, purpose, time.Now().UTC())
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Evaluate Challenge Deliveries", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if wait > 0 {
+		slog.WarnContext(ctx, "Challenge Issuance Throttled", slog.String("purpose", input.Purpose), slog.Duration("wait", wait), slog.Int("deliveries", int(deliveries)))
+
+		if !(authenticated) {
+			decoy()
+			return
+		}
+
+		w.Header().Set("Retry-After", strconv.FormatInt(policy.Seconds(wait), 10))
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+		return
+	}
+
+	destination, e := challenge.Destination(ctx, tx, purpose, subject, input.Destination)
+	if e != nil {
+		switch {
+		case errors.Is(e, challenge.ErrDestination):
+			http.Error(w, "A New Email Address is Required for an Email Change", http.StatusBadRequest)
+		case errors.Is(e, challenge.ErrUnreachable):
+			http.Error(w, "A Verified Phone Number is Required - Use POST /phone", http.StatusUnprocessableEntity)
+		default:
+			slog.ErrorContext(ctx, "Unable to Resolve Challenge Destination", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	issued, code, e := challenge.Issue(ctx, tx, subject, purpose, destination)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Issue Challenge", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> an email is enqueued within the transaction, whereas an SMS is sent immediately; either way, the challenge
+	// only persists once delivery is assured
+	if e := challenge.Deliver(ctx, tx, issued, code); e != nil {
+		switch {
+		case errors.Is(e, mail.ErrSuppressed) && !(authenticated):
+			decoy()
+		case errors.Is(e, mail.ErrSuppressed): // --> the address hard-bounced or complained; see POST /sns
+			http.Error(w, "Email Address Suppressed Following a Bounce or Complaint", http.StatusUnprocessableEntity)
+		case errors.Is(e, challenge.ErrUndelivered):
+			slog.ErrorContext(ctx, "Unable to Send SMS", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		default:
+			slog.ErrorContext(ctx, "Unable to Deliver Challenge", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	outbox.Wake()
+
+	slog.InfoContext(ctx, "Successfully Issued Challenge", slog.String("id", issued.ID.String()), slog.String("purpose", issued.Purpose), slog.String("channel", issued.Channel))
+
+	status := http.StatusCreated
+	if !(authenticated) { // --> indistinguishable from the decoy
+		status = http.StatusAccepted
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Issued{ID: issued.ID.String(), Purpose: issued.Purpose, Channel: issued.Channel, Destination: challenge.Mask(issued.Channel, issued.Destination), Expiration: issued.Expiration.Time.UTC()})
+
+	return
+})
+
+// Confirm confirms a challenge's code, returning a grant authorizing its purpose.
+var Confirm = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "challenge-confirm"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	subject, authenticated, e := session(r.WithContext(ctx))
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Session Credential", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// --> verify input
+	var input Code
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	// --> establish connection, transaction
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	// --> lock the challenge to serialize concurrent attempt(s) against its attempt limit
+	locked, e := challenge.Lock(ctx, tx, r.PathValue("id"))
+	if e != nil && !(errors.Is(e, pgx.ErrNoRows)) {
+		slog.ErrorContext(ctx, "Unable to Lock Challenge", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> another user's challenge is indistinguishable from a missing one; an anonymous request receives the invalid
+	// code response, as a decoy's identifier is never found
+	found := e == nil && (!(authenticated) || locked.Subject == subject) && locked.Purpose != string(challenge.EmailVerification)
+	if !(found) {
+		if !(authenticated) {
+			slog.WarnContext(ctx, "Invalid Anonymous Challenge Confirmation")
+			http.Error(w, "Invalid Challenge Code", http.StatusConflict)
+			return
+		}
+
+		slog.WarnContext(ctx, "Challenge Not Found", slog.String("id", r.PathValue("id")))
+		http.Error(w, "Challenge Not Found", http.StatusNotFound)
+		return
+	}
+
+	configuration, e := challenge.Lookup(challenge.Purpose(locked.Purpose))
+	if e != nil {
+		slog.ErrorContext(ctx, "Unknown Challenge Purpose", slog.String("purpose", locked.Purpose))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !(authenticated) && !(configuration.Anonymous) {
+		slog.WarnContext(ctx, "Challenge Purpose Requires a Session", slog.String("purpose", locked.Purpose))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	confirmed, e := challenge.Confirm(ctx, tx, locked, input.Code)
+	if e != nil {
+		switch {
+		case errors.Is(e, challenge.ErrInvalid), errors.Is(e, challenge.ErrLocked), errors.Is(e, challenge.ErrExpired):
+			// --> the attempt, or the challenge's transition, must persist regardless of the response
+			if e := tx.Commit(ctx); e != nil {
+				const message = "Unable to Commit Transaction"
+
+				slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+				labeler.Add(attribute.Bool("error", true))
+				http.Error(w, message, http.StatusInternalServerError)
+				return
+			}
+		}
+
+		switch {
+		case errors.Is(e, challenge.ErrInvalid):
+			http.Error(w, "Invalid Challenge Code", http.StatusConflict)
+		case errors.Is(e, challenge.ErrLocked):
+			http.Error(w, "Too Many Invalid Attempts - Use POST /challenges to Request a New Code", http.StatusLocked)
+		case errors.Is(e, challenge.ErrExpired):
+			http.Error(w, "Expired Challenge - Use POST /challenges to Request a New Code", http.StatusGone)
+		case errors.Is(e, challenge.ErrSettled):
+			http.Error(w, "Challenge Already Confirmed or Superseded", http.StatusGone)
+		default:
+			slog.ErrorContext(ctx, "Unable to Confirm Challenge", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	// --> sign the grant ahead of committing, such that a signing failure doesn't consume the challenge
+	expiration := time.Now().Add(grant)
+
+	signed, e := token.Sign(ctx, confirmed.Subject, token.Purpose(confirmed.Purpose), expiration)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Sign Challenge Grant", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Confirmed Challenge", slog.String("id", confirmed.ID.String()), slog.String("purpose", confirmed.Purpose))
+
+	events.Emit(ctx, events.Challenged, confirmed.Subject, events.UserChallengeConfirmed{
+		ID:           confirmed.ID.String(),
+		Email:        confirmed.Subject,
+		Purpose:      confirmed.Purpose,
+		Destination:  confirmed.Destination,
+		Confirmation: confirmed.Confirmation.Time.UTC(),
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Confirmed{ID: confirmed.ID.String(), Purpose: confirmed.Purpose, Status: confirmed.Status, Confirmation: confirmed.Confirmation.Time.UTC(), Grant: signed, Expiration: expiration.UTC()})
+
+	return
+})
@@ -0,0 +1,35 @@
+package challenges
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"verification-service/internal/library/middleware/keystore"
+)
+
+func TestIssueValidation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	tests := map[string]int{
+		`{"purpose": "email-verification"}`:                             http.StatusBadRequest,
+		`{"purpose": "unknown"}`:                                        http.StatusBadRequest,
+		`{"purpose": "password-reset", "email": "invalid"}`:             http.StatusBadRequest,
+		`{"purpose": "password-reset"}`:                                 http.StatusBadRequest,
+		`{"purpose": "account-deletion", "email": "user@example.com"}`:  http.StatusUnauthorized,
+		`{"purpose": "step-up", "destination": "new-user@example.com"}`: http.StatusUnauthorized,
+	}
+
+	for body, expected := range tests {
+		request := httptest.NewRequest(http.MethodPost, "/challenges", strings.NewReader(body)).WithContext(ctx)
+		recorder := httptest.NewRecorder()
+
+		Issue.ServeHTTP(recorder, request)
+
+		if recorder.Code != expected {
+			t.Errorf("Unexpected Status Code for %s: %d, Expected %d", body, recorder.Code, expected)
+		}
+	}
+}
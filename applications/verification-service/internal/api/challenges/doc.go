@@ -0,0 +1,11 @@
+// Package challenges issues and confirms purpose-scoped challenge(s) -- password reset, email change, account deletion,
+// and sensitive-action step-up -- via POST /challenges and POST /challenges/{id}/confirm. A confirmed challenge yields a
+// short-lived grant: a signed link token (see token.Sign) whose purpose matches the challenge's, which the service
+// performing the action verifies before acting. A "user.challenge.confirmed" event is emitted alongside.
+//
+// Purpose(s) permitting anonymous use (see challenge.Policy) -- i.e. password reset -- are keyed by the email address
+// supplied in the request body. Their response(s) never reveal whether an account exists: an unknown, throttled, or
+// suppressed address receives the same 202 response, without a code being delivered.
+//
+// Email verification is a challenge purpose too, but is issued & confirmed via POST /register, /resend and /verify.
+package challenges
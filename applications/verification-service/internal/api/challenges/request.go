@@ -0,0 +1,62 @@
+package challenges
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"verification-service/internal/library/server"
+)
+
+// Body represents the issue handler's structured request-body.
+type Body struct {
+	Purpose     string `json:"purpose" validate:"required,oneof=password-reset email-change account-deletion step-up"` // Purpose represents the action the challenge authorizes.
+	Email       string `json:"email,omitempty" validate:"omitempty,email,max=255"`                                     // Email represents an anonymous challenge's subject; ignored given a session.
+	Destination string `json:"destination,omitempty" validate:"omitempty,email,max=255"`                               // Destination represents an email change's new address.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"purpose": {
+			Value:   b.Purpose,
+			Valid:   v.Var(b.Purpose, "required,oneof=password-reset email-change account-deletion step-up") == nil,
+			Message: "(Required) One of: password-reset, email-change, account-deletion, step-up.",
+		},
+		"email": {
+			Value:   b.Email,
+			Valid:   v.Var(b.Email, "omitempty,email,max=255") == nil,
+			Message: "(Optional) The account's email address; required for a password-reset without a session.",
+		},
+		"destination": {
+			Value:   b.Destination,
+			Valid:   v.Var(b.Destination, "omitempty,email,max=255") == nil,
+			Message: "(Optional) The new email address; required for an email-change.",
+		},
+	}
+
+	return mapping
+}
+
+// Code represents the confirm handler's structured request-body.
+type Code struct {
+	Code string `json:"code" validate:"required,max=64"` // Code represents the delivered challenge code.
+}
+
+func (c *Code) Help() server.Validators {
+	var mapping = server.Validators{
+		"code": {
+			Value:   c.Code,
+			Valid:   c.Code != "" && len(c.Code) <= 64,
+			Message: "(Required) The code delivered for the challenge.",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body and Code satisfy server.Helper
+var (
+	_ server.Helper = (*Body)(nil)
+	_ server.Helper = (*Code)(nil)
+)
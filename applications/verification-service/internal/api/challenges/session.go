@@ -0,0 +1,56 @@
+package challenges
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"verification-service/internal/token"
+)
+
+// ErrCredential is returned by [session] for a malformed Authorization header, or a session token that fails
+// verification.
+var ErrCredential = errors.New("invalid session credential")
+
+// session returns the request's authenticated subject when a session credential -- the "token" cookie, else a Bearer
+// Authorization header -- is presented. Unlike the authentication middleware, a request without a credential isn't
+// rejected, such that anonymous purpose(s) remain usable; an invalid credential returns [ErrCredential].
+func session(r *http.Request) (subject string, authenticated bool, e error) {
+	ctx := r.Context()
+
+	var credential string
+
+	if cookie, e := r.Cookie("token"); e == nil {
+		credential = cookie.Value
+	} else if authorization := r.Header.Get("Authorization"); authorization != "" {
+		scheme, value, ok := strings.Cut(authorization, " ")
+		if !(ok) || scheme != "Bearer" || value == "" {
+			return "", false, ErrCredential
+		}
+
+		credential = value
+	}
+
+	if credential == "" {
+		return "", false, nil
+	}
+
+	jwttoken, e := token.Verify(ctx, credential)
+	if e != nil {
+		return "", false, errors.Join(ErrCredential, e)
+	}
+
+	claims, ok := jwttoken.Claims.(jwt.MapClaims)
+	if !(ok) {
+		return "", false, ErrCredential
+	}
+
+	subject, e = claims.GetSubject()
+	if e != nil || subject == "" {
+		return "", false, ErrCredential
+	}
+
+	return subject, true, nil
+}
@@ -0,0 +1,49 @@
+package challenges
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"verification-service/internal/library/middleware/keystore"
+	"verification-service/internal/token"
+)
+
+func TestSession(t *testing.T) {
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	t.Run("Anonymous", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/challenges", nil).WithContext(ctx)
+
+		if subject, authenticated, e := session(request); e != nil || authenticated || subject != "" {
+			t.Errorf("Unexpected Session: %q, %t, %v", subject, authenticated, e)
+		}
+	})
+
+	t.Run("Malformed-Authorization", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/challenges", nil).WithContext(ctx)
+		request.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+
+		if _, authenticated, e := session(request); !(errors.Is(e, ErrCredential)) || authenticated {
+			t.Errorf("Expected ErrCredential, Received: %v", e)
+		}
+	})
+
+	t.Run("Link-Token-Rejected", func(t *testing.T) {
+		// --> a grant or verification link is signed with the same key, but its audience excludes every service
+		signature, e := token.Sign(ctx, "user@example.com", token.Purpose("password-reset"), time.Now().Add(time.Minute))
+		if e != nil {
+			t.Fatalf("Unable to Sign Link: %v", e)
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "/challenges", nil).WithContext(ctx)
+		request.AddCookie(&http.Cookie{Name: "token", Value: signature})
+
+		if _, authenticated, e := session(request); !(errors.Is(e, ErrCredential)) || authenticated {
+			t.Errorf("Expected ErrCredential, Received: %v", e)
+		}
+	})
+}
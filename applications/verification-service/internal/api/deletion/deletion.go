@@ -11,7 +11,6 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"verification-service/internal/database"
-	"verification-service/models/verifications"
 
 	"verification-service/internal/library/middleware"
 	"verification-service/internal/library/middleware/authentication"
@@ -59,9 +58,9 @@ func handle(w http.ResponseWriter, r *http.Request) {
 
 	defer database.Disconnect(ctx, connection, tx)
 
-	// --> delete the record
-	if e := verifications.New().DeleteByEmail(ctx, tx, email); e != nil {
-		slog.ErrorContext(ctx, "Unable to Delete Verification Record", slog.String("error", e.Error()))
+	// --> delete the user's record(s), including any outbound email(s) addressed to them
+	if e := purge(ctx, tx, email); e != nil {
+		slog.ErrorContext(ctx, "Unable to Delete Verification Record(s)", slog.String("error", e.Error()))
 
 		labeler.Add(attribute.Bool("error", true))
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -0,0 +1,36 @@
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"verification-service/models/challenges"
+	"verification-service/models/emails"
+	"verification-service/models/verifications"
+)
+
+// purge hard-deletes every record of the email address: its email & phone verification(s), its challenge(s), and the
+// outbox's email(s) addressed to it -- which retain the address and, while undelivered, a live code.
+func purge(ctx context.Context, db emails.DBTX, email string) error {
+	if e := verifications.New().DeleteByEmail(ctx, db, email); e != nil {
+		return fmt.Errorf("unable to delete verification record: %w", e)
+	}
+
+	if e := verifications.New().DeletePhoneByEmail(ctx, db, email); e != nil {
+		return fmt.Errorf("unable to delete phone verification record: %w", e)
+	}
+
+	if e := challenges.New().DeleteBySubject(ctx, db, email); e != nil {
+		return fmt.Errorf("unable to delete challenge record(s): %w", e)
+	}
+
+	rows, e := emails.New().DeleteByRecipient(ctx, db, email)
+	if e != nil {
+		return fmt.Errorf("unable to delete outbound email record(s): %w", e)
+	}
+
+	slog.DebugContext(ctx, "Deleted Outbound Email Record(s)", slog.Int64("count", rows))
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package deletion
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// recorder records each executed statement, failing the statement(s) containing failure, if set.
+type recorder struct {
+	statements []string
+	arguments  [][]interface{}
+	failure    string
+}
+
+func (r *recorder) Exec(_ context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	r.statements = append(r.statements, sql)
+	r.arguments = append(r.arguments, arguments)
+
+	if r.failure != "" && strings.Contains(sql, r.failure) {
+		return pgconn.CommandTag{}, errors.New("failure")
+	}
+
+	return pgconn.NewCommandTag("DELETE 1"), nil
+}
+
+func (r *recorder) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query")
+}
+
+func (r *recorder) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return nil
+}
+
+func TestPurge(t *testing.T) {
+	const email = "user@example.com"
+
+	t.Run("Deletes-Outbound-Email", func(t *testing.T) {
+		db := &recorder{}
+		if e := purge(context.Background(), db, email); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		for _, table := range []string{`"Verification"`, `"Phone-Verification"`, `"Challenge"`, `"Email"`} {
+			found := false
+			for index, statement := range db.statements {
+				if strings.Contains(statement, "DELETE FROM "+table) && len(db.arguments[index]) == 1 && db.arguments[index][0] == email {
+					found = true
+				}
+			}
+
+			if !(found) {
+				t.Errorf("Expected %s Record(s) of %q to be Deleted; Statement(s): %v", table, email, db.statements)
+			}
+		}
+	})
+
+	t.Run("Outbox-Failure", func(t *testing.T) {
+		db := &recorder{failure: `"Email"`}
+		if e := purge(context.Background(), db, email); e == nil || !(strings.Contains(e.Error(), "outbound email")) {
+			t.Errorf("Expected Outbound Email Deletion Error, Received: %v", e)
+		}
+	})
+}
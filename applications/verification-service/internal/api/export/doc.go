@@ -0,0 +1,3 @@
+// Package export provides an internal HTTP handler that returns the authenticated user's verification data as part of a
+// data-subject access request.
+package export
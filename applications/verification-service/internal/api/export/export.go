@@ -0,0 +1,104 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/middleware"
+	"verification-service/internal/library/middleware/authentication"
+
+	"verification-service/internal/database"
+	"verification-service/models/challenges"
+	"verification-service/models/verifications"
+)
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "export"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer connection.Release()
+
+	// --> a missing record is a valid export state; the user may not have started verification
+	records := make([]verifications.ExportRow, 0, 1)
+
+	record, e := verifications.New().Export(ctx, connection, email)
+	if e != nil && !(errors.Is(e, pgx.ErrNoRows)) {
+		slog.ErrorContext(ctx, "Unable to Export Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if e == nil {
+		records = append(records, record)
+	}
+
+	phones := make([]verifications.ExportPhoneRow, 0, 1)
+
+	phone, e := verifications.New().ExportPhone(ctx, connection, email)
+	if e != nil && !(errors.Is(e, pgx.ErrNoRows)) {
+		slog.ErrorContext(ctx, "Unable to Export Phone Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if e == nil {
+		phones = append(phones, phone)
+	}
+
+	issued, e := challenges.New().Export(ctx, connection, email)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Export Challenge Record(s)", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"verifications": records, "phones": phones, "challenges": issued})
+
+	return
+}
+
+// Handler returns the authenticated user's email and phone verification record(s) and challenge(s), excluding code(s).
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
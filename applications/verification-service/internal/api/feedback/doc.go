@@ -0,0 +1,3 @@
+// Package feedback is the Amazon SNS HTTP(S) subscription endpoint for SES bounce & complaint notification(s), which
+// feed the suppression list.
+package feedback
@@ -0,0 +1,160 @@
+package feedback
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/middleware"
+
+	"verification-service/internal/database"
+	"verification-service/internal/sns"
+	"verification-service/internal/suppression"
+)
+
+// limit represents the maximum size, in bytes, of an SNS message; SNS itself caps message(s) at 256 KiB.
+const limit = 512 << 10
+
+// Topics returns the SNS topic ARN(s) accepted by the endpoint, configured via the required, comma-separated
+// SNS_TOPIC_ARNS environment variable. When unset, every message -- including a subscription confirmation -- is
+// rejected, as a validly signed message may originate from any topic.
+func Topics() []string {
+	var topics []string
+	for _, topic := range strings.Split(os.Getenv("SNS_TOPIC_ARNS"), ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+
+	return topics
+}
+
+// record suppresses the notification's address(es); overridable during unit-testing.
+var record = func(ctx context.Context, message string, entries []suppression.Entry) error {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		database.Disconnect(ctx, connection, nil)
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	if e := suppression.Record(ctx, tx, message, entries); e != nil {
+		return e
+	}
+
+	return tx.Commit(ctx)
+}
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "feedback"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	message, e := sns.Decode(http.MaxBytesReader(w, r.Body, limit))
+	if e != nil {
+		slog.WarnContext(ctx, "Unable to Decode SNS Message", slog.String("error", e.Error()))
+		http.Error(w, "Invalid SNS Message", http.StatusBadRequest)
+		return
+	}
+
+	if header := r.Header.Get("X-Amz-Sns-Message-Type"); header != "" && header != string(message.Type) {
+		slog.WarnContext(ctx, "SNS Message Type Mismatch", slog.String("header", header), slog.String("type", string(message.Type)))
+		http.Error(w, "Invalid SNS Message", http.StatusBadRequest)
+		return
+	}
+
+	topics := Topics()
+	if len(topics) == 0 {
+		slog.ErrorContext(ctx, "SNS_TOPIC_ARNS is Unset - Rejecting SNS Message", slog.String("topic", message.TopicArn), slog.String("type", string(message.Type)))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if !(slices.Contains(topics, message.TopicArn)) {
+		slog.WarnContext(ctx, "SNS Message From Unexpected Topic", slog.String("topic", message.TopicArn), slog.String("type", string(message.Type)))
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if e := message.Verify(ctx); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify SNS Message Signature", slog.String("message", message.MessageID), slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	switch message.Type {
+	case sns.SubscriptionConfirmation:
+		if e := message.Confirm(ctx); e != nil {
+			slog.ErrorContext(ctx, "Unable to Confirm SNS Subscription", slog.String("topic", message.TopicArn), slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+			return
+		}
+	case sns.UnsubscribeConfirmation:
+		slog.WarnContext(ctx, "SNS Subscription Removed", slog.String("topic", message.TopicArn))
+	case sns.Notification:
+		entries, e := suppression.Parse(message.Message)
+		if e != nil {
+			// --> a malformed notification won't improve upon redelivery; acknowledge it
+			slog.ErrorContext(ctx, "Unable to Parse SES Notification", slog.String("message", message.MessageID), slog.String("error", e.Error()))
+			break
+		}
+
+		if len(entries) == 0 {
+			slog.DebugContext(ctx, "SES Notification Suppresses No Address(es)", slog.String("message", message.MessageID))
+			break
+		}
+
+		if e := record(ctx, message.MessageID, entries); e != nil {
+			slog.ErrorContext(ctx, "Unable to Record Suppression(s)", slog.String("message", message.MessageID), slog.String("error", e.Error()))
+
+			// --> SNS retries a failed delivery
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		for _, entry := range entries {
+			slog.InfoContext(ctx, "Suppressed Email Address", slog.String("email", entry.Email), slog.String("reason", string(entry.Reason)), slog.String("type", entry.Type))
+		}
+	default:
+		slog.WarnContext(ctx, "Unsupported SNS Message Type", slog.String("type", string(message.Type)))
+		http.Error(w, "Unsupported SNS Message Type", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"status": "ok"})
+
+	return
+}
+
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
@@ -0,0 +1,199 @@
+package feedback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"verification-service/internal/library/middleware/keystore"
+	"verification-service/internal/sns"
+	"verification-service/internal/suppression"
+)
+
+// stub serves the recorded fixture(s)' signing certificate in place of SNS, recording every requested URL.
+type stub struct {
+	requests []string
+}
+
+func (s *stub) RoundTrip(request *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, request.URL.String())
+
+	body := []byte("<ConfirmSubscriptionResponse/>")
+	if strings.HasSuffix(request.URL.Path, ".pem") {
+		content, e := os.ReadFile(filepath.Join("..", "..", "sns", "testdata", "certificate.pem"))
+		if e != nil {
+			return nil, e
+		}
+
+		body = content
+	}
+
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header), Request: request}, nil
+}
+
+// recorded represents a single invocation of the (overridden) suppression recorder.
+type recorded struct {
+	message string
+	entries []suppression.Entry
+}
+
+// replay posts the recorded SNS fixture to the handler, returning the response, the recorded suppression(s), and the
+// stubbed SNS request(s).
+func replay(t *testing.T, name string, mutate func(body []byte) []byte, failure error) (*httptest.ResponseRecorder, []recorded, []string) {
+	t.Helper()
+
+	transport := &stub{}
+
+	client := sns.Client
+	sns.Client = &http.Client{Transport: transport}
+
+	var calls []recorded
+
+	original := record
+	record = func(ctx context.Context, message string, entries []suppression.Entry) error {
+		calls = append(calls, recorded{message, entries})
+		return failure
+	}
+
+	t.Cleanup(func() {
+		sns.Client = client
+		record = original
+	})
+
+	body, e := os.ReadFile(filepath.Join("..", "..", "sns", "testdata", name+".json"))
+	if e != nil {
+		t.Fatalf("Unable to Read Fixture: %v", e)
+	}
+
+	if mutate != nil {
+		body = mutate(body)
+	}
+
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	request := httptest.NewRequest(http.MethodPost, "/sns", bytes.NewReader(body)).WithContext(ctx)
+	request.Header.Set("Content-Type", "text/plain; charset=UTF-8")
+
+	response := httptest.NewRecorder()
+
+	Handler.ServeHTTP(response, request)
+
+	return response, calls, transport.requests
+}
+
+// topic is the SNS topic ARN of the recorded fixture(s).
+const topic = "arn:aws:sns:us-east-1:123456789012:verification-service-ses-feedback"
+
+func TestHandler(t *testing.T) {
+	t.Setenv("SNS_TOPIC_ARNS", "arn:aws:sns:us-east-1:123456789012:other-topic, "+topic)
+
+	t.Run("Subscription-Confirmation", func(t *testing.T) {
+		response, calls, requests := replay(t, "subscription-confirmation", nil, nil)
+		if response.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status Code: %d", response.Code)
+		}
+
+		if len(calls) != 0 {
+			t.Errorf("Unexpected Suppression(s): %+v", calls)
+		}
+
+		confirmed := false
+		for _, request := range requests {
+			confirmed = confirmed || strings.Contains(request, "Action=ConfirmSubscription")
+		}
+
+		if !(confirmed) {
+			t.Errorf("Subscription Wasn't Confirmed: %v", requests)
+		}
+	})
+
+	t.Run("Permanent-Bounce", func(t *testing.T) {
+		response, calls, _ := replay(t, "bounce-permanent", nil, nil)
+		if response.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status Code: %d", response.Code)
+		}
+
+		if len(calls) != 1 || calls[0].message != "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324" || len(calls[0].entries) != 2 {
+			t.Errorf("Unexpected Suppression(s): %+v", calls)
+		}
+	})
+
+	t.Run("Complaint", func(t *testing.T) {
+		response, calls, _ := replay(t, "complaint", nil, nil)
+		if response.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status Code: %d", response.Code)
+		}
+
+		if len(calls) != 1 || calls[0].entries[0].Email != "complainer@example.com" {
+			t.Errorf("Unexpected Suppression(s): %+v", calls)
+		}
+	})
+
+	for _, name := range []string{"bounce-transient", "delivery"} {
+		t.Run(name, func(t *testing.T) {
+			response, calls, _ := replay(t, name, nil, nil)
+			if response.Code != http.StatusOK {
+				t.Fatalf("Unexpected Status Code: %d", response.Code)
+			}
+
+			if len(calls) != 0 {
+				t.Errorf("Unexpected Suppression(s): %+v", calls)
+			}
+		})
+	}
+
+	t.Run("Forged", func(t *testing.T) {
+		response, calls, _ := replay(t, "complaint", func(body []byte) []byte {
+			return bytes.Replace(body, []byte("complainer@example.com"), []byte("victim@example.com"), 1)
+		}, nil)
+
+		if response.Code != http.StatusForbidden {
+			t.Errorf("Unexpected Status Code: %d", response.Code)
+		}
+
+		if len(calls) != 0 {
+			t.Errorf("Forged Message Suppressed Address(es): %+v", calls)
+		}
+	})
+
+	for _, name := range []string{"subscription-confirmation", "bounce-permanent"} {
+		t.Run("Unexpected-Topic-"+name, func(t *testing.T) {
+			t.Setenv("SNS_TOPIC_ARNS", "arn:aws:sns:us-east-1:123456789012:other-topic")
+
+			response, calls, requests := replay(t, name, nil, nil)
+			if response.Code != http.StatusForbidden || len(calls) != 0 || len(requests) != 0 {
+				t.Errorf("Unexpected Response: %d, %+v, %v", response.Code, calls, requests)
+			}
+		})
+
+		t.Run("Unset-Topics-"+name, func(t *testing.T) {
+			t.Setenv("SNS_TOPIC_ARNS", "")
+
+			response, calls, requests := replay(t, name, nil, nil)
+			if response.Code != http.StatusForbidden || len(calls) != 0 || len(requests) != 0 {
+				t.Errorf("Unexpected Response: %d, %+v, %v", response.Code, calls, requests)
+			}
+		})
+	}
+
+	t.Run("Malformed", func(t *testing.T) {
+		response, _, _ := replay(t, "complaint", func([]byte) []byte { return []byte("{") }, nil)
+		if response.Code != http.StatusBadRequest {
+			t.Errorf("Unexpected Status Code: %d", response.Code)
+		}
+	})
+
+	t.Run("Database-Failure", func(t *testing.T) {
+		response, _, _ := replay(t, "bounce-permanent", nil, errors.New("unavailable"))
+		if response.Code != http.StatusInternalServerError {
+			t.Errorf("Unexpected Status Code: %d -- SNS must redeliver", response.Code)
+		}
+	})
+}
@@ -0,0 +1,4 @@
+// Package mailbox exposes the development [mail.Mailbox] and fake SMS provider's [sms.Outbox] over HTTP, enabling
+// local stacks and end-to-end tests to read the verification email(s) and text message(s) that would otherwise only be
+// delivered externally. The routes are only registered when ENVIRONMENT is "local" or "development".
+package mailbox
@@ -0,0 +1,134 @@
+package mailbox
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/mail"
+	"verification-service/internal/library/middleware"
+	"verification-service/internal/library/sms"
+)
+
+// List is an HTTP handler returning the recorded message summaries, newest first. An optional "recipient" query
+// parameter filters by recipient.
+var List = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "mailbox-list"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+
+	defer span.End()
+
+	messages := mail.Box.List(r.URL.Query().Get("recipient"))
+
+	summaries := make([]mail.Envelope, 0, len(messages))
+	for _, envelope := range messages {
+		summaries = append(summaries, envelope.Summary())
+	}
+
+	slog.DebugContext(ctx, "Listing Development Mailbox", slog.Int("total", len(summaries)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"messages": summaries})
+
+	return
+})
+
+// Message is an HTTP handler returning a recorded message, including its rendered HTML and text bodies.
+var Message = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "mailbox-message"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+
+	defer span.End()
+
+	id := r.PathValue("id")
+
+	envelope, ok := mail.Box.Get(id)
+	if !(ok) {
+		slog.WarnContext(ctx, "Development Mailbox Message Not Found", slog.String("id", id))
+		http.Error(w, "Message Not Found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(envelope)
+
+	return
+})
+
+// Clear is an HTTP handler removing all recorded message(s).
+var Clear = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "mailbox-clear"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+
+	defer span.End()
+
+	mail.Box.Clear()
+
+	slog.InfoContext(ctx, "Cleared Development Mailbox")
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return
+})
+
+// Texts is an HTTP handler returning the text message(s) recorded by the fake SMS provider, newest first. An optional
+// "recipient" query parameter filters by E.164 phone number.
+var Texts = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "mailbox-texts"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+
+	defer span.End()
+
+	messages := sms.Box.List(r.URL.Query().Get("recipient"))
+
+	slog.DebugContext(ctx, "Listing Development SMS Outbox", slog.Int("total", len(messages)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"messages": messages})
+
+	return
+})
+
+// ClearTexts is an HTTP handler removing all text message(s) recorded by the fake SMS provider.
+var ClearTexts = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "mailbox-clear-texts"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+
+	defer span.End()
+
+	sms.Box.Clear()
+
+	slog.InfoContext(ctx, "Cleared Development SMS Outbox")
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return
+})
@@ -0,0 +1,81 @@
+package mailbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"verification-service/internal/library/mail"
+	"verification-service/internal/library/middleware/keystore"
+)
+
+func TestMailbox(t *testing.T) {
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /dev/mailbox", List)
+	mux.Handle("GET /dev/mailbox/{id}", Message)
+	mux.Handle("DELETE /dev/mailbox", Clear)
+
+	mail.Box.Clear()
+
+	envelope := mail.Box.Record(mail.Memory, &mail.Message{Recipient: "user@example.com", Subject: "Verify", Text: "code", HTML: "<p>code</p>"})
+
+	request := func(method, path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(method, path, nil).WithContext(ctx))
+
+		return recorder
+	}
+
+	t.Run("List", func(t *testing.T) {
+		response := request(http.MethodGet, "/dev/mailbox?recipient=user@example.com")
+		if response.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status: %d", response.Code)
+		}
+
+		var body struct {
+			Messages []mail.Envelope `json:"messages"`
+		}
+
+		if e := json.NewDecoder(response.Body).Decode(&body); e != nil {
+			t.Fatalf("Unable to Decode Response: %v", e)
+		}
+
+		if len(body.Messages) != 1 || body.Messages[0].ID != envelope.ID || body.Messages[0].Text != "" {
+			t.Errorf("Unexpected Message Summaries: %+v", body.Messages)
+		}
+	})
+
+	t.Run("Message", func(t *testing.T) {
+		response := request(http.MethodGet, "/dev/mailbox/"+envelope.ID)
+		if response.Code != http.StatusOK {
+			t.Fatalf("Unexpected Status: %d", response.Code)
+		}
+
+		var body mail.Envelope
+		if e := json.NewDecoder(response.Body).Decode(&body); e != nil {
+			t.Fatalf("Unable to Decode Response: %v", e)
+		}
+
+		if body.Text != "code" || body.HTML != "<p>code</p>" {
+			t.Errorf("Unexpected Message: %+v", body)
+		}
+
+		if response := request(http.MethodGet, "/dev/mailbox/unknown"); response.Code != http.StatusNotFound {
+			t.Errorf("Unexpected Status for Unknown Message: %d", response.Code)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		if response := request(http.MethodDelete, "/dev/mailbox"); response.Code != http.StatusNoContent {
+			t.Fatalf("Unexpected Status: %d", response.Code)
+		}
+
+		if messages := mail.Box.List(""); len(messages) != 0 {
+			t.Errorf("Unexpected Message(s) After Clear: %+v", messages)
+		}
+	})
+}
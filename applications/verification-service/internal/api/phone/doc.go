@@ -0,0 +1,4 @@
+// Package phone captures and verifies the authenticated user's phone number via SMS. Phone verification shares the
+// email verification code lifecycle: codes expire, lock after repeated invalid attempts, and resends are subject to a
+// per-user cooldown and daily cap.
+package phone
@@ -0,0 +1,449 @@
+package phone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/database"
+	"verification-service/internal/digest"
+	"verification-service/internal/library/mail"
+	"verification-service/internal/library/middleware"
+	"verification-service/internal/library/middleware/authentication"
+	"verification-service/internal/library/random"
+	"verification-service/internal/library/server"
+	"verification-service/internal/library/sms"
+	"verification-service/internal/policy"
+	"verification-service/models/verifications"
+)
+
+// Response represents the capture & resend handlers' response body. The code itself is only ever delivered via SMS.
+type Response struct {
+	Phone      string    `json:"phone"`      // Phone represents the E.164-normalized phone number.
+	Expiration time.Time `json:"expiration"` // Expiration represents when the newly issued code expires.
+	Remaining  int32     `json:"remaining"`  // Remaining represents the number of deliveries left in the current daily window.
+}
+
+// send delivers a new SMS code; overridable during unit-testing.
+var send = sms.Verification
+
+// throttled writes a 429 response with a Retry-After header if the record's cooldown or daily cap applies.
+func throttled(ctx context.Context, w http.ResponseWriter, record *verifications.PhoneVerification) bool {
+	wait := policy.Throttled(record.Delivery, record.Window, record.Deliveries, time.Now().UTC(), policy.Cooldown(), policy.Limit())
+	if wait <= 0 {
+		return false
+	}
+
+	slog.WarnContext(ctx, "Phone Verification Delivery Throttled", slog.String("phone", sms.Mask(record.Phone)), slog.Duration("wait", wait))
+
+	w.Header().Set("Retry-After", strconv.FormatInt(policy.Seconds(wait), 10))
+	http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+
+	return true
+}
+
+// subject returns the authenticated user's email, writing a 401 response otherwise.
+func subject(ctx context.Context, w http.ResponseWriter) (string, bool) {
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return "", false
+	}
+
+	return email, true
+}
+
+// Capture is an HTTP handler that assigns a phone number to the authenticated user and sends it an SMS verification
+// code. Capturing a new phone number replaces the user's previous one -- a previously verified number no longer
+// counts as verified.
+var Capture = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "phone-capture"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	email, ok := subject(ctx, w)
+	if !(ok) {
+		return
+	}
+
+	ctx = mail.WithLocale(ctx, mail.Resolve(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language")))
+
+	var input Body
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	phone, e := sms.Normalize(input.Phone)
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Phone Number", slog.String("error", e.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(server.Validators{"phone": {Value: input.Phone, Valid: false, Message: e.Error()}})
+
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	// --> the cooldown & daily cap apply per user, regardless of phone number
+	existing, e := verifications.New().LockPhone(ctx, tx, email)
+	if e != nil && !(errors.Is(e, pgx.ErrNoRows)) {
+		slog.ErrorContext(ctx, "Unable to Lock Phone Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if e == nil {
+		if existing.Verified && existing.Phone == phone {
+			slog.WarnContext(ctx, "Phone Number Already Verified", slog.String("phone", sms.Mask(phone)))
+			http.Error(w, "Phone Number Already Verified", http.StatusUnprocessableEntity)
+			return
+		}
+
+		if throttled(ctx, w, &existing) {
+			return
+		}
+	}
+
+	claimed, e := verifications.New().Claimed(ctx, tx, &verifications.ClaimedParams{Phone: phone, Email: email})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Check Phone Number Ownership", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if claimed {
+		slog.WarnContext(ctx, "Phone Number Verified by Another User", slog.String("phone", sms.Mask(phone)))
+		http.Error(w, "Phone Number Unavailable", http.StatusConflict)
+		return
+	}
+
+	code := random.Code(random.OTP)
+
+	record, e := verifications.New().CapturePhone(ctx, tx, &verifications.CapturePhoneParams{Email: email, Phone: phone, Digest: digest.Digest(phone, code), Lifetime: verifications.Lifetime.Seconds()})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Capture Phone Number", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if e := send(ctx, phone, code, int(verifications.Lifetime.Hours()), "hours"); e != nil {
+		slog.ErrorContext(ctx, "Unable to Send SMS", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Captured Phone Number", slog.String("phone", sms.Mask(phone)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(Response{Phone: record.Phone, Expiration: record.Expiration.Time, Remaining: max(policy.Limit()-record.Deliveries, 0)})
+
+	return
+})
+
+// Resend is an HTTP handler that rotates the authenticated user's pending phone verification code and sends it anew.
+var Resend = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "phone-resend"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	email, ok := subject(ctx, w)
+	if !(ok) {
+		return
+	}
+
+	ctx = mail.WithLocale(ctx, mail.Resolve(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language")))
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	existing, e := verifications.New().LockPhone(ctx, tx, email)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Phone Verification Record Not Found")
+			http.Error(w, "Phone Verification Record Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Phone Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if existing.Verified {
+		slog.WarnContext(ctx, "Phone Number Already Verified", slog.String("phone", sms.Mask(existing.Phone)))
+		http.Error(w, "Phone Number Already Verified", http.StatusUnprocessableEntity)
+		return
+	}
+
+	if throttled(ctx, w, &existing) {
+		return
+	}
+
+	code := random.Code(random.OTP)
+
+	record, e := verifications.New().RotatePhone(ctx, tx, &verifications.RotatePhoneParams{Email: email, Digest: digest.Digest(existing.Phone, code), Lifetime: verifications.Lifetime.Seconds()})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Rotate Phone Verification Code", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if e := send(ctx, record.Phone, code, int(verifications.Lifetime.Hours()), "hours"); e != nil {
+		slog.ErrorContext(ctx, "Unable to Send SMS", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Resent Phone Verification Code", slog.String("phone", sms.Mask(record.Phone)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Response{Phone: record.Phone, Expiration: record.Expiration.Time, Remaining: max(policy.Limit()-record.Deliveries, 0)})
+
+	return
+})
+
+// Verify is an HTTP handler that verifies the authenticated user's phone number against the submitted SMS code.
+var Verify = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "phone-verify"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	email, ok := subject(ctx, w)
+	if !(ok) {
+		return
+	}
+
+	var input Code
+	if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+		slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+		if validator != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validator)
+
+			return
+		}
+
+		http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	record, e := verifications.New().LockPhone(ctx, tx, email)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Phone Verification Record Not Found")
+			http.Error(w, "Phone Verification Record Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Phone Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if record.Verified {
+		slog.WarnContext(ctx, "Phone Number Already Verified", slog.String("phone", sms.Mask(record.Phone)))
+		http.Error(w, "Phone Number Already Verified", http.StatusUnprocessableEntity)
+		return
+	} else if time.Now().UTC().After(record.Expiration.Time) {
+		slog.WarnContext(ctx, "Expired Phone Verification Code")
+		http.Error(w, "Expired Verification Code", http.StatusGone)
+		return
+	} else if record.Attempts >= policy.Attempts() {
+		slog.WarnContext(ctx, "Phone Verification Locked After Invalid Attempts", slog.Int("attempts", int(record.Attempts)))
+		http.Error(w, "Too Many Invalid Attempts - Use POST /phone/resend to Request a New Code", http.StatusLocked)
+		return
+	}
+
+	if !(digest.Equal(record.Digest, record.Phone, input.Code)) {
+		attempts, e := verifications.New().AttemptPhone(ctx, tx, email)
+		if e != nil {
+			slog.ErrorContext(ctx, "Unable to Record Phone Verification Attempt", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		// --> the attempt must persist regardless of the response
+		if e := tx.Commit(ctx); e != nil {
+			const message = "Unable to Commit Transaction"
+
+			slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, message, http.StatusInternalServerError)
+			return
+		}
+
+		slog.WarnContext(ctx, "Invalid Phone Verification Code", slog.Int("attempts", int(attempts)))
+		http.Error(w, "Invalid Verification Code", http.StatusConflict)
+		return
+	}
+
+	if e := verifications.New().VerifyPhone(ctx, tx, email); e != nil {
+		var exception *pgconn.PgError
+		if errors.As(e, &exception) && exception.Code == "23505" { // --> unique_violation; another user verified the number first
+			slog.WarnContext(ctx, "Phone Number Verified Concurrently by Another User", slog.String("phone", sms.Mask(record.Phone)))
+			http.Error(w, "Phone Number Unavailable", http.StatusConflict)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Verify Phone Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Verified Phone Number", slog.String("phone", sms.Mask(record.Phone)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"status": "ok", "phone": record.Phone})
+
+	return
+})
@@ -0,0 +1,50 @@
+package phone
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"verification-service/internal/library/server"
+)
+
+// Body represents the capture handler's structured request-body.
+type Body struct {
+	Phone string `json:"phone" validate:"required,max=32"` // Phone represents the user's phone number, including its country calling code.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"phone": {
+			Value:   b.Phone,
+			Valid:   b.Phone != "" && len(b.Phone) <= 32,
+			Message: "(Required) A phone number including its country calling code, e.g. +1 555 010 9999.",
+		},
+	}
+
+	return mapping
+}
+
+// Code represents the verify handler's structured request-body.
+type Code struct {
+	Code string `json:"verification-code" validate:"required"` // Code represents the SMS verification code.
+}
+
+func (c *Code) Help() server.Validators {
+	var mapping = server.Validators{
+		"verification-code": {
+			Value:   c.Code,
+			Valid:   c.Code != "",
+			Message: "(Required) A valid verification code.",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body and Code satisfy server.Helper
+var (
+	_ server.Helper = (*Body)(nil)
+	_ server.Helper = (*Code)(nil)
+)
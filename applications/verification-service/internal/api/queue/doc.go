@@ -0,0 +1,3 @@
+// Package queue exposes the outbound email queue's state -- queued, sent, and failed (dead-lettered) email(s) -- to
+// administrator(s). Email content is never exposed.
+package queue
@@ -0,0 +1,140 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/middleware"
+
+	"verification-service/internal/database"
+	"verification-service/models/emails"
+)
+
+// Page represents the list handler's response.
+type Page struct {
+	Summary map[string]int64 `json:"summary"` // Summary represents the number of email(s) per status, across the whole queue.
+	Emails  []emails.Email   `json:"emails"`  // Emails represents the page's email(s), newest first.
+	Cursor  *int64           `json:"cursor"`  // Cursor represents the "cursor" query parameter of the next page, if any.
+}
+
+// List returns a page of the queue's email(s), optionally filtered by status, alongside a per-status summary.
+var List = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "queue-list"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	filter, e := Parse(r.URL.Query())
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Email Queue Query Parameter(s)", slog.String("error", e.Error()))
+		http.Error(w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	rows, e := emails.New().Summary(ctx, connection)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Summarize Email Queue", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	page := Page{Summary: map[string]int64{emails.Queued: 0, emails.Sent: 0, emails.Failed: 0}}
+	for _, row := range rows {
+		page.Summary[row.Status] = row.Count
+	}
+
+	page.Emails, e = emails.New().List(ctx, connection, &emails.ListParams{Status: filter.Status, Cursor: filter.Cursor, Size: filter.Size})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to List Email Queue", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if count := len(page.Emails); count == int(filter.Size) {
+		page.Cursor = &page.Emails[count-1].ID
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
+
+	return
+})
+
+// Email returns a single queued email's state.
+var Email = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	const name = "queue-email"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	id, e := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if e != nil {
+		http.Error(w, "Invalid Email Identifier", http.StatusBadRequest)
+		return
+	}
+
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	email, e := emails.New().Get(ctx, connection, id)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			http.Error(w, "Email Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Get Queued Email", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(email)
+
+	return
+})
@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"verification-service/models/emails"
+)
+
+const (
+	size    = 50  // size represents the default page size.
+	maximum = 250 // maximum represents the largest permitted page size.
+)
+
+// Filter represents the list handler's parsed query parameter(s).
+type Filter struct {
+	Status *string // Status represents the optional status filter.
+	Cursor *int64  // Cursor represents the exclusive upper bound of the page's email identifier(s).
+	Size   int32   // Size represents the page size.
+}
+
+// Parse validates the list handler's query parameter(s): "status" (queued, sent, or failed), "cursor", and "limit".
+func Parse(query url.Values) (*Filter, error) {
+	filter := &Filter{Size: size}
+
+	if value := query.Get("status"); value != "" {
+		status := strings.ToUpper(value)
+		switch status {
+		case emails.Queued, emails.Sent, emails.Failed:
+			filter.Status = &status
+		default:
+			return nil, errors.New("invalid status: expected one of queued, sent, or failed")
+		}
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		cursor, e := strconv.ParseInt(value, 10, 64)
+		if e != nil || cursor < 1 {
+			return nil, errors.New("invalid cursor: expected a positive integer")
+		}
+
+		filter.Cursor = &cursor
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, e := strconv.Atoi(value)
+		if e != nil || limit < 1 || limit > maximum {
+			return nil, errors.New("invalid limit: expected an integer between 1 and 250")
+		}
+
+		filter.Size = int32(limit)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,30 @@
+package queue
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	filter, e := Parse(url.Values{})
+	if e != nil || filter.Status != nil || filter.Cursor != nil || filter.Size != size {
+		t.Errorf("Unexpected Default Filter: %+v (%v)", filter, e)
+	}
+
+	filter, e = Parse(url.Values{"status": {"failed"}, "cursor": {"120"}, "limit": {"10"}})
+	if e != nil || *filter.Status != "FAILED" || *filter.Cursor != 120 || filter.Size != 10 {
+		t.Errorf("Unexpected Filter: %+v (%v)", filter, e)
+	}
+
+	for _, query := range []url.Values{
+		{"status": {"sending"}},
+		{"cursor": {"-1"}},
+		{"cursor": {"abc"}},
+		{"limit": {"0"}},
+		{"limit": {"251"}},
+	} {
+		if _, e := Parse(query); e == nil {
+			t.Errorf("Expected Invalid Query to be Rejected: %v", query)
+		}
+	}
+}
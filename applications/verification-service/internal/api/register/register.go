@@ -2,6 +2,7 @@ package register
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -10,11 +11,12 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"verification-service/internal/challenge"
 	"verification-service/internal/database"
 	"verification-service/internal/library/mail"
 	"verification-service/internal/library/middleware"
 	"verification-service/internal/library/middleware/authentication"
-	"verification-service/internal/library/random"
+	"verification-service/internal/outbox"
 	"verification-service/models/verifications"
 )
 
@@ -39,6 +41,9 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// --> render email(s) in the user's preferred locale (supplied by the frontend), else the request's Accept-Language
+	ctx = mail.WithLocale(ctx, mail.Resolve(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language")))
+
 	// --> construct database payload & establish connection, transaction
 	connection, e := database.Connection(ctx)
 	if e != nil {
@@ -72,16 +77,14 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		slog.WarnContext(ctx, "Verification Record Already Exists", slog.String("email", email))
 
 		labeler.Add(attribute.Bool("error", true))
-		http.Error(w, "User Already Exists", http.StatusConflict)
+		http.Error(w, "User Already Exists - Use POST /resend to Request a New Code", http.StatusConflict)
 		return
 	}
 
 	// --> create the new record
-	record := &verifications.CreateParams{Email: email, Code: random.Verification()}
-
-	slog.DebugContext(ctx, "Creating New Verification Record", slog.Any("record", record))
+	slog.DebugContext(ctx, "Creating New Verification Record", slog.String("email", email))
 
-	result, e := verifications.New().Create(ctx, tx, record)
+	result, e := verifications.New().Create(ctx, tx, email)
 	if e != nil {
 		slog.ErrorContext(ctx, "Unable to Create New Verification Record", slog.String("error", e.Error()))
 
@@ -90,16 +93,30 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slog.DebugContext(ctx, "Sending Email", slog.String("recipient", record.Email))
-	if e := mail.Verification(ctx, record.Email, record.Code); e != nil {
-		slog.ErrorContext(ctx, "Unable to Send Email", slog.String("error", e.Error()))
+	// --> the verification code is an "email-verification" challenge
+	issued, code, e := challenge.Issue(ctx, tx, email, challenge.EmailVerification, email)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Issue Verification Challenge", slog.String("error", e.Error()))
 
 		labeler.Add(attribute.Bool("error", true))
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	slog.DebugContext(ctx, "Successfully Submitted Email")
+	// --> enqueue the email within the transaction; the outbox delivers it once committed, retrying transport failure(s)
+	slog.DebugContext(ctx, "Enqueuing Email", slog.String("recipient", email))
+	if e := challenge.Deliver(ctx, tx, issued, code); e != nil {
+		if errors.Is(e, mail.ErrSuppressed) { // --> the address hard-bounced or complained; see POST /sns
+			http.Error(w, "Email Address Suppressed Following a Bounce or Complaint", http.StatusUnprocessableEntity)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Enqueue Email", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 
 	// --> commit the transaction only after all error cases have been evaluated
 	if e := tx.Commit(ctx); e != nil {
@@ -110,6 +127,8 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	outbox.Wake()
+
 	slog.InfoContext(ctx, "Successfully Created Verification Record")
 
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,3 @@
+// Package resend rotates the authenticated user's verification code and emails it anew, subject to a per-user cooldown
+// and daily cap.
+package resend
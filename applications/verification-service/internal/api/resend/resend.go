@@ -0,0 +1,168 @@
+package resend
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/challenge"
+	"verification-service/internal/database"
+	"verification-service/internal/library/mail"
+	"verification-service/internal/library/middleware"
+	"verification-service/internal/library/middleware/authentication"
+	"verification-service/internal/outbox"
+	"verification-service/internal/policy"
+	"verification-service/models/verifications"
+)
+
+// Response represents the successful resend response. The code itself is only ever delivered via email.
+type Response struct {
+	Expiration time.Time `json:"expiration"` // Expiration represents when the newly issued code expires.
+	Remaining  int32     `json:"remaining"`  // Remaining represents the number of deliveries left in the current daily window.
+}
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "resend"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	email, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// --> render email(s) in the user's preferred locale (supplied by the frontend), else the request's Accept-Language
+	ctx = mail.WithLocale(ctx, mail.Resolve(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language")))
+
+	// --> establish connection, transaction
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	// --> lock the record to serialize concurrent resend requests against the cooldown & daily cap
+	verification, e := verifications.New().Lock(ctx, tx, email)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Verification Record Not Found", slog.String("email", email))
+			http.Error(w, "Verification Record Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !(verifications.Permitted(verification.Status, verifications.Pending)) {
+		slog.WarnContext(ctx, "User Already Verified", slog.String("email", email))
+		http.Error(w, "User Already Verified", http.StatusUnprocessableEntity)
+		return
+	}
+
+	limit := policy.Limit()
+	if wait := policy.Throttled(verification.Delivery, verification.Window, verification.Deliveries, time.Now().UTC(), policy.Cooldown(), limit); wait > 0 {
+		slog.WarnContext(ctx, "Verification Resend Throttled", slog.String("email", email), slog.Duration("wait", wait), slog.Int("deliveries", int(verification.Deliveries)))
+
+		w.Header().Set("Retry-After", strconv.FormatInt(policy.Seconds(wait), 10))
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+		return
+	}
+
+	// --> rotate the code, resetting its expiration & recording the delivery
+	verification, e = verifications.New().Rotate(ctx, tx, &verifications.RotateParams{Email: email, Lifetime: verifications.Lifetime.Seconds()})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Rotate Verification Code", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> issuing a new "email-verification" challenge supersedes the previous code
+	issued, code, e := challenge.Issue(ctx, tx, email, challenge.EmailVerification, email)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Issue Verification Challenge", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> enqueue the email within the transaction; the outbox delivers it once committed, retrying transport failure(s)
+	slog.DebugContext(ctx, "Enqueuing Email", slog.String("recipient", email))
+	if e := challenge.Deliver(ctx, tx, issued, code); e != nil {
+		if errors.Is(e, mail.ErrSuppressed) { // --> the address hard-bounced or complained; see POST /sns
+			http.Error(w, "Email Address Suppressed Following a Bounce or Complaint", http.StatusUnprocessableEntity)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Enqueue Email", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// --> the rotated code & its email are committed together
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	outbox.Wake()
+
+	slog.InfoContext(ctx, "Successfully Resent Verification Code", slog.String("email", email))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Response{Expiration: verification.Expiration.Time, Remaining: max(limit-verification.Deliveries, 0)})
+
+	return
+}
+
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
@@ -0,0 +1,3 @@
+// Package revoke lets an administrator revoke a user's email verification. The user must re-verify via POST /resend and
+// POST /verify.
+package revoke
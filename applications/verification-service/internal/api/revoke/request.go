@@ -0,0 +1,30 @@
+package revoke
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"verification-service/internal/library/server"
+)
+
+// Body represents the revoke handler's optional structured request-body.
+type Body struct {
+	Reason string `json:"reason" validate:"max=255"` // Reason represents why the verification is being revoked.
+}
+
+func (b *Body) Help() server.Validators {
+	var mapping = server.Validators{
+		"reason": {
+			Value:   b.Reason,
+			Valid:   len(b.Reason) <= 255,
+			Message: "(Optional) The reason for revoking the verification; at most 255 characters.",
+		},
+	}
+
+	return mapping
+}
+
+// v represents the request body's struct validator
+var v = validator.New(validator.WithRequiredStructEnabled())
+
+// --> ensure Body satisfies server.Helper
+var _ server.Helper = (*Body)(nil)
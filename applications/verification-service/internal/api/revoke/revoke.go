@@ -0,0 +1,160 @@
+package revoke
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/events"
+	"verification-service/internal/library/middleware"
+	"verification-service/internal/library/middleware/authentication"
+	"verification-service/internal/library/server"
+
+	"verification-service/internal/database"
+	"verification-service/models/verifications"
+)
+
+// Response represents the revoked verification.
+type Response struct {
+	Email      string                               `json:"email"`            // Email represents the email address whose verification was revoked.
+	Status     verifications.UserVerificationStatus `json:"status"`           // Status represents the verification's new lifecycle state (REVOKED).
+	Previous   verifications.UserVerificationStatus `json:"previous"`         // Previous represents the verification's lifecycle state prior to revocation.
+	Revocation time.Time                            `json:"revocation"`       // Revocation represents when the verification was revoked.
+	Reason     string                               `json:"reason,omitempty"` // Reason represents the administrator-supplied reason, if any.
+}
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	const name = "revoke"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	// Retrieve authentication context.
+	claims := authentication.New().Value(ctx).Token.Claims.(jwt.MapClaims)
+
+	administrator, e := claims.GetSubject()
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Get JWT Subject", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	email := strings.TrimSpace(r.PathValue("email"))
+	if email == "" {
+		http.Error(w, "Email Path Parameter Required", http.StatusBadRequest)
+		return
+	}
+
+	// --> the request-body, and therefore the reason, is optional
+	var input Body
+	if r.ContentLength != 0 {
+		if validator, e := server.Validate(ctx, v, r.Body, &input); e != nil {
+			slog.WarnContext(ctx, "Unable to Verify Request Body")
+
+			if validator != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(validator)
+
+				return
+			}
+
+			http.Error(w, "Unable to Validate Request Body", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	// --> construct database payload & establish connection, transaction
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	verification, e := verifications.New().Lock(ctx, tx, email)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Verification Record Not Found", slog.String("email", email))
+			http.Error(w, "Verification Record Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !(verifications.Permitted(verification.Status, verifications.Revoked)) {
+		slog.WarnContext(ctx, "Verification Already Revoked", slog.String("email", email))
+		http.Error(w, "Verification Already Revoked", http.StatusConflict)
+		return
+	}
+
+	var reason *string
+	if input.Reason != "" {
+		reason = &input.Reason
+	}
+
+	revoked, e := verifications.New().Revoke(ctx, tx, &verifications.RevokeParams{Email: email, Reason: reason})
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Revoke Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "Successfully Revoked Verification", slog.String("email", email), slog.String("administrator", administrator), slog.String("previous", string(verification.Status)))
+
+	events.Emit(ctx, events.Revoked, email, events.UserVerificationRevoked{Email: email, Revocation: revoked.Revocation.Time, Reason: input.Reason})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Response{Email: email, Status: revoked.Status, Previous: verification.Status, Revocation: revoked.Revocation.Time.UTC(), Reason: input.Reason})
+
+	return
+}
+
+var Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handle(w, r)
+
+	return
+})
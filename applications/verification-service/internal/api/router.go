@@ -5,12 +5,22 @@ import (
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"verification-service/internal/api/challenges"
 	"verification-service/internal/api/deletion"
+	"verification-service/internal/api/export"
+	"verification-service/internal/api/feedback"
+	"verification-service/internal/api/mailbox"
+	"verification-service/internal/api/phone"
+	"verification-service/internal/api/queue"
 	"verification-service/internal/api/register"
+	"verification-service/internal/api/resend"
+	"verification-service/internal/api/revoke"
 	"verification-service/internal/api/status"
 	"verification-service/internal/api/verify"
+	"verification-service/internal/library/mail"
 	"verification-service/internal/library/middleware"
 	"verification-service/internal/library/server"
+	"verification-service/internal/middleware/administrator"
 )
 
 func Router(parent *http.ServeMux) {
@@ -22,8 +32,13 @@ func Router(parent *http.ServeMux) {
 
 		mux.Handle("DELETE /", otelhttp.WithRouteTag("/", deletion.Handler))
 		mux.Handle("POST /register", otelhttp.WithRouteTag("/register", register.Handler))
+		mux.Handle("POST /resend", otelhttp.WithRouteTag("/resend", resend.Handler))
 		mux.Handle("POST /verify", otelhttp.WithRouteTag("/verify", verify.Handler))
 		mux.Handle("GET /status", otelhttp.WithRouteTag("/status", status.Handler))
+		mux.Handle("POST /phone", otelhttp.WithRouteTag("/phone", phone.Capture))
+		mux.Handle("POST /phone/resend", otelhttp.WithRouteTag("/phone/resend", phone.Resend))
+		mux.Handle("POST /phone/verify", otelhttp.WithRouteTag("/phone/verify", phone.Verify))
+		mux.Handle("GET /export", otelhttp.WithRouteTag("/export", export.Handler))
 
 		handler := middlewares.Handler(mux)
 
@@ -32,5 +47,28 @@ func Router(parent *http.ServeMux) {
 
 	authentication(parent)
 
+	// --> emailed verification link(s) carry a signed payload, and so must remain usable without a session
+	parent.Handle("GET /verify/{token}", otelhttp.WithRouteTag("/verify/{token}", verify.Link))
+
+	// --> a password reset is requested without a session; the handlers authenticate a presented session themselves
+	parent.Handle("POST /challenges", otelhttp.WithRouteTag("/challenges", challenges.Issue))
+	parent.Handle("POST /challenges/{id}/confirm", otelhttp.WithRouteTag("/challenges/{id}/confirm", challenges.Confirm))
+
+	// --> SNS can't present a session; its message(s) are authenticated by signature
+	parent.Handle("POST /sns", otelhttp.WithRouteTag("/sns", feedback.Handler))
+
+	parent.Handle("POST /admin/verifications/{email}/revoke", administrator.Middleware(otelhttp.WithRouteTag("/admin/verifications/{email}/revoke", revoke.Handler)))
+	parent.Handle("GET /admin/emails", administrator.Middleware(otelhttp.WithRouteTag("/admin/emails", queue.List)))
+	parent.Handle("GET /admin/emails/{id}", administrator.Middleware(otelhttp.WithRouteTag("/admin/emails/{id}", queue.Email)))
+
+	// --> the development mailbox exposes rendered email(s) & text message(s), including verification code(s); only registered in an allowlisted development environment
+	if mail.Development() {
+		parent.Handle("GET /dev/mailbox", otelhttp.WithRouteTag("/dev/mailbox", mailbox.List))
+		parent.Handle("GET /dev/mailbox/{id}", otelhttp.WithRouteTag("/dev/mailbox/{id}", mailbox.Message))
+		parent.Handle("DELETE /dev/mailbox", otelhttp.WithRouteTag("/dev/mailbox", mailbox.Clear))
+		parent.Handle("GET /dev/sms", otelhttp.WithRouteTag("/dev/sms", mailbox.Texts))
+		parent.Handle("DELETE /dev/sms", otelhttp.WithRouteTag("/dev/sms", mailbox.ClearTexts))
+	}
+
 	parent.HandleFunc("GET /health", server.Health)
 }
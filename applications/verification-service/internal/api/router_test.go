@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouterDevelopment(t *testing.T) {
+	routes := []string{"GET /dev/mailbox", "GET /dev/mailbox/{id}", "DELETE /dev/mailbox", "GET /dev/sms", "DELETE /dev/sms"}
+
+	tests := []struct {
+		environment string
+		enabled     bool
+	}{
+		{"", false},
+		{"staging", false},
+		{"production", false},
+		{"local", true},
+		{"development", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.environment, func(t *testing.T) {
+			t.Setenv("ENVIRONMENT", test.environment)
+
+			mux := http.NewServeMux()
+			Router(mux)
+
+			for _, route := range routes {
+				var method, path = http.MethodGet, route[len("GET "):]
+				if route[0] == 'D' {
+					method, path = http.MethodDelete, route[len("DELETE "):]
+				}
+
+				_, pattern := mux.Handler(httptest.NewRequest(method, path, nil))
+				if registered := pattern == route; registered != test.enabled {
+					t.Errorf("Unexpected Registration for %q (Pattern: %q)", route, pattern)
+				}
+			}
+		})
+	}
+}
@@ -1 +1,3 @@
+// Package status reports the authenticated user's verification lifecycle state, establishing a verification record if
+// none exists.
 package status
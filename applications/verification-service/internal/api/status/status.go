@@ -2,10 +2,14 @@ package status
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -13,12 +17,46 @@ import (
 	"verification-service/internal/library/middleware"
 	"verification-service/internal/library/middleware/authentication"
 
+	"verification-service/internal/challenge"
 	"verification-service/internal/database"
 	"verification-service/internal/library/mail"
-	"verification-service/internal/library/random"
+	"verification-service/internal/outbox"
 	"verification-service/models/verifications"
 )
 
+// Response represents the user's verification state. Timestamps are null until the corresponding transition occurs.
+type Response struct {
+	Status        verifications.UserVerificationStatus `json:"status"`         // Status represents the verification's lifecycle state.
+	Verified      bool                                 `json:"verified"`       // Verified reports whether Status is VERIFIED.
+	VerifiedPhone *string                              `json:"verified_phone"` // VerifiedPhone represents the user's verified E.164 phone number, if any.
+	Creation      *time.Time                           `json:"creation"`       // Creation represents when the verification record was established.
+	Expiration    *time.Time                           `json:"expiration"`     // Expiration represents when the current code expires.
+	Verification  *time.Time                           `json:"verification"`   // Verification represents when the user was most recently verified.
+	Revocation    *time.Time                           `json:"revocation"`     // Revocation represents when the verification was most recently revoked.
+	Remaining     int64                                `json:"remaining"`      // Remaining represents the seconds of validity left on a PENDING code; otherwise 0.
+}
+
+// timestamp converts a nullable database timestamp into its JSON representation.
+func timestamp(value pgtype.Timestamptz) *time.Time {
+	if !(value.Valid) {
+		return nil
+	}
+
+	t := value.Time.UTC()
+
+	return &t
+}
+
+// remaining returns the whole seconds of validity left on a PENDING verification's code. Any other status, or an
+// elapsed expiration not yet swept to TIMEOUT, has none.
+func remaining(status verifications.UserVerificationStatus, expiration pgtype.Timestamptz, now time.Time) int64 {
+	if status != verifications.Pending || !(expiration.Valid) || !(expiration.Time.After(now)) {
+		return 0
+	}
+
+	return int64(expiration.Time.Sub(now) / time.Second)
+}
+
 func handle(w http.ResponseWriter, r *http.Request) {
 	const name = "status"
 
@@ -40,6 +78,9 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// --> render email(s) in the user's preferred locale (supplied by the frontend), else the request's Accept-Language
+	ctx = mail.WithLocale(ctx, mail.Resolve(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language")))
+
 	// --> construct database payload & establish connection, transaction
 	connection, e := database.Connection(ctx)
 	if e != nil {
@@ -65,19 +106,18 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		// --> create the new record
 		slog.DebugContext(ctx, "Creating New Verification Record")
 
-		code := random.Verification()
-
-		if e := mail.Verification(ctx, email, code); e != nil {
-			slog.ErrorContext(ctx, "Unable to Send Email", slog.String("error", e.Error()))
+		tx, e := connection.Begin(ctx)
+		if e != nil {
+			slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
 
 			labeler.Add(attribute.Bool("error", true))
 			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 			return
 		}
 
-		slog.DebugContext(ctx, "Successfully Submitted Email")
+		defer tx.Rollback(ctx)
 
-		result, e := verifications.New().Create(ctx, connection, &verifications.CreateParams{Email: email, Code: code})
+		result, e := verifications.New().Create(ctx, tx, email)
 		if e != nil {
 			slog.ErrorContext(ctx, "Unable to Create New Verification Record", slog.String("error", e.Error()))
 
@@ -86,6 +126,41 @@ func handle(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		issued, code, e := challenge.Issue(ctx, tx, email, challenge.EmailVerification, email)
+		if e != nil {
+			slog.ErrorContext(ctx, "Unable to Issue Verification Challenge", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		// --> the outbox delivers the email once the record commits, retrying transport failure(s)
+		if e := challenge.Deliver(ctx, tx, issued, code); e != nil {
+			if errors.Is(e, mail.ErrSuppressed) { // --> the address hard-bounced or complained; see POST /sns
+				http.Error(w, "Email Address Suppressed Following a Bounce or Complaint", http.StatusUnprocessableEntity)
+				return
+			}
+
+			slog.ErrorContext(ctx, "Unable to Enqueue Email", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if e := tx.Commit(ctx); e != nil {
+			const message = "Unable to Commit Transaction"
+
+			slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, message, http.StatusInternalServerError)
+			return
+		}
+
+		outbox.Wake()
+
 		slog.DebugContext(ctx, "Successfully Established Verification Database Record", slog.Any("record", result))
 	}
 
@@ -97,7 +172,33 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := map[string]bool{"verified": verification.Verified}
+	// --> verified_phone is the user's verified E.164 phone number, or null
+	var phone *string
+
+	value, e := verifications.New().VerifiedPhone(ctx, connection, email)
+	if e != nil && !(errors.Is(e, pgx.ErrNoRows)) {
+		slog.ErrorContext(ctx, "Unable to Get Verified Phone Number", slog.String("error", e.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if e == nil {
+		phone = &value
+	}
+
+	status := verification.Status
+	if status == verifications.Pending && !(remaining(status, verification.Expiration, time.Now()) > 0) {
+		status = verifications.Timeout // --> report the expiry ahead of the sweeper
+	}
+
+	response := Response{
+		Status:        status,
+		Verified:      status == verifications.Verified,
+		VerifiedPhone: phone,
+		Creation:      timestamp(verification.Creation),
+		Expiration:    timestamp(verification.Expiration),
+		Verification:  timestamp(verification.Verification),
+		Revocation:    timestamp(verification.Revocation),
+		Remaining:     remaining(status, verification.Expiration, time.Now()),
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -0,0 +1,50 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"verification-service/models/verifications"
+)
+
+func TestRemaining(t *testing.T) {
+	now := time.Now()
+
+	future := pgtype.Timestamptz{Valid: true, Time: now.Add(90 * time.Second)}
+	past := pgtype.Timestamptz{Valid: true, Time: now.Add(-time.Second)}
+
+	tests := []struct {
+		name       string
+		status     verifications.UserVerificationStatus
+		expiration pgtype.Timestamptz
+		expected   int64
+	}{
+		{"pending", verifications.Pending, future, 90},
+		{"pending-expired", verifications.Pending, past, 0},
+		{"pending-null", verifications.Pending, pgtype.Timestamptz{}, 0},
+		{"verified", verifications.Verified, future, 0},
+		{"timeout", verifications.Timeout, future, 0},
+		{"revoked", verifications.Revoked, future, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if v := remaining(test.status, test.expiration, now); v != test.expected {
+				t.Errorf("remaining() = %d, expected %d", v, test.expected)
+			}
+		})
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	if v := timestamp(pgtype.Timestamptz{}); v != nil {
+		t.Errorf("timestamp() of a null value = %v, expected nil", v)
+	}
+
+	now := time.Now()
+	if v := timestamp(pgtype.Timestamptz{Valid: true, Time: now}); v == nil || !(v.Equal(now)) || v.Location() != time.UTC {
+		t.Errorf("timestamp() = %v, expected %v in UTC", v, now)
+	}
+}
@@ -0,0 +1,161 @@
+package verify
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"verification-service/internal/library/events"
+	"verification-service/internal/library/middleware"
+
+	"verification-service/internal/challenge"
+	"verification-service/internal/database"
+	"verification-service/internal/link"
+	"verification-service/internal/token"
+	"verification-service/models/challenges"
+	"verification-service/models/verifications"
+)
+
+// resolve verifies the link token's subject, returning the resulting [link.State]. The link is self-contained, so
+// neither a session nor the verification code is required; a link issued before the verification's most recent
+// revocation is refused.
+func resolve(r *http.Request) link.State {
+	const name = "verify-link"
+
+	ctx := r.Context()
+
+	service := middleware.New().Service().Value(ctx)
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(service).Start(ctx, name)
+	labeler, _ := otelhttp.LabelerFromContext(ctx)
+
+	defer span.End()
+
+	claims, e := token.Linked(ctx, r.PathValue("token"), token.Email)
+	if e != nil {
+		if errors.Is(e, jwt.ErrTokenExpired) {
+			return link.Expired
+		}
+
+		return link.Invalid
+	}
+
+	email := claims.Subject
+
+	// --> construct database payload & establish connection, transaction
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Connection to Database", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		return link.Failed
+	}
+
+	tx, e := connection.Begin(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		return link.Failed
+	}
+
+	defer database.Disconnect(ctx, connection, tx)
+
+	verification, e := verifications.New().Lock(ctx, tx, email)
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Verification Record Not Found", slog.String("email", email))
+			return link.Invalid
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		return link.Failed
+	}
+
+	// --> a revocation invalidates every link issued before it, including those issued while the user was verified
+	if verification.Revocation.Valid && claims.IssuedAt.Time.Before(verification.Revocation.Time.Truncate(time.Second)) {
+		slog.WarnContext(ctx, "Verification Link Issued Prior to Revocation", slog.String("email", email))
+		return link.Revoked
+	}
+
+	switch verification.Status {
+	case verifications.Verified:
+		slog.InfoContext(ctx, "User Already Verified", slog.String("email", email))
+		return link.Verified
+	case verifications.Revoked:
+		slog.WarnContext(ctx, "Revoked Verification Request", slog.String("email", email))
+		return link.Revoked
+	case verifications.Timeout:
+		slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
+		return link.Expired
+	}
+
+	if time.Now().After(verification.Expiration.Time) { // --> expired, but not yet swept
+		if _, e := verifications.New().Expire(ctx, tx, email); e != nil {
+			slog.ErrorContext(ctx, "Unable to Expire Verification Record", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			return link.Failed
+		}
+
+		if e := tx.Commit(ctx); e != nil {
+			slog.ErrorContext(ctx, "Unable to Commit Transaction", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			return link.Failed
+		}
+
+		slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
+		return link.Expired
+	}
+
+	rows, e := verifications.New().Verify(ctx, tx, email)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Verify Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		return link.Failed
+	} else if rows == 0 { // --> the PENDING -> VERIFIED transition is guarded by the query; the verification has expired
+		slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
+		return link.Expired
+	}
+
+	// --> the link stands in for the code; the pending "email-verification" challenge is no longer confirmable
+	if _, e := challenges.New().Supersede(ctx, tx, &challenges.SupersedeParams{Subject: email, Purpose: string(challenge.EmailVerification)}); e != nil {
+		slog.ErrorContext(ctx, "Unable to Supersede Verification Challenge", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		return link.Failed
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		slog.ErrorContext(ctx, "Unable to Commit Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		return link.Failed
+	}
+
+	slog.InfoContext(ctx, "Successfully Verified User via Link", slog.String("email", email))
+
+	events.Emit(ctx, events.Verified, email, events.UserVerified{Email: email, Verification: time.Now().UTC()})
+
+	return link.Verified
+}
+
+// Link is the public, session-less counterpart to [Handler]: it resolves an emailed verification link and redirects to
+// the front-end with the resulting state.
+var Link = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	state := resolve(r)
+
+	http.Redirect(w, r, link.Redirect(r.Context(), state), http.StatusSeeOther)
+
+	return
+})
@@ -0,0 +1,46 @@
+package verify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"verification-service/internal/library/middleware/keystore"
+	"verification-service/internal/token"
+)
+
+func TestLink(t *testing.T) {
+	t.Setenv("FRONTEND_URL", "https://example.com/")
+
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	expired, e := token.Sign(ctx, "user@example.com", token.Email, time.Now().Add(-time.Minute))
+	if e != nil {
+		t.Fatalf("Unable to Sign Link: %v", e)
+	}
+
+	tests := map[string]string{
+		"malformed": "https://example.com/verification?state=invalid",
+		expired:     "https://example.com/verification?state=expired",
+	}
+
+	for signature, expected := range tests {
+		mux := http.NewServeMux()
+		mux.Handle("GET /verify/{token}", Link)
+
+		request := httptest.NewRequest(http.MethodGet, "/verify/"+signature, nil).WithContext(ctx)
+		recorder := httptest.NewRecorder()
+
+		mux.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusSeeOther {
+			t.Errorf("Unexpected Status Code: %d", recorder.Code)
+		}
+
+		if location := recorder.Header().Get("Location"); location != expected {
+			t.Errorf("Unexpected Redirect: %s, expected %s", location, expected)
+		}
+	}
+}
@@ -2,22 +2,26 @@ package verify
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"verification-service/internal/library/middleware/authentication"
 
+	"verification-service/internal/library/events"
 	"verification-service/internal/library/middleware"
 	"verification-service/internal/library/server"
 
+	"verification-service/internal/challenge"
 	"verification-service/internal/database"
+	"verification-service/models/challenges"
 	"verification-service/models/verifications"
 )
 
@@ -59,8 +63,6 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slog.InfoContext(ctx, "Input", slog.Any("body", input))
-
 	// --> construct database payload & establish connection, transaction
 	connection, e := database.Connection(ctx)
 	if e != nil {
@@ -71,59 +73,153 @@ func handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	defer connection.Release()
-
-	// --> check if record exists
-	count, e := verifications.New().Count(ctx, connection, email)
+	tx, e := connection.Begin(ctx)
 	if e != nil {
-		slog.ErrorContext(ctx, "Unable to Check Verification Count", slog.String("error", e.Error()))
+		slog.ErrorContext(ctx, "Error Establishing Database Transaction", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
-	} else if count == 0 {
-		slog.WarnContext(ctx, "Verification Record Not Found", slog.String("email", email))
-		http.Error(w, "Verification Record Not Found", http.StatusNotFound)
-		return
 	}
 
-	// --> validate the token(s)
-	verification, e := verifications.New().Get(ctx, connection, email)
+	defer database.Disconnect(ctx, connection, tx)
+
+	// --> lock the record to serialize concurrent attempt(s) against the attempt limit
+	verification, e := verifications.New().Lock(ctx, tx, email)
 	if e != nil {
-		slog.ErrorContext(ctx, "Unable to Get Verification Record", slog.String("error", e.Error()))
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Verification Record Not Found", slog.String("email", email))
+			http.Error(w, "Verification Record Not Found", http.StatusNotFound)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
 	now := time.Now().UTC()
-	if verification.Modification.Valid && now.After(verification.Modification.Time.Add(time.Hour*24)) {
-		slog.ErrorContext(ctx, "Expired Verification Request")
+	switch verification.Status {
+	case verifications.Verified:
+		slog.ErrorContext(ctx, "User Already Verified")
+		http.Error(w, "User Already Verified", http.StatusUnprocessableEntity)
+		return
+	case verifications.Revoked:
+		slog.WarnContext(ctx, "Revoked Verification Request", slog.String("email", email))
+		http.Error(w, "Verification Revoked - Use POST /resend to Request a New Code", http.StatusGone)
+		return
+	case verifications.Timeout:
+		slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
 		http.Error(w, "Expired Verification Request Token", http.StatusGone)
 		return
-	} else if now.After(verification.Creation.Time.Add(time.Hour * 24)) {
-		slog.ErrorContext(ctx, "Expired Verification Request")
+	}
+
+	if now.After(verification.Expiration.Time) { // --> expired, but not yet swept; expiration is reset whenever the code is resent
+		if _, e := verifications.New().Expire(ctx, tx, email); e != nil {
+			slog.ErrorContext(ctx, "Unable to Expire Verification Record", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if e := tx.Commit(ctx); e != nil {
+			const message = "Unable to Commit Transaction"
+
+			slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, message, http.StatusInternalServerError)
+			return
+		}
+
+		slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
 		http.Error(w, "Expired Verification Request Token", http.StatusGone)
 		return
-	} else if verification.Verified {
-		slog.ErrorContext(ctx, "User Already Verified")
-		http.Error(w, "User Already Verified", http.StatusUnprocessableEntity)
+	}
+
+	// --> the code is the user's most recent "email-verification" challenge; resending supersedes earlier code(s)
+	latest, e := challenges.New().Latest(ctx, tx, &challenges.LatestParams{Subject: email, Purpose: string(challenge.EmailVerification)})
+	if e != nil {
+		if errors.Is(e, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "Verification Challenge Not Found", slog.String("email", email))
+			http.Error(w, "Expired Verification Request Token", http.StatusGone)
+			return
+		}
+
+		slog.ErrorContext(ctx, "Unable to Lock Verification Challenge", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	if input.Code != verification.Code {
-		slog.ErrorContext(ctx, "Invalid Verification Request")
-		http.Error(w, "Invalid Verification Request Token", http.StatusConflict)
+	if _, e := challenge.Confirm(ctx, tx, latest, input.Code); e != nil {
+		switch {
+		case errors.Is(e, challenge.ErrInvalid), errors.Is(e, challenge.ErrLocked), errors.Is(e, challenge.ErrExpired):
+			// --> the attempt, or the challenge's transition, must persist regardless of the response
+			if e := tx.Commit(ctx); e != nil {
+				const message = "Unable to Commit Transaction"
+
+				slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+				labeler.Add(attribute.Bool("error", true))
+				http.Error(w, message, http.StatusInternalServerError)
+				return
+			}
+		}
+
+		switch {
+		case errors.Is(e, challenge.ErrInvalid):
+			slog.WarnContext(ctx, "Invalid Verification Request", slog.String("email", email))
+			http.Error(w, "Invalid Verification Request Token", http.StatusConflict)
+		case errors.Is(e, challenge.ErrLocked):
+			slog.WarnContext(ctx, "Verification Locked After Invalid Attempts", slog.String("email", email), slog.Int("attempts", int(latest.Attempts)))
+			http.Error(w, "Too Many Invalid Attempts - Use POST /resend to Request a New Code", http.StatusLocked)
+		case errors.Is(e, challenge.ErrExpired), errors.Is(e, challenge.ErrSettled):
+			slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
+			http.Error(w, "Expired Verification Request Token", http.StatusGone)
+		default:
+			slog.ErrorContext(ctx, "Unable to Confirm Verification Challenge", slog.String("error", e.Error()))
+
+			labeler.Add(attribute.Bool("error", true))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+
 		return
 	}
 
 	slog.DebugContext(ctx, "Verifying Verification Record")
 
-	if e := verifications.New().Verify(ctx, connection, &verifications.VerifyParams{Email: email, Modification: pgtype.Timestamptz{Valid: true, Time: time.Now().UTC()}}); e != nil {
+	rows, e := verifications.New().Verify(ctx, tx, email)
+	if e != nil {
 		slog.ErrorContext(ctx, "Unable to Verify Verification Record", slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
+	} else if rows == 0 { // --> the PENDING -> VERIFIED transition is guarded by the query; the code expired since locking
+		slog.WarnContext(ctx, "Expired Verification Request", slog.String("email", email))
+		http.Error(w, "Expired Verification Request Token", http.StatusGone)
+		return
+	}
+
+	if e := tx.Commit(ctx); e != nil {
+		const message = "Unable to Commit Transaction"
+
+		slog.ErrorContext(ctx, message, slog.String("error", e.Error()))
+
+		labeler.Add(attribute.Bool("error", true))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
 	}
 
 	slog.InfoContext(ctx, "Successfully Verified User")
 
+	events.Emit(ctx, events.Verified, email, events.UserVerified{Email: email, Verification: now})
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{"status": "ok"})
@@ -0,0 +1,249 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"verification-service/internal/digest"
+	"verification-service/internal/library/mail"
+	"verification-service/internal/library/random"
+	"verification-service/internal/library/sms"
+	"verification-service/internal/link"
+	"verification-service/internal/outbox"
+	"verification-service/internal/policy"
+	"verification-service/models/challenges"
+	"verification-service/models/verifications"
+)
+
+var (
+	ErrDestination = errors.New("challenge destination required")                // ErrDestination is returned by [Destination] when an email change omits, or doesn't change, the address.
+	ErrUnreachable = errors.New("challenge channel unavailable")                 // ErrUnreachable is returned by [Destination] when the subject has no verified phone number for an SMS challenge.
+	ErrSettled     = errors.New("challenge already confirmed or superseded")     // ErrSettled is returned by [Evaluate] for a challenge no longer pending.
+	ErrExpired     = errors.New("challenge expired")                             // ErrExpired is returned by [Evaluate] for a challenge whose code expired.
+	ErrLocked      = errors.New("challenge locked following invalid attempt(s)") // ErrLocked is returned by [Evaluate] for a challenge that exhausted its attempt(s).
+	ErrInvalid     = errors.New("invalid challenge code")                        // ErrInvalid is returned by [Confirm] for a code that doesn't match.
+	ErrUndelivered = errors.New("challenge sms undelivered")                     // ErrUndelivered wraps the SMS provider's error returned by [Deliver].
+)
+
+// send delivers an SMS challenge; overridable during unit-testing.
+var send = sms.Verification
+
+// Destination resolves where the subject's challenge of the purpose is delivered: the subject's own email address, the
+// requested address for an [EmailChange], or the subject's verified phone number for an SMS challenge.
+func Destination(ctx context.Context, db challenges.DBTX, purpose Purpose, subject string, requested string) (string, error) {
+	configuration, e := Lookup(purpose)
+	if e != nil {
+		return "", e
+	}
+
+	switch {
+	case purpose == EmailChange:
+		if requested == "" || strings.EqualFold(requested, subject) {
+			return "", ErrDestination
+		}
+
+		return requested, nil
+	case configuration.Channel == challenges.SMS:
+		phone, e := verifications.New().VerifiedPhone(ctx, db, subject)
+		if errors.Is(e, pgx.ErrNoRows) {
+			return "", ErrUnreachable
+		}
+
+		return phone, e
+	default:
+		return subject, nil
+	}
+}
+
+// Throttled evaluates the subject's challenge(s) of the purpose issued within the trailing 24 hours against the resend
+// cooldown and daily cap (see [policy.Throttled]), returning the duration the caller must wait before another is issued
+// alongside the number already issued.
+func Throttled(ctx context.Context, db challenges.DBTX, subject string, purpose Purpose, now time.Time) (time.Duration, int32, error) {
+	summary, e := challenges.New().Deliveries(ctx, db, &challenges.DeliveriesParams{Subject: subject, Purpose: string(purpose)})
+	if e != nil {
+		return 0, 0, e
+	}
+
+	return policy.Throttled(summary.Delivery, summary.Window, summary.Deliveries, now, policy.Cooldown(), policy.Limit()), summary.Deliveries, nil
+}
+
+// Issue supersedes the subject's pending challenge of the purpose and establishes a new one, returning it alongside its
+// code. The code is only ever returned here; see [Deliver].
+func Issue(ctx context.Context, db challenges.DBTX, subject string, purpose Purpose, destination string) (challenges.Challenge, string, error) {
+	configuration, e := Lookup(purpose)
+	if e != nil {
+		return challenges.Challenge{}, "", e
+	}
+
+	if _, e := challenges.New().Supersede(ctx, db, &challenges.SupersedeParams{Subject: subject, Purpose: string(purpose)}); e != nil {
+		return challenges.Challenge{}, "", e
+	}
+
+	id := uuid.New()
+	code := random.Code(configuration.Mode)
+
+	challenge, e := challenges.New().Create(ctx, db, &challenges.CreateParams{
+		ID:          id,
+		Subject:     subject,
+		Purpose:     string(purpose),
+		Channel:     configuration.Channel,
+		Destination: destination,
+		Digest:      digest.Digest(id.String(), code),
+		Lifetime:    configuration.Lifetime.Seconds(),
+	})
+
+	if e != nil {
+		return challenges.Challenge{}, "", e
+	}
+
+	slog.DebugContext(ctx, "Issued Challenge", slog.String("id", id.String()), slog.String("purpose", string(purpose)), slog.String("channel", configuration.Channel))
+
+	return challenge, code, nil
+}
+
+// Deliver sends the challenge's code via its channel, in the context's locale (see [mail.WithLocale]). An email is
+// enqueued to the outbox within the caller's transaction, such that the caller must [outbox.Wake] once committed; an
+// SMS is sent immediately -- its failure wrapping [ErrUndelivered]. A suppressed recipient returns [mail.ErrSuppressed].
+func Deliver(ctx context.Context, db challenges.DBTX, challenge challenges.Challenge, code string) error {
+	configuration, e := Lookup(Purpose(challenge.Purpose))
+	if e != nil {
+		return e
+	}
+
+	if challenge.Channel == challenges.SMS {
+		expiration, duration := mail.Span(configuration.Lifetime)
+
+		if e := send(ctx, challenge.Destination, code, expiration, duration); e != nil {
+			return fmt.Errorf("%w: %w", ErrUndelivered, e)
+		}
+
+		return nil
+	}
+
+	var message *mail.Message
+
+	if configuration.Template == "" { // --> the verification email additionally carries a link that verifies without a session
+		address, e := link.URL(ctx, challenge.Destination, challenge.Expiration.Time)
+		if e != nil {
+			return e
+		}
+
+		message, e = mail.Compose(ctx, challenge.Destination, code, address)
+		if e != nil {
+			return e
+		}
+	} else {
+		message, e = mail.Challenge(ctx, challenge.Destination, configuration.Template, code, configuration.Lifetime)
+		if e != nil {
+			return e
+		}
+	}
+
+	_, e = outbox.Enqueue(ctx, db, message)
+
+	return e
+}
+
+// Lock returns the challenge identified by the id, acquiring a row-level lock for the remainder of the transaction. An
+// id that isn't a UUID can't match, returning [pgx.ErrNoRows].
+func Lock(ctx context.Context, db challenges.DBTX, id string) (challenges.Challenge, error) {
+	identifier, e := uuid.Parse(id)
+	if e != nil {
+		return challenges.Challenge{}, pgx.ErrNoRows
+	}
+
+	return challenges.New().Lock(ctx, db, identifier)
+}
+
+// Evaluate reports whether the challenge may still be confirmed at the provided time: [ErrSettled] once confirmed or
+// superseded, [ErrExpired] once expired, and [ErrLocked] once its attempt(s) are exhausted.
+func Evaluate(challenge challenges.Challenge, configuration Policy, now time.Time) error {
+	switch challenge.Status {
+	case challenges.Pending:
+	case challenges.Expired:
+		return ErrExpired
+	case challenges.Locked:
+		return ErrLocked
+	default:
+		return ErrSettled
+	}
+
+	switch {
+	case !(now.Before(challenge.Expiration.Time)):
+		return ErrExpired
+	case challenge.Attempts >= configuration.Attempts:
+		return ErrLocked
+	}
+
+	return nil
+}
+
+// Confirm confirms the locked challenge's code, returning the CONFIRMED challenge. A pending challenge found expired or
+// locked is transitioned accordingly, and an invalid code counts against its attempt(s) -- locking it once exhausted.
+// The caller must commit following [ErrExpired], [ErrLocked] and [ErrInvalid], such that the transition persists.
+func Confirm(ctx context.Context, db challenges.DBTX, challenge challenges.Challenge, code string) (challenges.Challenge, error) {
+	configuration, e := Lookup(Purpose(challenge.Purpose))
+	if e != nil {
+		return challenge, e
+	}
+
+	if e := Evaluate(challenge, configuration, time.Now()); e != nil {
+		if challenge.Status == challenges.Pending && (errors.Is(e, ErrExpired) || errors.Is(e, ErrLocked)) {
+			status := challenges.Expired
+			if errors.Is(e, ErrLocked) {
+				status = challenges.Locked
+			}
+
+			if e := challenges.New().Terminate(ctx, db, &challenges.TerminateParams{Status: status, ID: challenge.ID}); e != nil {
+				return challenge, e
+			}
+		}
+
+		return challenge, e
+	}
+
+	if !(digest.Equal(challenge.Digest, challenge.ID.String(), code)) {
+		attempts, e := challenges.New().Attempt(ctx, db, challenge.ID)
+		if e != nil {
+			return challenge, e
+		}
+
+		if attempts >= configuration.Attempts {
+			if e := challenges.New().Terminate(ctx, db, &challenges.TerminateParams{Status: challenges.Locked, ID: challenge.ID}); e != nil {
+				return challenge, e
+			}
+		}
+
+		slog.WarnContext(ctx, "Invalid Challenge Code", slog.String("id", challenge.ID.String()), slog.String("purpose", challenge.Purpose), slog.Int("attempts", int(attempts)))
+
+		return challenge, ErrInvalid
+	}
+
+	confirmed, e := challenges.New().Confirm(ctx, db, challenge.ID)
+	if errors.Is(e, pgx.ErrNoRows) { // --> the PENDING -> CONFIRMED transition is guarded by the query; the code expired since locking
+		return challenge, ErrExpired
+	}
+
+	return confirmed, e
+}
+
+// Mask obscures a challenge's destination for display -- e.g. "j***@example.com", or "********9999" via [sms.Mask].
+func Mask(channel string, destination string) string {
+	if channel == challenges.SMS {
+		return sms.Mask(destination)
+	}
+
+	local, domain, ok := strings.Cut(destination, "@")
+	if !(ok) || local == "" {
+		return "***"
+	}
+
+	return local[:1] + "***@" + domain
+}
@@ -0,0 +1,62 @@
+package challenge
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"verification-service/models/challenges"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	configuration := Policy{Lifetime: 10 * time.Minute, Attempts: 3}
+
+	challenge := func(status string, expiration time.Duration, attempts int32) challenges.Challenge {
+		return challenges.Challenge{Status: status, Attempts: attempts, Expiration: pgtype.Timestamptz{Time: now.Add(expiration), Valid: true}}
+	}
+
+	tests := []struct {
+		name      string
+		challenge challenges.Challenge
+		expected  error
+	}{
+		{"Pending", challenge(challenges.Pending, time.Minute, 0), nil},
+		{"Pending-Remaining-Attempt", challenge(challenges.Pending, time.Minute, 2), nil},
+		{"Pending-Elapsed", challenge(challenges.Pending, -time.Second, 0), ErrExpired},
+		{"Pending-At-Expiration", challenge(challenges.Pending, 0, 0), ErrExpired},
+		{"Pending-Exhausted", challenge(challenges.Pending, time.Minute, 3), ErrLocked},
+		{"Expired", challenge(challenges.Expired, time.Minute, 0), ErrExpired},
+		{"Locked", challenge(challenges.Locked, time.Minute, 3), ErrLocked},
+		{"Confirmed", challenge(challenges.Confirmed, time.Minute, 0), ErrSettled},
+		{"Superseded", challenge(challenges.Superseded, time.Minute, 0), ErrSettled},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if e := Evaluate(test.challenge, configuration, now); !(errors.Is(e, test.expected)) {
+				t.Errorf("Evaluate() = %v, Expected %v", e, test.expected)
+			}
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		channel     string
+		destination string
+		expected    string
+	}{
+		{challenges.Email, "jane@example.com", "j***@example.com"},
+		{challenges.Email, "invalid", "***"},
+		{challenges.SMS, "+15550109999", "********9999"},
+	}
+
+	for _, test := range tests {
+		if v := Mask(test.channel, test.destination); v != test.expected {
+			t.Errorf("Mask(%q, %q) = %q, Expected %q", test.channel, test.destination, v, test.expected)
+		}
+	}
+}
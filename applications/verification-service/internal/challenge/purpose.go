@@ -0,0 +1,90 @@
+// Package challenge issues and confirms purpose-scoped code(s). Each [Purpose] -- email verification, password reset,
+// email change, account deletion, and step-up of a sensitive action -- carries its own [Policy]: how long its code
+// remains confirmable, how many invalid attempt(s) lock it, which channel delivers it, and which template renders it.
+// A code confirms only the purpose it was issued for, and issuing a new code supersedes the subject's pending one.
+package challenge
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"verification-service/internal/library/random"
+	"verification-service/internal/policy"
+	"verification-service/models/challenges"
+	"verification-service/models/verifications"
+)
+
+// Purpose represents the action a challenge authorizes once confirmed.
+type Purpose string
+
+const (
+	EmailVerification Purpose = "email-verification" // EmailVerification confirms the subject owns their email address; see POST /register.
+	PasswordReset     Purpose = "password-reset"     // PasswordReset authorizes resetting a forgotten password; issuable without a session.
+	EmailChange       Purpose = "email-change"       // EmailChange confirms the subject owns the email address they're changing to.
+	AccountDeletion   Purpose = "account-deletion"   // AccountDeletion confirms the subject intends to delete their account.
+	StepUp            Purpose = "step-up"            // StepUp re-establishes the subject's identity ahead of a sensitive action.
+)
+
+// Policy represents a [Purpose]'s code lifecycle and delivery.
+type Policy struct {
+	Lifetime  time.Duration // Lifetime represents how long an issued code remains confirmable.
+	Attempts  int32         // Attempts represents the number of invalid submission(s) after which the challenge locks.
+	Channel   string        // Channel represents how the code is delivered; see [challenges.Email] and [challenges.SMS].
+	Template  string        // Template represents the email's message catalog scope; empty renders the verification email.
+	Mode      random.Mode   // Mode represents the code's format.
+	Anonymous bool          // Anonymous permits issuing & confirming the challenge without a session.
+}
+
+// ErrUnknownPurpose is returned by [Lookup] for a purpose without a [Policy].
+var ErrUnknownPurpose = errors.New("unknown challenge purpose")
+
+// Policies returns every [Purpose]'s [Policy]. Email verification follows the verification code lifecycle (see
+// [verifications.Lifetime], [policy.Attempts] and [random.Configuration]); every other purpose issues a short-lived
+// OTP, overridable via the CHALLENGE_<PURPOSE>_LIFETIME (a [time.ParseDuration] string) and
+// CHALLENGE_<PURPOSE>_ATTEMPTS environment variable(s) -- e.g. CHALLENGE_PASSWORD_RESET_LIFETIME.
+func Policies() map[Purpose]Policy {
+	return map[Purpose]Policy{
+		EmailVerification: {Lifetime: verifications.Lifetime, Attempts: policy.Attempts(), Channel: challenges.Email, Mode: random.Configuration()},
+		PasswordReset:     override(PasswordReset, Policy{Lifetime: 30 * time.Minute, Attempts: 3, Channel: challenges.Email, Template: string(PasswordReset), Mode: random.OTP, Anonymous: true}),
+		EmailChange:       override(EmailChange, Policy{Lifetime: time.Hour, Attempts: 5, Channel: challenges.Email, Template: string(EmailChange), Mode: random.OTP}),
+		AccountDeletion:   override(AccountDeletion, Policy{Lifetime: 15 * time.Minute, Attempts: 3, Channel: challenges.Email, Template: string(AccountDeletion), Mode: random.OTP}),
+		StepUp:            override(StepUp, Policy{Lifetime: 10 * time.Minute, Attempts: 3, Channel: challenges.SMS, Template: string(StepUp), Mode: random.OTP}),
+	}
+}
+
+// Lookup returns the purpose's [Policy], else [ErrUnknownPurpose].
+func Lookup(purpose Purpose) (Policy, error) {
+	value, ok := Policies()[purpose]
+	if !(ok) {
+		return Policy{}, ErrUnknownPurpose
+	}
+
+	return value, nil
+}
+
+// override applies the purpose's environment variable override(s) to its default policy.
+func override(purpose Purpose, defaults Policy) Policy {
+	prefix := "CHALLENGE_" + strings.ToUpper(strings.ReplaceAll(string(purpose), "-", "_"))
+
+	if value := os.Getenv(prefix + "_LIFETIME"); value != "" {
+		if duration, e := time.ParseDuration(value); e != nil || duration <= 0 {
+			slog.Warn("Invalid "+prefix+"_LIFETIME Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", defaults.Lifetime))
+		} else {
+			defaults.Lifetime = duration
+		}
+	}
+
+	if value := os.Getenv(prefix + "_ATTEMPTS"); value != "" {
+		if limit, e := strconv.ParseInt(value, 10, 32); e != nil || limit < 1 {
+			slog.Warn("Invalid "+prefix+"_ATTEMPTS Environment Variable - Using Default", slog.String("value", value), slog.Int("default", int(defaults.Attempts)))
+		} else {
+			defaults.Attempts = int32(limit)
+		}
+	}
+
+	return defaults
+}
@@ -0,0 +1,67 @@
+package challenge
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"verification-service/internal/library/mail"
+	"verification-service/models/challenges"
+)
+
+func TestPolicies(t *testing.T) {
+	catalog := mail.Lookup(mail.Fallback).Catalog
+
+	for _, purpose := range []Purpose{EmailVerification, PasswordReset, EmailChange, AccountDeletion, StepUp} {
+		t.Run(string(purpose), func(t *testing.T) {
+			configuration, e := Lookup(purpose)
+			if e != nil {
+				t.Fatalf("Missing Policy: %v", e)
+			}
+
+			if configuration.Lifetime <= 0 || configuration.Attempts < 1 {
+				t.Errorf("Invalid Lifetime or Attempt Limit: %+v", configuration)
+			}
+
+			if configuration.Channel != challenges.Email && configuration.Channel != challenges.SMS {
+				t.Errorf("Unknown Channel: %q", configuration.Channel)
+			}
+
+			if configuration.Template != "" {
+				if _, ok := catalog[configuration.Template+".subject"]; !(ok) {
+					t.Errorf("Template %q Absent from the %s Message Catalog", configuration.Template, mail.Fallback)
+				}
+			}
+
+			if configuration.Anonymous != (purpose == PasswordReset) {
+				t.Errorf("Unexpected Anonymous Policy: %t", configuration.Anonymous)
+			}
+		})
+	}
+
+	if _, e := Lookup("unknown"); !(errors.Is(e, ErrUnknownPurpose)) {
+		t.Errorf("Expected ErrUnknownPurpose, Received: %v", e)
+	}
+}
+
+func TestOverride(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		t.Setenv("CHALLENGE_PASSWORD_RESET_LIFETIME", "45m")
+		t.Setenv("CHALLENGE_PASSWORD_RESET_ATTEMPTS", "7")
+
+		configuration, _ := Lookup(PasswordReset)
+		if configuration.Lifetime != 45*time.Minute || configuration.Attempts != 7 {
+			t.Errorf("Overrides Not Applied: %+v", configuration)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv("CHALLENGE_STEP_UP_LIFETIME", "-1m")
+		t.Setenv("CHALLENGE_STEP_UP_ATTEMPTS", "zero")
+
+		configuration, _ := Lookup(StepUp)
+		if configuration.Lifetime != 10*time.Minute || configuration.Attempts != 3 {
+			t.Errorf("Expected Defaults: %+v", configuration)
+		}
+	})
+}
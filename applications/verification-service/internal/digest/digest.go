@@ -0,0 +1,40 @@
+// Package digest provides keyed hashing of verification codes, such that a database disclosure doesn't reveal usable
+// code(s).
+package digest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"os"
+)
+
+var key []byte
+
+func init() {
+	value := os.Getenv("VERIFICATION_CODE_KEY")
+	if value == "" {
+		slog.Warn("No VERIFICATION_CODE_KEY Environment Variable Set... Defaulting to Development Key")
+
+		value = "Vq0c7gYV3kS1pQ2m8bTn4Zr6Xw9Ld5Hj"
+	}
+
+	key = []byte(value)
+}
+
+// Digest returns the hex-encoded HMAC-SHA256 of the verification code. The email is included in the message so
+// identical short code(s), e.g. 6-digit OTPs, issued to different user(s) produce distinct digest(s).
+func Digest(email string, code string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(email))
+	mac.Write([]byte{0})
+	mac.Write([]byte(code))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Equal reports whether the code matches the stored digest, comparing in constant time.
+func Equal(digest string, email string, code string) bool {
+	return hmac.Equal([]byte(digest), []byte(Digest(email, code)))
+}
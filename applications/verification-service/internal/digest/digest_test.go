@@ -0,0 +1,24 @@
+package digest
+
+import "testing"
+
+func TestDigest(t *testing.T) {
+	const email = "user@example.com"
+
+	value := Digest(email, "123456")
+	if len(value) != 64 {
+		t.Errorf("Unexpected Digest Length: %d", len(value))
+	}
+
+	if !(Equal(value, email, "123456")) {
+		t.Errorf("Expected Matching Code")
+	}
+
+	if Equal(value, email, "123457") {
+		t.Errorf("Unexpected Match for Invalid Code")
+	}
+
+	if Equal(value, "other@example.com", "123456") {
+		t.Errorf("Unexpected Match for Different Email")
+	}
+}
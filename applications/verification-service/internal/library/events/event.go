@@ -0,0 +1,74 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Specification represents the CloudEvents specification version events conform to.
+const Specification = "1.0"
+
+// Event type(s). Consumers should dispatch according to an [Event]'s type and [Event.DataVersion].
+const (
+	Created  = "user.created"              // Created represents a newly registered user; see [UserCreated].
+	Updated  = "user.updated"              // Updated represents a change to a user's attribute(s); see [UserUpdated].
+	Deleted  = "user.deleted"              // Deleted represents a user whose record(s) have been removed across all services; see [UserDeleted].
+	Verified = "user.verified"             // Verified represents a user that has verified their email address; see [UserVerified].
+	Revoked  = "user.verification.revoked" // Revoked represents a user whose verification an administrator revoked; see [UserVerificationRevoked].
+
+	Challenged = "user.challenge.confirmed" // Challenged represents a user that confirmed a purpose-scoped challenge; see [UserChallengeConfirmed].
+)
+
+// Event represents a CloudEvents (v1.0) structured-mode event.
+type Event struct {
+	SpecVersion     string          `json:"specversion"`          // SpecVersion represents the CloudEvents specification version ([Specification]).
+	ID              string          `json:"id"`                   // ID uniquely identifies the event; duplicate(s) share an ID.
+	Source          string          `json:"source"`               // Source represents the producing service (e.g. "/user-service").
+	Type            string          `json:"type"`                 // Type represents the event's type (e.g. [Created]).
+	Subject         string          `json:"subject,omitempty"`    // Subject represents the user the event applies to.
+	Time            time.Time       `json:"time"`                 // Time represents when the occurrence happened.
+	DataContentType string          `json:"datacontenttype"`      // DataContentType represents the payload's media type.
+	DataSchema      string          `json:"dataschema,omitempty"` // DataSchema identifies the payload's schema, including its version.
+	DataVersion     int             `json:"dataversion"`          // DataVersion is an extension attribute representing the payload's schema version.
+	Data            json.RawMessage `json:"data"`                 // Data represents the event's payload.
+}
+
+// Subject returns the message bus subject an event of the given type is published to (e.g. "events.user.created").
+func Subject(kind string) string {
+	return fmt.Sprintf("events.%s", kind)
+}
+
+// source represents the producing service's CloudEvents source attribute.
+func source() string {
+	service := os.Getenv("SERVICE")
+	if service == "" {
+		service = "service"
+	}
+
+	return "/" + service
+}
+
+// New constructs an [Event] of the given type, encoding the payload under the payload's schema version.
+func New(kind string, subject string, payload Payload) (Event, error) {
+	data, e := json.Marshal(payload)
+	if e != nil {
+		return Event{}, fmt.Errorf("unable to encode %s payload: %w", kind, e)
+	}
+
+	return Event{
+		SpecVersion:     Specification,
+		ID:              uuid.NewString(),
+		Source:          source(),
+		Type:            kind,
+		Subject:         subject,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		DataSchema:      fmt.Sprintf("urn:x-ethr:events:%s:%d", kind, payload.Version()),
+		DataVersion:     payload.Version(),
+		Data:            data,
+	}, nil
+}
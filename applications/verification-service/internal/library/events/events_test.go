@@ -0,0 +1,155 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	t.Setenv("SERVICE", "verification-service")
+
+	event, e := New(Created, "1", UserCreated{ID: 1, Email: "test-events@x-ethr.gg", Creation: time.Now()})
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	content, e := json.Marshal(event)
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	var attributes map[string]any
+	if e := json.Unmarshal(content, &attributes); e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	expectations := map[string]any{
+		"specversion":     "1.0",
+		"source":          "/verification-service",
+		"type":            "user.created",
+		"subject":         "1",
+		"datacontenttype": "application/json",
+		"dataschema":      "urn:x-ethr:events:user.created:1",
+		"dataversion":     float64(1),
+	}
+
+	for key, expectation := range expectations {
+		if attributes[key] != expectation {
+			t.Errorf("Attribute (%s): Expected (%v), Received (%v)", key, expectation, attributes[key])
+		}
+	}
+
+	if attributes["id"] == "" {
+		t.Errorf("Expected Event Identifier")
+	}
+
+	var payload UserCreated
+	if e := json.Unmarshal(event.Data, &payload); e != nil || payload.Email != "test-events@x-ethr.gg" {
+		t.Errorf("Unexpected Payload (%s): %v", event.Data, e)
+	}
+
+	if v := Subject(Verified); v != "events.user.verified" {
+		t.Errorf("Expected (events.user.verified), Received (%s)", v)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+
+	buffer := Memory()
+
+	created, _ := New(Created, "1", UserCreated{ID: 1})
+	updated, _ := New(Updated, "1", UserUpdated{ID: 1, Attributes: []string{"avatar"}})
+
+	for _, event := range []Event{created, updated, created} {
+		if e := buffer.Publish(ctx, event); e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+	}
+
+	if v := buffer.Events(); len(v) != 2 {
+		t.Errorf("Expected Duplicate Event to be Discarded, Received (%d) Event(s)", len(v))
+	}
+
+	if v := buffer.Events(Updated); len(v) != 1 || v[0].ID != updated.ID {
+		t.Errorf("Expected a Single (%s) Event, Received (%v)", Updated, v)
+	}
+
+	t.Run("Capacity", func(t *testing.T) {
+		buffer.Reset()
+
+		var first string
+		for index := range capacity + 1 {
+			event, _ := New(Updated, "1", UserUpdated{ID: 1})
+			if index == 0 {
+				first = event.ID
+			}
+
+			buffer.Publish(ctx, event)
+		}
+
+		v := buffer.Events()
+		if len(v) != capacity {
+			t.Fatalf("Expected (%d) Event(s), Received (%d)", capacity, len(v))
+		}
+
+		if v[0].ID == first {
+			t.Errorf("Expected Oldest Event to be Evicted")
+		}
+	})
+}
+
+func TestDefault(t *testing.T) {
+	tests := []struct {
+		name        string
+		backend     string
+		environment string
+		valid       bool
+	}{
+		{"Unset", "", "local", false},
+		{"Memory-Unset-Environment", "memory", "", false},
+		{"Memory-Production", "memory", "production", false},
+		{"Memory-Development", "memory", "development", true},
+		{"Unsupported", "kafka", "local", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("EVENTS_BACKEND", test.backend)
+			t.Setenv("ENVIRONMENT", test.environment)
+
+			instance = nil
+			t.Cleanup(func() { instance = nil })
+
+			publisher, e := Default(context.Background())
+			if valid := e == nil && publisher != nil; valid != test.valid {
+				t.Errorf("Unexpected Result: %v (%v)", publisher, e)
+			}
+		})
+	}
+}
+
+func TestJetStream(t *testing.T) {
+	url := os.Getenv("NATS_URL")
+	if url == "" {
+		t.Skip("NATS_URL Environment Variable Not Set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, e := JetStream(ctx, url, "EVENTS-TEST")
+	if e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+
+	defer stream.Close()
+
+	event, _ := New(Verified, "test-events@x-ethr.gg", UserVerified{Email: "test-events@x-ethr.gg", Verification: time.Now()})
+	if e := stream.Publish(ctx, event); e != nil {
+		t.Fatalf("Unexpected Error: %v", e)
+	}
+}
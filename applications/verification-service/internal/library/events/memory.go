@@ -0,0 +1,74 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// capacity represents the maximum number of event(s) an in-memory publisher retains.
+const capacity = 1024
+
+// Buffer is an in-memory [Publisher] that retains the most recently published event(s). Buffer is intended for local
+// development and unit-testing.
+type Buffer struct {
+	mutex  sync.Mutex
+	events []Event
+	seen   map[string]struct{}
+}
+
+// Memory constructs an empty, in-memory [Buffer].
+func Memory() *Buffer {
+	return &Buffer{seen: make(map[string]struct{})}
+}
+
+func (b *Buffer) Publish(ctx context.Context, event Event) error {
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, duplicate := b.seen[event.ID]; duplicate {
+		return nil
+	}
+
+	if len(b.events) == capacity {
+		delete(b.seen, b.events[0].ID)
+		b.events = b.events[1:]
+	}
+
+	b.seen[event.ID] = struct{}{}
+	b.events = append(b.events, event)
+
+	return nil
+}
+
+// Events returns a copy of the retained event(s), optionally filtered by type, in publication order.
+func (b *Buffer) Events(kinds ...string) []Event {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	v := make([]Event, 0, len(b.events))
+	for _, event := range b.events {
+		if len(kinds) == 0 || slices.Contains(kinds, event.Type) {
+			v = append(v, event)
+		}
+	}
+
+	return v
+}
+
+// Reset removes all retained event(s).
+func (b *Buffer) Reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.events = nil
+	b.seen = make(map[string]struct{})
+}
+
+func (b *Buffer) Close() error {
+	return nil
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"time"
+)
+
+// Payload represents a versioned event payload. Adding optional attribute(s) to a payload is backwards compatible;
+// removing or changing an attribute requires a new payload type with an incremented version.
+type Payload interface {
+	Version() int
+}
+
+// UserCreated represents a [Created] event's payload.
+type UserCreated struct {
+	ID       int64     `json:"id"`       // ID represents the user-service user's identifier.
+	Email    string    `json:"email"`    // Email represents the user's email address.
+	Creation time.Time `json:"creation"` // Creation represents when the user registered.
+}
+
+func (UserCreated) Version() int { return 1 }
+
+// UserUpdated represents an [Updated] event's payload.
+type UserUpdated struct {
+	ID         int64    `json:"id"`         // ID represents the user-service user's identifier.
+	Attributes []string `json:"attributes"` // Attributes represents the name(s) of the changed attribute(s) (e.g. "avatar").
+}
+
+func (UserUpdated) Version() int { return 1 }
+
+// UserDeleted represents a [Deleted] event's payload.
+type UserDeleted struct {
+	ID       int64  `json:"id"`       // ID represents the user-service user's identifier.
+	Type     string `json:"type"`     // Type represents the deletion's type: either "soft" or "hard".
+	Deletion int64  `json:"deletion"` // Deletion represents the completed deletion saga's identifier.
+}
+
+func (UserDeleted) Version() int { return 1 }
+
+// UserVerified represents a [Verified] event's payload.
+type UserVerified struct {
+	Email        string    `json:"email"`        // Email represents the verified email address.
+	Verification time.Time `json:"verification"` // Verification represents when the email address was verified.
+}
+
+func (UserVerified) Version() int { return 1 }
+
+// UserVerificationRevoked represents a [Revoked] event's payload.
+type UserVerificationRevoked struct {
+	Email      string    `json:"email"`            // Email represents the email address whose verification was revoked.
+	Revocation time.Time `json:"revocation"`       // Revocation represents when the verification was revoked.
+	Reason     string    `json:"reason,omitempty"` // Reason represents the administrator-supplied reason, if any.
+}
+
+func (UserVerificationRevoked) Version() int { return 1 }
+
+// UserChallengeConfirmed represents a [Challenged] event's payload. Consumers act upon the purpose(s) they own -- e.g.
+// the authentication-service completes a "password-reset".
+type UserChallengeConfirmed struct {
+	ID           string    `json:"id"`           // ID represents the confirmed challenge's identifier.
+	Email        string    `json:"email"`        // Email represents the email address of the user the challenge was issued to.
+	Purpose      string    `json:"purpose"`      // Purpose represents the action the challenge authorized (e.g. "password-reset").
+	Destination  string    `json:"destination"`  // Destination represents where the code was delivered; for an "email-change", the new address.
+	Confirmation time.Time `json:"confirmation"` // Confirmation represents when the challenge was confirmed.
+}
+
+func (UserChallengeConfirmed) Version() int { return 1 }
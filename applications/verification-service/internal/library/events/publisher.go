@@ -0,0 +1,109 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Publisher represents a message bus that [Event](s) are published to.
+type Publisher interface {
+	// Publish delivers the event to the message bus. Implementations must treat [Event.ID] as an idempotency key.
+	Publish(ctx context.Context, event Event) error
+
+	// Close releases the publisher's resource(s).
+	Close() error
+}
+
+var (
+	mutex    sync.Mutex
+	instance Publisher
+)
+
+// Default returns the runtime's [Publisher] implementation, established according to environment variable(s):
+//
+//   - EVENTS_BACKEND: either "memory" or "jetstream" (required). The memory backend is only available when ENVIRONMENT
+//     is "local" or "development", as its event(s) never leave the process.
+//   - NATS_URL: the jetstream backend's server url(s). Defaults to "nats://nats:4222".
+//   - EVENTS_STREAM: the jetstream backend's stream name. Defaults to "EVENTS".
+func Default(ctx context.Context) (Publisher, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if instance != nil {
+		return instance, nil
+	}
+
+	backend := strings.ToLower(strings.TrimSpace(os.Getenv("EVENTS_BACKEND")))
+	if backend == "" {
+		return nil, fmt.Errorf("events: EVENTS_BACKEND environment variable is required")
+	}
+
+	slog.InfoContext(ctx, "Establishing Event Publisher", slog.String("backend", backend))
+
+	switch backend {
+	case "memory":
+		if !(development()) {
+			return nil, fmt.Errorf("events: the memory backend is unavailable outside of development")
+		}
+
+		instance = Memory()
+	case "jetstream":
+		url := os.Getenv("NATS_URL")
+		if url == "" {
+			url = "nats://nats:4222"
+		}
+
+		stream := os.Getenv("EVENTS_STREAM")
+		if stream == "" {
+			stream = "EVENTS"
+		}
+
+		v, e := JetStream(ctx, url, stream)
+		if e != nil {
+			return nil, e
+		}
+
+		instance = v
+	default:
+		return nil, fmt.Errorf("events: unsupported EVENTS_BACKEND (%s)", backend)
+	}
+
+	return instance, nil
+}
+
+// development reports whether the ENVIRONMENT environment variable is explicitly set to "local" or "development".
+func development() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv("ENVIRONMENT"))) {
+	case "local", "development":
+		return true
+	default:
+		return false
+	}
+}
+
+// Emit constructs and publishes an [Event] using the [Default] publisher. Emit is intended to be called after the
+// originating transaction commits; failures are logged rather than returned, as the occurrence has already happened.
+func Emit(ctx context.Context, kind string, subject string, payload Payload) {
+	event, e := New(kind, subject, payload)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Construct Event", slog.String("type", kind), slog.String("error", e.Error()))
+		return
+	}
+
+	publisher, e := Default(ctx)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Establish Event Publisher", slog.String("type", kind), slog.String("error", e.Error()))
+		return
+	}
+
+	if e := publisher.Publish(ctx, event); e != nil {
+		slog.ErrorContext(ctx, "Unable to Publish Event", slog.String("type", kind), slog.String("id", event.ID), slog.String("error", e.Error()))
+		return
+	}
+
+	slog.DebugContext(ctx, "Published Event", slog.String("type", kind), slog.String("id", event.ID), slog.String("subject", subject))
+}
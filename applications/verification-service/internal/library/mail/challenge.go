@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ChallengeType represents the "Type" tag of challenge email(s); the "Purpose" tag distinguishes their purpose.
+const ChallengeType = "User-Challenge"
+
+// Challenge renders the recipient's challenge email -- a code, without a link -- in the context's locale (see
+// [WithLocale]). The template is the purpose's message catalog scope (e.g. "password-reset"), whose "subject",
+// "title", "heading", "welcome", "instructions.html", "instructions.text", "disregard" and "closing" key(s) replace
+// the verification email's. The validity is rendered via [Span]. A suppressed recipient returns [ErrSuppressed].
+func Challenge(ctx context.Context, recipient string, template string, code string, validity time.Duration) (*Message, error) {
+	settings, e := Environment()
+	if e != nil {
+		slog.ErrorContext(ctx, "Invalid Mail Transport Configuration", slog.String("error", e.Error()))
+		return nil, e
+	}
+
+	scope := template + "."
+
+	subject, e := Lookup(Locale(ctx)).Catalog.Translate(scope + "subject")
+	if e != nil {
+		return nil, e
+	}
+
+	expiration, duration := Span(validity)
+
+	metadata := Metadata{Expiration: expiration, Duration: duration, Code: code, Scope: scope}
+
+	return render(ctx, settings, recipient, subject, metadata, map[string]string{"Type": ChallengeType, "Purpose": template})
+}
+
+// Span expresses the duration in the largest of "days", "hours" or "minutes" that represents it without loss of
+// precision beyond a minute -- e.g. 48h yields (2, "days"), 24h yields (24, "hours"), and 90m yields (90, "minutes").
+// Single unit(s) are avoided, such that the catalog's plural "duration.*" key(s) read naturally.
+func Span(duration time.Duration) (int, string) {
+	const day = 24 * time.Hour
+
+	switch {
+	case duration >= 2*day && duration%day == 0:
+		return int(duration / day), "days"
+	case duration >= 2*time.Hour && duration%time.Hour == 0:
+		return int(duration / time.Hour), "hours"
+	default:
+		return int((duration + time.Minute - 1) / time.Minute), "minutes"
+	}
+}
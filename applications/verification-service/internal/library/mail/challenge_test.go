@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSpan(t *testing.T) {
+	tests := []struct {
+		duration   time.Duration
+		expiration int
+		unit       string
+	}{
+		{24 * time.Hour, 24, "hours"},
+		{72 * time.Hour, 3, "days"},
+		{36 * time.Hour, 36, "hours"},
+		{2 * time.Hour, 2, "hours"},
+		{time.Hour, 60, "minutes"},
+		{90 * time.Minute, 90, "minutes"},
+		{5 * time.Minute, 5, "minutes"},
+		{90 * time.Second, 2, "minutes"},
+	}
+
+	for _, test := range tests {
+		if expiration, unit := Span(test.duration); expiration != test.expiration || unit != test.unit {
+			t.Errorf("Span(%s) = (%d, %q), Expected (%d, %q)", test.duration, expiration, unit, test.expiration, test.unit)
+		}
+	}
+}
+
+func TestChallenge(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+	t.Setenv("MAIL_TRANSPORT", "memory")
+	t.Setenv("MAIL_SUBJECT", "Ignored - Challenge Subject(s) Are Always Localized")
+
+	t.Run("Localized", func(t *testing.T) {
+		ctx := WithLocale(context.Background(), "fr")
+
+		message, e := Challenge(ctx, "utilisateur@example.com", "password-reset", "654321", 30*time.Minute)
+		if e != nil {
+			t.Fatalf("Unable to Compose Challenge: %v", e)
+		}
+
+		catalog := Lookup("fr").Catalog
+
+		if message.Subject != catalog["password-reset.subject"] {
+			t.Errorf("Unexpected Subject: %q", message.Subject)
+		}
+
+		if message.Tags["Type"] != ChallengeType || message.Tags["Purpose"] != "password-reset" || message.Tags["Timestamp"] == "" {
+			t.Errorf("Unexpected Tag(s): %v", message.Tags)
+		}
+
+		for name, rendered := range map[string]string{"html": message.HTML, "text": message.Text} {
+			if !(strings.Contains(rendered, "654321")) || !(strings.Contains(rendered, "30 "+catalog["duration.minutes"])) {
+				t.Errorf("Rendered %s Body Missing Code or Expiration", name)
+			}
+		}
+	})
+
+	t.Run("Unknown-Template", func(t *testing.T) {
+		if _, e := Challenge(context.Background(), "user@example.com", "unknown", "654321", time.Minute); e == nil {
+			t.Errorf("Expected Error for an Unknown Template")
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package mail
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Fallback represents the locale used when no requested locale is supported. Its catalog must define every key.
+const Fallback = "en"
+
+//go:embed locales
+var locales embed.FS
+
+// Catalog represents a locale's translatable string(s), keyed by message identifier. Values are [fmt] format strings.
+type Catalog map[string]string
+
+// Translate formats the catalog's message for the key. An error is returned for an unknown key, failing template
+// execution rather than rendering an incomplete email.
+func (c Catalog) Translate(key string, arguments ...interface{}) (string, error) {
+	format, ok := c[key]
+	if !(ok) {
+		return "", fmt.Errorf("missing message catalog key: %q", key)
+	}
+
+	if len(arguments) == 0 {
+		return format, nil
+	}
+
+	return fmt.Sprintf(format, arguments...), nil
+}
+
+// chain returns the locale's fallback chain, most specific first -- e.g. "es-MX" yields ["es-MX", "es"]. The
+// [Fallback] locale isn't included.
+func chain(locale string) []string {
+	var candidates []string
+
+	subtags := strings.Split(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+	for index := len(subtags); index > 0; index-- {
+		if candidate := canonical(strings.Join(subtags[:index], "-")); candidate != "" {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+// canonical returns the supported locale matching the tag case-insensitively, or an empty string.
+func canonical(tag string) string {
+	for _, locale := range Locales() {
+		if strings.EqualFold(locale, tag) {
+			return locale
+		}
+	}
+
+	return ""
+}
+
+// Locales returns the sorted, supported locale(s) -- one per embedded locales/ directory.
+func Locales() []string {
+	entries, _ := fs.ReadDir(locales, "locales")
+
+	values := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			values = append(values, entry.Name())
+		}
+	}
+
+	sort.Strings(values)
+
+	return values
+}
+
+// load reads every locale's catalog, merging each over its fallback chain's catalog(s) -- e.g. "es-MX" inherits
+// from "es", which inherits from [Fallback].
+func load() (map[string]Catalog, error) {
+	raw := map[string]Catalog{}
+
+	for _, locale := range Locales() {
+		content, e := locales.ReadFile(path.Join("locales", locale, "catalog.json"))
+		if e != nil {
+			return nil, fmt.Errorf("unable to read %s message catalog: %w", locale, e)
+		}
+
+		var catalog Catalog
+		if e := json.Unmarshal(content, &catalog); e != nil {
+			return nil, fmt.Errorf("unable to parse %s message catalog: %w", locale, e)
+		}
+
+		raw[locale] = catalog
+	}
+
+	if _, ok := raw[Fallback]; !(ok) {
+		return nil, fmt.Errorf("missing fallback (%s) message catalog", Fallback)
+	}
+
+	catalogs := map[string]Catalog{}
+
+	for locale := range raw {
+		effective := Catalog{}
+
+		// --> least specific first, so more specific locale(s) override
+		layers := append([]string{Fallback}, reverse(chain(locale))...)
+		for _, layer := range layers {
+			for key, value := range raw[layer] {
+				effective[key] = value
+			}
+		}
+
+		catalogs[locale] = effective
+	}
+
+	return catalogs, nil
+}
+
+func reverse(values []string) []string {
+	reversed := make([]string, 0, len(values))
+	for index := len(values) - 1; index >= 0; index-- {
+		reversed = append(reversed, values[index])
+	}
+
+	return reversed
+}
+
+// Resolve returns the supported locale for the user's preferred locale, if any, and otherwise the request's
+// Accept-Language header -- walking each candidate's fallback chain (e.g. "es-MX" → "es") before the next candidate,
+// and finally [Fallback].
+func Resolve(preferred string, accept string) string {
+	var candidates []string
+
+	if preferred = strings.TrimSpace(preferred); preferred != "" {
+		candidates = append(candidates, preferred)
+	}
+
+	if tags, _, e := language.ParseAcceptLanguage(accept); e == nil {
+		for _, tag := range tags {
+			candidates = append(candidates, tag.String())
+		}
+	}
+
+	for _, candidate := range candidates {
+		if values := chain(candidate); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return Fallback
+}
+
+// key represents the context key for the resolved locale.
+type key struct{}
+
+// WithLocale returns a copy of the context carrying the locale used to render email(s).
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, key{}, locale)
+}
+
+// Locale returns the context's locale, or [Fallback] if unset.
+func Locale(ctx context.Context) string {
+	if locale, ok := ctx.Value(key{}).(string); ok && locale != "" {
+		return locale
+	}
+
+	return Fallback
+}
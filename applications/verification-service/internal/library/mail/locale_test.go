@@ -0,0 +1,155 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLocales(t *testing.T) {
+	locales := Locales()
+	if len(locales) < 2 {
+		t.Fatalf("Unexpected Supported Locale(s): %v", locales)
+	}
+
+	reference := sets[Fallback].Catalog
+
+	for _, locale := range locales {
+		t.Run(locale, func(t *testing.T) {
+			set := Lookup(locale)
+			if set.Locale != locale {
+				t.Fatalf("Unexpected Set Locale: %s", set.Locale)
+			}
+
+			for key := range set.Catalog {
+				if _, ok := reference[key]; !(ok) {
+					t.Errorf("Unknown Message Catalog Key (Absent from %s): %q", Fallback, key)
+				}
+			}
+
+			for _, duration := range []string{"minutes", "hours", "days"} {
+				metadata := Metadata{Expiration: 24, Duration: duration, URL: "https://testing.ethr.gg/verify/token", Code: "A1B2C3"}
+
+				var html, text bytes.Buffer
+				if e := set.HTML.Execute(&html, metadata); e != nil {
+					t.Fatalf("Unable to Render HTML Template (%s): %v", duration, e)
+				}
+
+				if e := set.Text.Execute(&text, metadata); e != nil {
+					t.Fatalf("Unable to Render Text Template (%s): %v", duration, e)
+				}
+
+				for name, rendered := range map[string]string{"html": html.String(), "text": text.String()} {
+					if !(strings.Contains(rendered, metadata.URL)) {
+						t.Errorf("Rendered %s Template Missing URL", name)
+					}
+
+					if !(strings.Contains(rendered, metadata.Code)) {
+						t.Errorf("Rendered %s Template Missing Code", name)
+					}
+
+					if !(strings.Contains(rendered, "24 "+set.Catalog["duration."+duration])) {
+						t.Errorf("Rendered %s Template Missing Localized Expiration", name)
+					}
+
+					if strings.Contains(rendered, "%!") {
+						t.Errorf("Rendered %s Template Contains Formatting Error(s)", name)
+					}
+				}
+
+				if !(strings.Contains(html.String(), `lang="`+locale+`"`)) {
+					t.Errorf("Rendered HTML Template Missing Language Attribute")
+				}
+			}
+
+			for _, scope := range []string{"password-reset.", "email-change.", "account-deletion.", "step-up."} {
+				metadata := Metadata{Expiration: 15, Duration: "minutes", Code: "123456", Scope: scope}
+
+				var html, text bytes.Buffer
+				if e := set.HTML.Execute(&html, metadata); e != nil {
+					t.Fatalf("Unable to Render HTML Template (%s): %v", scope, e)
+				}
+
+				if e := set.Text.Execute(&text, metadata); e != nil {
+					t.Fatalf("Unable to Render Text Template (%s): %v", scope, e)
+				}
+
+				if !(strings.Contains(text.String(), set.Catalog[scope+"instructions.text"])) {
+					t.Errorf("Rendered Text Template (%s) Missing Scoped Instructions", scope)
+				}
+
+				for name, rendered := range map[string]string{"html": html.String(), "text": text.String()} {
+					if !(strings.Contains(rendered, metadata.Code)) {
+						t.Errorf("Rendered %s Template (%s) Missing Code", name, scope)
+					}
+
+					if strings.Contains(rendered, "href=\"\"") || strings.Contains(rendered, set.Catalog["action"]) {
+						t.Errorf("Rendered %s Template (%s) Contains a Verification Link", name, scope)
+					}
+
+					if strings.Contains(rendered, "%!") {
+						t.Errorf("Rendered %s Template (%s) Contains Formatting Error(s)", name, scope)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		preferred string
+		accept    string
+		expected  string
+	}{
+		{"Default", "", "", Fallback},
+		{"Preferred", "fr", "es", "fr"},
+		{"Preferred-Fallback-Chain", "es-AR", "", "es"},
+		{"Preferred-Case-Insensitive", "es_mx", "", "es-MX"},
+		{"Accept-Language", "", "es-MX,es;q=0.9,en;q=0.8", "es-MX"},
+		{"Accept-Language-Fallback-Chain", "", "es-CO,en;q=0.5", "es"},
+		{"Accept-Language-Quality", "", "de;q=0.9,fr;q=0.8", "fr"},
+		{"Unsupported", "de", "ja,zh;q=0.8", Fallback},
+		{"Invalid-Header", "", ";;;", Fallback},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if locale := Resolve(test.preferred, test.accept); locale != test.expected {
+				t.Errorf("Unexpected Locale: %s, Expected: %s", locale, test.expected)
+			}
+		})
+	}
+}
+
+func TestCatalogInheritance(t *testing.T) {
+	mexican, spanish := Lookup("es-MX").Catalog, Lookup("es").Catalog
+
+	if mexican["subject"] != spanish["subject"] {
+		t.Errorf("Expected es-MX to Inherit es Subject")
+	}
+
+	if mexican["expiration"] == spanish["expiration"] {
+		t.Errorf("Expected es-MX to Override es Expiration")
+	}
+}
+
+func TestLocalizedVerification(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+	t.Setenv("MAIL_TRANSPORT", "memory")
+	t.Setenv("MAIL_SUBJECT", "")
+
+	Box.Clear()
+
+	ctx := WithLocale(context.Background(), Resolve("", "es-MX,es;q=0.9"))
+	if e := Verification(ctx, "usuario@example.com", "123456", "https://testing.ethr.gg/verify/token"); e != nil {
+		t.Fatalf("Unable to Send Verification: %v", e)
+	}
+
+	messages := Box.List("usuario@example.com")
+	if len(messages) != 1 || messages[0].Locale != "es-MX" || messages[0].Subject != Lookup("es-MX").Catalog["subject"] {
+		t.Errorf("Unexpected Localized Message(s): %+v", messages)
+	}
+}
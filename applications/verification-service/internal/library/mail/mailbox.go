@@ -0,0 +1,129 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// capacity represents the maximum number of message(s) retained by the [Mailbox]; the oldest are evicted first.
+const capacity = 250
+
+// Envelope represents a [Message] recorded by the development [Mailbox].
+type Envelope struct {
+	ID        string            `json:"id"`
+	Transport Kind              `json:"transport"`
+	Timestamp time.Time         `json:"timestamp"`
+	Sender    string            `json:"sender"`
+	Recipient string            `json:"recipient"`
+	Subject   string            `json:"subject"`
+	Locale    string            `json:"locale,omitempty"`
+	HTML      string            `json:"html,omitempty"`
+	Text      string            `json:"text,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+// Summary returns the envelope without its rendered bodies.
+func (e Envelope) Summary() Envelope {
+	e.HTML, e.Text = "", ""
+
+	return e
+}
+
+// Mailbox is an in-process, bounded mail catcher recording every message sent in non-production environment(s).
+type Mailbox struct {
+	mutex    sync.RWMutex
+	messages []Envelope
+}
+
+// Record stores the message, evicting the oldest once [capacity] is reached.
+func (m *Mailbox) Record(transport Kind, message *Message) Envelope {
+	envelope := Envelope{
+		ID:        uuid.NewString(),
+		Transport: transport,
+		Timestamp: time.Now().UTC(),
+		Sender:    message.Sender,
+		Recipient: message.Recipient,
+		Subject:   message.Subject,
+		Locale:    message.Locale,
+		HTML:      message.HTML,
+		Text:      message.Text,
+		Tags:      message.Tags,
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(m.messages) >= capacity {
+		m.messages = slices.Delete(m.messages, 0, len(m.messages)-capacity+1)
+	}
+
+	m.messages = append(m.messages, envelope)
+
+	return envelope
+}
+
+// List returns the recorded message(s), newest first, optionally filtered by a case-insensitive recipient.
+func (m *Mailbox) List(recipient string) []Envelope {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	messages := make([]Envelope, 0, len(m.messages))
+	for index := len(m.messages) - 1; index >= 0; index-- {
+		if recipient == "" || strings.EqualFold(m.messages[index].Recipient, recipient) {
+			messages = append(messages, m.messages[index])
+		}
+	}
+
+	return messages
+}
+
+// Get returns the recorded message matching the identifier.
+func (m *Mailbox) Get(id string) (Envelope, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, envelope := range m.messages {
+		if envelope.ID == id {
+			return envelope, true
+		}
+	}
+
+	return Envelope{}, false
+}
+
+// Clear removes all recorded message(s).
+func (m *Mailbox) Clear() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.messages = nil
+}
+
+// Box is the process-wide development [Mailbox].
+var Box = &Mailbox{}
+
+// Development reports whether the development [Mailbox] is enabled -- i.e. the ENVIRONMENT environment variable is
+// explicitly set to "local" or "development". An unset or unrecognized environment is treated as production.
+func Development() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv("ENVIRONMENT"))) {
+	case "local", "development":
+		return true
+	default:
+		return false
+	}
+}
+
+// memory is a [Transport] that only records message(s) to the development [Mailbox] -- no external delivery occurs.
+type memory struct{}
+
+func (memory) Send(ctx context.Context, message *Message) (string, error) {
+	_, id, e := message.Bytes()
+
+	return id, e
+}
@@ -0,0 +1,99 @@
+package mail
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMailbox(t *testing.T) {
+	box := &Mailbox{}
+
+	first := box.Record(SES, &Message{Recipient: "first@example.com", Text: "first"})
+	box.Record(SES, &Message{Recipient: "second@example.com", Text: "second"})
+
+	if messages := box.List(""); len(messages) != 2 || messages[0].Recipient != "second@example.com" {
+		t.Errorf("Unexpected Message(s) Order: %+v", messages)
+	}
+
+	if messages := box.List("FIRST@example.com"); len(messages) != 1 || messages[0].ID != first.ID {
+		t.Errorf("Unexpected Filtered Message(s): %+v", messages)
+	}
+
+	if envelope, ok := box.Get(first.ID); !(ok) || envelope.Text != "first" {
+		t.Errorf("Unexpected Envelope: %+v", envelope)
+	}
+
+	box.Clear()
+
+	if messages := box.List(""); len(messages) != 0 {
+		t.Errorf("Unexpected Message(s) After Clear: %+v", messages)
+	}
+
+	for range capacity + 10 {
+		box.Record(SES, &Message{})
+	}
+
+	if messages := box.List(""); len(messages) != capacity {
+		t.Errorf("Unexpected Retained Message Count: %d", len(messages))
+	}
+}
+
+func TestDevelopment(t *testing.T) {
+	for _, environment := range []string{"", "staging", "production", "prod"} {
+		t.Setenv("ENVIRONMENT", environment)
+		if Development() {
+			t.Errorf("Expected Development Mailbox to be Disabled for Environment %q", environment)
+		}
+	}
+
+	if _, e := New(Settings{Transport: Memory}); e != nil {
+		t.Errorf("Unexpected Error Constructing Memory Transport: %v", e)
+	}
+
+	t.Setenv("MAIL_TRANSPORT", "memory")
+	if _, e := Environment(); e == nil {
+		t.Errorf("Expected Error for Memory Transport Outside of Development")
+	}
+
+	for _, environment := range []string{"local", "Development"} {
+		t.Setenv("ENVIRONMENT", environment)
+		if !(Development()) {
+			t.Errorf("Expected Development Mailbox to be Enabled for Environment %q", environment)
+		}
+	}
+}
+
+func TestDeliverUnrecorded(t *testing.T) {
+	t.Setenv("MAIL_TRANSPORT", string(Filesystem))
+	t.Setenv("MAIL_DIRECTORY", t.TempDir())
+
+	for _, environment := range []string{"", "staging"} {
+		t.Setenv("ENVIRONMENT", environment)
+
+		Box.Clear()
+
+		if e := Verification(context.Background(), "user@example.com", "123456", "https://testing.ethr.gg/verify/token"); e != nil {
+			t.Fatalf("Unable to Send Verification: %v", e)
+		}
+
+		if messages := Box.List(""); len(messages) != 0 {
+			t.Errorf("Unexpected Recorded Message(s) for Environment %q: %+v", environment, messages)
+		}
+	}
+}
+
+func TestVerificationRecords(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+	t.Setenv("MAIL_TRANSPORT", "memory")
+
+	Box.Clear()
+
+	if e := Verification(context.Background(), "user@example.com", "123456", "https://testing.ethr.gg/verify/token"); e != nil {
+		t.Fatalf("Unable to Send Verification: %v", e)
+	}
+
+	messages := Box.List("user@example.com")
+	if len(messages) != 1 || messages[0].Transport != Memory || messages[0].Text == "" || messages[0].HTML == "" {
+		t.Errorf("Unexpected Recorded Message(s): %+v", messages)
+	}
+}
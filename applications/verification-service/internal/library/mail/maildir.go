@@ -0,0 +1,53 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// maildir is a [Transport] writing message(s) to a local Maildir for development and CI inspection. Messages are
+// written to tmp/ and atomically renamed into new/, per the Maildir specification.
+type maildir struct {
+	directory string
+}
+
+func (m *maildir) Send(ctx context.Context, message *Message) (string, error) {
+	document, id, e := message.Bytes()
+	if e != nil {
+		return "", fmt.Errorf("unable to render message: %w", e)
+	}
+
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if e := os.MkdirAll(filepath.Join(m.directory, sub), 0o700); e != nil {
+			return "", fmt.Errorf("unable to create maildir: %w", e)
+		}
+	}
+
+	buffer := make([]byte, 8)
+	_, _ = rand.Read(buffer)
+
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "localhost"
+	}
+
+	name := fmt.Sprintf("%s.%s_%d.%s", strconv.FormatInt(time.Now().UnixNano(), 10), hex.EncodeToString(buffer), os.Getpid(), hostname)
+
+	temporary := filepath.Join(m.directory, "tmp", name)
+	if e := os.WriteFile(temporary, document, 0o600); e != nil {
+		return "", fmt.Errorf("unable to write message: %w", e)
+	}
+
+	if e := os.Rename(temporary, filepath.Join(m.directory, "new", name)); e != nil {
+		os.Remove(temporary)
+		return "", fmt.Errorf("unable to deliver message: %w", e)
+	}
+
+	return id, nil
+}
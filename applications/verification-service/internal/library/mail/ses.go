@@ -0,0 +1,89 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+	"github.com/aws/smithy-go"
+
+	"verification-service/internal/library/mail/internal/configuration"
+)
+
+// amazon is a [Transport] delivering via AWS SES.
+type amazon struct {
+	region string
+}
+
+func (a *amazon) Send(ctx context.Context, message *Message) (string, error) {
+	settings := configuration.Region(ctx, a.region)
+
+	keys := make([]string, 0, len(message.Tags))
+	for key := range message.Tags {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	tags := make([]types.MessageTag, 0, len(keys))
+	for _, key := range keys {
+		tags = append(tags, types.MessageTag{Name: aws.String(key), Value: aws.String(message.Tags[key])})
+	}
+
+	var set *string
+	if message.Set != "" {
+		set = aws.String(message.Set)
+	}
+
+	input := &ses.SendEmailInput{
+		Source: aws.String(message.Sender),
+		Destination: &types.Destination{
+			ToAddresses: []string{message.Recipient},
+		},
+		ReplyToAddresses:     []string{},
+		ReturnPath:           nil,
+		ConfigurationSetName: set,
+		Message: &types.Message{
+			Body: &types.Body{
+				Html: &types.Content{
+					Charset: aws.String("UTF-8"),
+					Data:    aws.String(message.HTML),
+				},
+				Text: &types.Content{
+					Charset: aws.String("UTF-8"),
+					Data:    aws.String(message.Text),
+				},
+			},
+			Subject: &types.Content{
+				Charset: aws.String("UTF-8"),
+				Data:    aws.String(message.Subject),
+			},
+		},
+		Tags: tags,
+	}
+
+	client := ses.NewFromConfig(settings)
+
+	result, e := client.SendEmail(ctx, input)
+	if e != nil {
+		var ae smithy.APIError
+		var oe *smithy.OperationError
+
+		switch {
+		case errors.As(e, &ae):
+			slog.ErrorContext(ctx, "Failed Submitting Email via SES (AE)", slog.String("code", ae.ErrorCode()), slog.Any("fault", ae.ErrorFault()), slog.String("message", ae.ErrorMessage()), slog.String("error", ae.Error()))
+		case errors.As(e, &oe):
+			slog.ErrorContext(ctx, "Failed Submitting Email via SES (OE)", slog.String("operation", oe.Operation()), slog.String("service", oe.Service()), slog.String("error", oe.Error()), slog.Any("unwrap", oe.Unwrap()))
+		default:
+			slog.ErrorContext(ctx, "Failed Submitting Email via SES (Unknown)", slog.String("error", e.Error()))
+		}
+
+		return "", e
+	}
+
+	return aws.ToString(result.MessageId), nil
+}
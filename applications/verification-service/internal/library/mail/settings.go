@@ -0,0 +1,148 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Kind represents a [Transport] implementation.
+type Kind string
+
+const (
+	SES        Kind = "ses"
+	SMTP       Kind = "smtp"
+	Filesystem Kind = "filesystem"
+	Memory     Kind = "memory" // Memory only records message(s) to the development [Mailbox]; unavailable outside of development.
+)
+
+// Security represents an SMTP connection's transport security.
+type Security string
+
+const (
+	STARTTLS Security = "starttls" // STARTTLS upgrades a plaintext connection; required if selected.
+	TLS      Security = "tls"      // TLS represents implicit TLS, typically on port 465.
+	None     Security = "none"     // None disables transport security -- only suitable for local relays.
+)
+
+// Settings represents the mail package's environment-derived configuration.
+type Settings struct {
+	Transport Kind   // MAIL_TRANSPORT; defaults to [SES].
+	Sender    string // MAIL_SENDER
+	Subject   string // MAIL_SUBJECT; overrides the locale's catalog subject if set.
+	Set       string // MAIL_CONFIGURATION_SET; the SES configuration set.
+	Region    string // MAIL_REGION; the SES region.
+
+	Host     string   // SMTP_HOST
+	Port     int      // SMTP_PORT; defaults to 587.
+	Username string   // SMTP_USERNAME; authentication is skipped if empty.
+	Password string   // SMTP_PASSWORD
+	Security Security // SMTP_SECURITY; defaults to [STARTTLS].
+
+	Domain   string // DKIM_DOMAIN; DKIM signing is skipped if empty.
+	Selector string // DKIM_SELECTOR
+	Key      string // DKIM_PRIVATE_KEY; a PEM-encoded RSA or Ed25519 private key, or DKIM_PRIVATE_KEY_FILE's contents.
+
+	Directory string // MAIL_DIRECTORY; the maildir root for the [Filesystem] transport.
+}
+
+func environment(key, fallback string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// Environment returns the mail [Settings] derived from environment variable(s), validating the selected transport's
+// required value(s).
+func Environment() (Settings, error) {
+	settings := Settings{
+		Transport: Kind(strings.ToLower(environment("MAIL_TRANSPORT", string(SES)))),
+		Sender:    environment("MAIL_SENDER", "no-reply@polygun.com"),
+		Subject:   environment("MAIL_SUBJECT", ""),
+		Set:       environment("MAIL_CONFIGURATION_SET", "polygun-email-verification-configuration-set"),
+		Region:    environment("MAIL_REGION", "us-east-2"),
+
+		Host:     environment("SMTP_HOST", ""),
+		Username: environment("SMTP_USERNAME", ""),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		Security: Security(strings.ToLower(environment("SMTP_SECURITY", string(STARTTLS)))),
+
+		Domain:   environment("DKIM_DOMAIN", ""),
+		Selector: environment("DKIM_SELECTOR", ""),
+		Key:      os.Getenv("DKIM_PRIVATE_KEY"),
+
+		Directory: environment("MAIL_DIRECTORY", filepath.Join(os.TempDir(), "verification-service", "mail")),
+	}
+
+	port, e := strconv.Atoi(environment("SMTP_PORT", "587"))
+	if e != nil || port <= 0 || port > 65535 {
+		return settings, fmt.Errorf("invalid SMTP_PORT: %q", os.Getenv("SMTP_PORT"))
+	}
+
+	settings.Port = port
+
+	if path := environment("DKIM_PRIVATE_KEY_FILE", ""); path != "" && settings.Key == "" {
+		content, e := os.ReadFile(path)
+		if e != nil {
+			return settings, fmt.Errorf("unable to read DKIM_PRIVATE_KEY_FILE: %w", e)
+		}
+
+		settings.Key = string(content)
+	}
+
+	switch settings.Transport {
+	case SES, Filesystem:
+	case Memory:
+		if !(Development()) {
+			return settings, fmt.Errorf("the %s transport is unavailable outside of development", Memory)
+		}
+	case SMTP:
+		if settings.Host == "" {
+			return settings, fmt.Errorf("SMTP_HOST is required for the %s transport", SMTP)
+		}
+
+		switch settings.Security {
+		case STARTTLS, TLS, None:
+		default:
+			return settings, fmt.Errorf("invalid SMTP_SECURITY: %q", settings.Security)
+		}
+
+		if settings.Domain != "" && (settings.Selector == "" || settings.Key == "") {
+			return settings, fmt.Errorf("DKIM_SELECTOR and DKIM_PRIVATE_KEY are required when DKIM_DOMAIN is set")
+		}
+	default:
+		return settings, fmt.Errorf("invalid MAIL_TRANSPORT: %q", settings.Transport)
+	}
+
+	return settings, nil
+}
+
+// New returns the [Transport] selected by the settings.
+func New(settings Settings) (Transport, error) {
+	switch settings.Transport {
+	case SES:
+		return &amazon{region: settings.Region}, nil
+	case SMTP:
+		var dkim *signer
+		if settings.Domain != "" {
+			value, e := signing(settings.Domain, settings.Selector, settings.Key)
+			if e != nil {
+				return nil, e
+			}
+
+			dkim = value
+		}
+
+		return &relay{host: settings.Host, port: settings.Port, username: settings.Username, password: settings.Password, security: settings.Security, signer: dkim}, nil
+	case Filesystem:
+		return &maildir{directory: settings.Directory}, nil
+	case Memory:
+		return memory{}, nil
+	default:
+		return nil, fmt.Errorf("invalid mail transport: %q", settings.Transport)
+	}
+}
@@ -0,0 +1,169 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"github.com/emersion/go-msgauth/dkim"
+)
+
+// signer represents DKIM signing option(s).
+type signer struct {
+	options *dkim.SignOptions
+}
+
+// signing parses the PEM-encoded private key into DKIM signing option(s) for the domain and selector.
+func signing(domain, selector, key string) (*signer, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("invalid DKIM private key: no PEM block found")
+	}
+
+	var value crypto.Signer
+
+	switch parsed, e := x509.ParsePKCS8PrivateKey(block.Bytes); {
+	case e == nil:
+		instance, ok := parsed.(crypto.Signer)
+		if !(ok) {
+			return nil, errors.New("invalid DKIM private key: unsupported key type")
+		}
+
+		value = instance
+	default:
+		instance, e := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if e != nil {
+			return nil, fmt.Errorf("invalid DKIM private key: %w", e)
+		}
+
+		value = instance
+	}
+
+	return &signer{options: &dkim.SignOptions{
+		Domain:                 domain,
+		Selector:               selector,
+		Signer:                 value,
+		HeaderKeys:             []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type"},
+		HeaderCanonicalization: dkim.CanonicalizationRelaxed,
+		BodyCanonicalization:   dkim.CanonicalizationRelaxed,
+	}}, nil
+}
+
+// Sign prepends a DKIM-Signature header to the RFC 5322 document.
+func (s *signer) Sign(document []byte) ([]byte, error) {
+	var signed bytes.Buffer
+	if e := dkim.Sign(&signed, bytes.NewReader(document), s.options); e != nil {
+		return nil, fmt.Errorf("unable to sign message: %w", e)
+	}
+
+	return signed.Bytes(), nil
+}
+
+// relay is a [Transport] delivering via an SMTP server, optionally authenticating and DKIM-signing.
+type relay struct {
+	host     string
+	port     int
+	username string
+	password string
+	security Security
+	signer   *signer
+
+	// configuration overrides the TLS configuration; currently used for trusting test certificate(s).
+	configuration *tls.Config
+}
+
+func (r *relay) tls() *tls.Config {
+	if r.configuration != nil {
+		return r.configuration
+	}
+
+	return &tls.Config{ServerName: r.host, MinVersion: tls.VersionTLS12}
+}
+
+func (r *relay) Send(ctx context.Context, message *Message) (string, error) {
+	document, id, e := message.Bytes()
+	if e != nil {
+		return "", fmt.Errorf("unable to render message: %w", e)
+	}
+
+	if r.signer != nil {
+		if document, e = r.signer.Sign(document); e != nil {
+			return "", e
+		}
+	}
+
+	address := net.JoinHostPort(r.host, strconv.Itoa(r.port))
+
+	var dialer net.Dialer
+
+	connection, e := dialer.DialContext(ctx, "tcp", address)
+	if e != nil {
+		return "", fmt.Errorf("unable to connect to smtp server: %w", e)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = connection.SetDeadline(deadline)
+	}
+
+	if r.security == TLS {
+		connection = tls.Client(connection, r.tls())
+	}
+
+	client, e := smtp.NewClient(connection, r.host)
+	if e != nil {
+		connection.Close()
+		return "", fmt.Errorf("unable to establish smtp session: %w", e)
+	}
+
+	defer client.Close()
+
+	if r.security == STARTTLS {
+		if ok, _ := client.Extension("STARTTLS"); !(ok) {
+			return "", errors.New("smtp server does not support STARTTLS")
+		}
+
+		if e := client.StartTLS(r.tls()); e != nil {
+			return "", fmt.Errorf("unable to negotiate STARTTLS: %w", e)
+		}
+	}
+
+	if r.username != "" {
+		// --> smtp.PlainAuth refuses to send credential(s) over an unencrypted connection, except to localhost
+		if e := client.Auth(smtp.PlainAuth("", r.username, r.password, r.host)); e != nil {
+			return "", fmt.Errorf("unable to authenticate with smtp server: %w", e)
+		}
+	}
+
+	if e := client.Mail(message.Sender); e != nil {
+		return "", fmt.Errorf("smtp server rejected sender: %w", e)
+	}
+
+	if e := client.Rcpt(message.Recipient); e != nil {
+		return "", fmt.Errorf("smtp server rejected recipient: %w", e)
+	}
+
+	writer, e := client.Data()
+	if e != nil {
+		return "", fmt.Errorf("unable to initiate smtp data: %w", e)
+	}
+
+	if _, e := writer.Write(document); e != nil {
+		return "", fmt.Errorf("unable to write smtp data: %w", e)
+	}
+
+	if e := writer.Close(); e != nil {
+		return "", fmt.Errorf("smtp server rejected message: %w", e)
+	}
+
+	_ = client.Quit()
+
+	return id, nil
+}
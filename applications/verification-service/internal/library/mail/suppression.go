@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+)
+
+// ErrSuppressed is returned in lieu of sending to a suppressed recipient; see [Suppression].
+var ErrSuppressed = errors.New("recipient address is suppressed")
+
+// Suppressor reports whether mail to the address is suppressed, e.g. following a hard bounce or complaint.
+type Suppressor func(ctx context.Context, address string) (bool, error)
+
+var (
+	suppressor Suppressor
+	mutex      sync.RWMutex
+)
+
+// Suppression registers the [Suppressor] consulted before every verification email. Without one, no address is
+// suppressed.
+func Suppression(fn Suppressor) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	suppressor = fn
+}
+
+// suppressed returns [ErrSuppressed] if the registered [Suppressor] reports the recipient as suppressed. A failed lookup
+// is returned as-is, such that a suppressed address is never mailed for want of a database.
+func suppressed(ctx context.Context, recipient string) error {
+	mutex.RLock()
+	fn := suppressor
+	mutex.RUnlock()
+
+	if fn == nil {
+		return nil
+	}
+
+	value, e := fn(ctx, recipient)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Evaluate Recipient Suppression", slog.String("error", e.Error()))
+		return e
+	} else if value {
+		slog.WarnContext(ctx, "Refusing to Mail Suppressed Recipient", slog.String("recipient", recipient))
+		return ErrSuppressed
+	}
+
+	return nil
+}
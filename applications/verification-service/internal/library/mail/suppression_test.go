@@ -0,0 +1,40 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSuppression(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+	t.Setenv("MAIL_TRANSPORT", "memory")
+
+	Box.Clear()
+
+	Suppression(func(ctx context.Context, address string) (bool, error) {
+		return address == "bounced@example.com", nil
+	})
+
+	t.Cleanup(func() { Suppression(nil) })
+
+	if e := Verification(context.Background(), "bounced@example.com", "123456", "https://testing.ethr.gg/verify/token"); !(errors.Is(e, ErrSuppressed)) {
+		t.Errorf("Expected Suppressed Error, Received: %v", e)
+	}
+
+	if messages := Box.List("bounced@example.com"); len(messages) != 0 {
+		t.Errorf("Suppressed Recipient was Mailed: %+v", messages)
+	}
+
+	if e := Verification(context.Background(), "user@example.com", "123456", "https://testing.ethr.gg/verify/token"); e != nil {
+		t.Errorf("Unable to Mail Unsuppressed Recipient: %v", e)
+	}
+
+	Suppression(func(ctx context.Context, address string) (bool, error) {
+		return false, errors.New("unavailable")
+	})
+
+	if e := Verification(context.Background(), "user@example.com", "123456", "https://testing.ethr.gg/verify/token"); e == nil || errors.Is(e, ErrSuppressed) {
+		t.Errorf("Expected Lookup Error, Received: %v", e)
+	}
+}
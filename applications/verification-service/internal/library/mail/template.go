@@ -5,15 +5,19 @@ import (
 	"embed"
 	_ "embed"
 	"errors"
+	"fmt"
 	html "html/template"
 	"io"
+	"path"
 	text "text/template"
 )
 
 type Metadata struct {
 	Expiration int    // e.g. 5
 	Duration   string // e.g. "days"
-	URL        string // verification url link
+	URL        string // verification url link; a challenge email (see [Challenge]) carries none
+	Code       string // verification code, for entry where the link can't be followed
+	Scope      string // message catalog key prefix of a challenge's purpose, e.g. "password-reset."; empty for verification
 }
 
 type Implementation interface {
@@ -49,50 +53,121 @@ func (t Template[T]) Execute(w io.Writer, metadata Metadata) error {
 }
 
 var (
-	//go:embed *.go.template
+	//go:embed templates/*.go.template
 	directive embed.FS
 
-	Text = Template[*text.Template]{
-		t:         "text",
-		name:      "email.text.go.template",
-		buffer:    bytes.Buffer{},
-		functions: text.FuncMap{},
-		template:  &text.Template{},
+	// Text represents the default ([Fallback]) locale's text template.
+	Text Template[*text.Template]
+
+	// HTML represents the default ([Fallback]) locale's HTML template.
+	HTML Template[*html.Template]
+)
+
+// Set represents a locale's compiled template set and its effective [Catalog].
+type Set struct {
+	Locale  string
+	Catalog Catalog
+	Text    Template[*text.Template]
+	HTML    Template[*html.Template]
+}
+
+// sets maps each supported locale to its compiled [Set].
+var sets = map[string]*Set{}
+
+// read returns the named template's content for the locale, preferring a locale-specific override found along the
+// locale's fallback chain, and otherwise the shared template.
+func read(locale string, name string) ([]byte, error) {
+	for _, candidate := range chain(locale) {
+		if buffer, e := locales.ReadFile(path.Join("locales", candidate, name)); e == nil {
+			return buffer, nil
+		}
+	}
+
+	return directive.ReadFile(path.Join("templates", name))
+}
+
+// compile constructs the locale's [Set], binding the "t" and "locale" template function(s) to its catalog.
+func compile(locale string, catalog Catalog) (*Set, error) {
+	functions := map[string]interface{}{
+		"t":      catalog.Translate,
+		"locale": func() string { return locale },
 	}
 
-	HTML = Template[*html.Template]{
-		t:         "html",
-		name:      "email.html.go.template",
-		buffer:    bytes.Buffer{},
-		functions: text.FuncMap{},
-		template:  &html.Template{},
+	set := &Set{
+		Locale:  locale,
+		Catalog: catalog,
+		Text: Template[*text.Template]{
+			t:         "text",
+			name:      "email.text.go.template",
+			buffer:    bytes.Buffer{},
+			functions: functions,
+		},
+		HTML: Template[*html.Template]{
+			t:         "html",
+			name:      "email.html.go.template",
+			buffer:    bytes.Buffer{},
+			functions: functions,
+		},
 	}
-)
 
-func init() {
 	{
-		buffer, e := directive.ReadFile(Text.name)
+		buffer, e := read(locale, set.Text.name)
 		if e != nil {
-			panic(e)
+			return nil, e
 		}
 
-		if _, e := Text.buffer.Write(buffer); e != nil {
-			panic(e)
-		}
+		set.Text.buffer.Write(buffer)
 
-		Text.template = text.Must(text.New(Text.name).Option("missingkey=error").Parse(Text.buffer.String()))
+		set.Text.template, e = text.New(set.Text.name).Option("missingkey=error").Funcs(functions).Parse(set.Text.buffer.String())
+		if e != nil {
+			return nil, fmt.Errorf("unable to parse %s text template: %w", locale, e)
+		}
 	}
 
 	{
-		buffer, e := directive.ReadFile(HTML.name)
+		buffer, e := read(locale, set.HTML.name)
 		if e != nil {
-			panic(e)
+			return nil, e
+		}
+
+		set.HTML.buffer.Write(buffer)
+
+		set.HTML.template, e = html.New(set.HTML.name).Option("missingkey=error").Funcs(functions).Parse(set.HTML.buffer.String())
+		if e != nil {
+			return nil, fmt.Errorf("unable to parse %s html template: %w", locale, e)
+		}
+	}
+
+	return set, nil
+}
+
+// Lookup returns the [Set] for the locale, resolved along its fallback chain; the [Fallback] locale's set is returned
+// if no supported locale matches.
+func Lookup(locale string) *Set {
+	for _, candidate := range chain(locale) {
+		if set, ok := sets[candidate]; ok {
+			return set
 		}
+	}
 
-		if _, e := HTML.buffer.Write(buffer); e != nil {
+	return sets[Fallback]
+}
+
+func init() {
+	catalogs, e := load()
+	if e != nil {
+		panic(e)
+	}
+
+	for locale, catalog := range catalogs {
+		set, e := compile(locale, catalog)
+		if e != nil {
 			panic(e)
 		}
 
-		HTML.template = html.Must(html.New(HTML.name).Option("missingkey=error").Parse(HTML.buffer.String()))
+		sets[locale] = set
 	}
+
+	Text = sets[Fallback].Text
+	HTML = sets[Fallback].HTML
 }
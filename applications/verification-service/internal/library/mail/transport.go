@@ -0,0 +1,125 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Message represents a rendered email, independent of the [Transport] delivering it.
+type Message struct {
+	Sender    string
+	Recipient string
+	Subject   string
+	HTML      string
+	Text      string
+
+	// Locale represents the rendered locale, advertised via the Content-Language header.
+	Locale string
+
+	// Set represents the SES configuration set the message is associated with; optional.
+	Set string
+
+	// Tags represents message metadata -- SES message tags, or X-Tag-* headers for RFC 5322 transports.
+	Tags map[string]string
+}
+
+// Transport delivers a [Message], returning the transport-specific message identifier.
+type Transport interface {
+	Send(ctx context.Context, message *Message) (string, error)
+}
+
+// identifier returns a random RFC 5322 Message-ID for the sender's domain.
+func identifier(sender string) string {
+	buffer := make([]byte, 16)
+	_, _ = rand.Read(buffer)
+
+	domain := "localhost"
+	if index := strings.LastIndex(sender, "@"); index >= 0 {
+		domain = sender[index+1:]
+	}
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(buffer), domain)
+}
+
+// Bytes renders the message as an RFC 5322 multipart/alternative document using CRLF line endings, returning the
+// rendered document and its Message-ID.
+func (m *Message) Bytes() ([]byte, string, error) {
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	for _, part := range []struct{ media, content string }{{"text/plain", m.Text}, {"text/html", m.HTML}} {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=UTF-8", part.media))
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		w, e := writer.CreatePart(header)
+		if e != nil {
+			return nil, "", e
+		}
+
+		encoder := quotedprintable.NewWriter(w)
+		if _, e := encoder.Write([]byte(part.content)); e != nil {
+			return nil, "", e
+		}
+
+		if e := encoder.Close(); e != nil {
+			return nil, "", e
+		}
+	}
+
+	if e := writer.Close(); e != nil {
+		return nil, "", e
+	}
+
+	id := identifier(m.Sender)
+
+	var document bytes.Buffer
+
+	header := func(key, value string) {
+		fmt.Fprintf(&document, "%s: %s\r\n", key, value)
+	}
+
+	header("From", m.Sender)
+	header("To", m.Recipient)
+	header("Subject", mime.QEncoding.Encode("UTF-8", m.Subject))
+	header("Date", time.Now().UTC().Format(time.RFC1123Z))
+	header("Message-ID", id)
+	header("MIME-Version", "1.0")
+
+	if m.Locale != "" {
+		header("Content-Language", m.Locale)
+	}
+
+	header("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", writer.Boundary()))
+
+	if m.Set != "" {
+		header("X-SES-CONFIGURATION-SET", m.Set)
+	}
+
+	keys := make([]string, 0, len(m.Tags))
+	for key := range m.Tags {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		header(fmt.Sprintf("X-Tag-%s", key), m.Tags[key])
+	}
+
+	document.WriteString("\r\n")
+	document.Write(body.Bytes())
+
+	return document.Bytes(), id, nil
+}
@@ -0,0 +1,294 @@
+package mail
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/emersion/go-msgauth/dkim"
+)
+
+func fixture() *Message {
+	return &Message{
+		Sender:    "no-reply@example.com",
+		Recipient: "user@example.com",
+		Subject:   "Verify Email Address",
+		HTML:      "<p>Verify</p>",
+		Text:      "Verify",
+		Set:       "configuration-set",
+		Tags:      map[string]string{"Type": "User-Email-Verification"},
+	}
+}
+
+// parse asserts the document is a well-formed multipart/alternative message, returning its headers.
+func parse(t *testing.T, document []byte) mail.Header {
+	t.Helper()
+
+	message, e := mail.ReadMessage(bytes.NewReader(document))
+	if e != nil {
+		t.Fatalf("Unable to Parse Message: %v", e)
+	}
+
+	media, parameters, e := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if e != nil || media != "multipart/alternative" {
+		t.Fatalf("Unexpected Content-Type: %q", message.Header.Get("Content-Type"))
+	}
+
+	reader := multipart.NewReader(message.Body, parameters["boundary"])
+
+	var parts []string
+	for {
+		part, e := reader.NextPart()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			t.Fatalf("Unable to Read Part: %v", e)
+		}
+
+		parts = append(parts, part.Header.Get("Content-Type"))
+	}
+
+	if len(parts) != 2 {
+		t.Errorf("Unexpected Part(s): %v", parts)
+	}
+
+	return message.Header
+}
+
+func TestMessage(t *testing.T) {
+	document, id, e := fixture().Bytes()
+	if e != nil {
+		t.Fatalf("Unable to Render Message: %v", e)
+	}
+
+	header := parse(t, document)
+
+	if v := header.Get("Message-ID"); v != id || !(strings.HasSuffix(id, "@example.com>")) {
+		t.Errorf("Unexpected Message-ID: %q", v)
+	}
+
+	if v := header.Get("X-SES-CONFIGURATION-SET"); v != "configuration-set" {
+		t.Errorf("Unexpected Configuration Set: %q", v)
+	}
+
+	if v := header.Get("X-Tag-Type"); v != "User-Email-Verification" {
+		t.Errorf("Unexpected Tag: %q", v)
+	}
+}
+
+func TestMaildir(t *testing.T) {
+	directory := t.TempDir()
+
+	transport := &maildir{directory: directory}
+	if _, e := transport.Send(context.Background(), fixture()); e != nil {
+		t.Fatalf("Unable to Send Message: %v", e)
+	}
+
+	entries, e := os.ReadDir(filepath.Join(directory, "new"))
+	if e != nil || len(entries) != 1 {
+		t.Fatalf("Unexpected Maildir Entries: %v (%v)", entries, e)
+	}
+
+	if entries, _ := os.ReadDir(filepath.Join(directory, "tmp")); len(entries) != 0 {
+		t.Errorf("Unexpected Temporary Maildir Entries: %v", entries)
+	}
+
+	document, e := os.ReadFile(filepath.Join(directory, "new", entries[0].Name()))
+	if e != nil {
+		t.Fatalf("Unable to Read Message: %v", e)
+	}
+
+	parse(t, document)
+}
+
+// listen starts a minimal, plaintext SMTP server accepting a single message, returning its port and a channel
+// receiving the DATA payload.
+func listen(t *testing.T) (int, <-chan []byte) {
+	t.Helper()
+
+	listener, e := net.Listen("tcp", "127.0.0.1:0")
+	if e != nil {
+		t.Fatalf("Unable to Listen: %v", e)
+	}
+
+	t.Cleanup(func() { listener.Close() })
+
+	channel := make(chan []byte, 1)
+
+	go func() {
+		connection, e := listener.Accept()
+		if e != nil {
+			return
+		}
+
+		defer connection.Close()
+
+		reader := bufio.NewReader(connection)
+		reply := func(line string) { fmt.Fprintf(connection, "%s\r\n", line) }
+
+		reply("220 localhost ESMTP")
+
+		for {
+			line, e := reader.ReadString('\n')
+			if e != nil {
+				return
+			}
+
+			switch command := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(command, "EHLO"):
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case strings.HasPrefix(command, "AUTH PLAIN"):
+				reply("235 Authenticated")
+			case strings.HasPrefix(command, "MAIL"), strings.HasPrefix(command, "RCPT"):
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 Continue")
+
+				var data bytes.Buffer
+				for {
+					line, e := reader.ReadString('\n')
+					if e != nil {
+						return
+					}
+
+					if line == ".\r\n" {
+						break
+					}
+
+					data.WriteString(strings.TrimPrefix(line, "."))
+				}
+
+				channel <- data.Bytes()
+
+				reply("250 Queued")
+			case command == "QUIT":
+				reply("221 Bye")
+				return
+			default:
+				reply("502 Unsupported")
+			}
+		}
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port, channel
+}
+
+func TestRelay(t *testing.T) {
+	public, private, e := ed25519.GenerateKey(rand.Reader)
+	if e != nil {
+		t.Fatalf("Unable to Generate Key: %v", e)
+	}
+
+	encoded, e := x509.MarshalPKCS8PrivateKey(private)
+	if e != nil {
+		t.Fatalf("Unable to Marshal Key: %v", e)
+	}
+
+	key := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encoded})
+
+	dkim, e := signing("example.com", "selector", string(key))
+	if e != nil {
+		t.Fatalf("Unable to Construct DKIM Signer: %v", e)
+	}
+
+	port, channel := listen(t)
+
+	transport := &relay{host: "localhost", port: port, username: "username", password: "password", security: None, signer: dkim}
+	if _, e := transport.Send(context.Background(), fixture()); e != nil {
+		t.Fatalf("Unable to Send Message: %v", e)
+	}
+
+	document := <-channel
+
+	parse(t, document)
+
+	verifications, e := verify(document, base64.StdEncoding.EncodeToString(public))
+	if e != nil || len(verifications) != 1 || verifications[0].Err != nil {
+		t.Fatalf("Invalid DKIM Signature: %v (%+v)", e, verifications)
+	}
+
+	if verifications[0].Domain != "example.com" {
+		t.Errorf("Unexpected DKIM Domain: %q", verifications[0].Domain)
+	}
+}
+
+func TestRelayRequiresSTARTTLS(t *testing.T) {
+	port, _ := listen(t)
+
+	transport := &relay{host: "localhost", port: port, security: STARTTLS}
+	if _, e := transport.Send(context.Background(), fixture()); e == nil || !(strings.Contains(e.Error(), "STARTTLS")) {
+		t.Errorf("Expected STARTTLS Error, Received: %v", e)
+	}
+}
+
+// verify validates the document's DKIM signature(s) against the Ed25519 public key.
+func verify(document []byte, key string) ([]*dkim.Verification, error) {
+	return dkim.VerifyWithOptions(bytes.NewReader(document), &dkim.VerifyOptions{
+		LookupTXT: func(string) ([]string, error) {
+			return []string{fmt.Sprintf("v=DKIM1; k=ed25519; p=%s", key)}, nil
+		},
+	})
+}
+
+func TestEnvironment(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		t.Setenv("MAIL_TRANSPORT", "")
+		t.Setenv("MAIL_SUBJECT", "")
+
+		// --> an unset subject defers to the locale's catalog
+		settings, e := Environment()
+		if e != nil || settings.Transport != SES || settings.Sender == "" || settings.Subject != "" {
+			t.Errorf("Unexpected Default Settings: %+v (%v)", settings, e)
+		}
+	})
+
+	t.Run("SMTP-Requires-Host", func(t *testing.T) {
+		t.Setenv("MAIL_TRANSPORT", "smtp")
+		t.Setenv("SMTP_HOST", "")
+
+		if _, e := Environment(); e == nil {
+			t.Errorf("Expected Error for Missing SMTP_HOST")
+		}
+	})
+
+	t.Run("Filesystem", func(t *testing.T) {
+		t.Setenv("MAIL_TRANSPORT", "filesystem")
+		t.Setenv("MAIL_DIRECTORY", "/tmp/mail")
+		t.Setenv("MAIL_SENDER", "sender@example.com")
+
+		settings, e := Environment()
+		if e != nil || settings.Directory != "/tmp/mail" || settings.Sender != "sender@example.com" {
+			t.Errorf("Unexpected Filesystem Settings: %+v (%v)", settings, e)
+		}
+
+		if transport, e := New(settings); e != nil {
+			t.Errorf("Unable to Construct Transport: %v", e)
+		} else if _, ok := transport.(*maildir); !(ok) {
+			t.Errorf("Unexpected Transport Type: %T", transport)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv("MAIL_TRANSPORT", "carrier-pigeon")
+
+		if _, e := Environment(); e == nil {
+			t.Errorf("Expected Error for Invalid MAIL_TRANSPORT")
+		}
+	})
+}
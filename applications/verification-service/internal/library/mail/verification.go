@@ -3,121 +3,134 @@ package mail
 import (
 	"bytes"
 	"context"
-	"errors"
-	"fmt"
 	"log/slog"
-	"os"
 	"strconv"
 	"time"
+)
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/ses"
-	"github.com/aws/aws-sdk-go-v2/service/ses/types"
-	"github.com/aws/smithy-go"
+// transport returns the configured [Transport] and its [Settings]; overridable during unit-testing.
+var transport = func() (Transport, Settings, error) {
+	settings, e := Environment()
+	if e != nil {
+		return nil, settings, e
+	}
 
-	"verification-service/internal/library/mail/internal/configuration"
-)
+	implementation, e := New(settings)
 
-func Verification(ctx context.Context, recipient string, code string) error {
-	const (
-		sender  = "no-reply@polygun.com"
-		subject = "Polygun - Verify Email Address"
-		set     = "polygun-email-verification-configuration-set"
-	)
+	return implementation, settings, e
+}
+
+// VerificationType represents the "Type" tag of verification email(s).
+const VerificationType = "User-Email-Verification"
+
+// Verification emails the recipient their verification code alongside a link that verifies them without a session. The
+// link is generated by the caller, such that this package needn't know of its signing. It's equivalent to [Compose]
+// followed by [Deliver]; prefer enqueuing the composed message where delivery should survive a transport outage.
+func Verification(ctx context.Context, recipient string, code string, link string) error {
+	message, e := Compose(ctx, recipient, code, link)
+	if e != nil {
+		return e
+	}
+
+	_, e = Deliver(ctx, message)
+
+	return e
+}
+
+// Compose renders the recipient's verification email in the context's locale (see [WithLocale]). A suppressed recipient
+// returns [ErrSuppressed].
+func Compose(ctx context.Context, recipient string, code string, link string) (*Message, error) {
+	settings, e := Environment()
+	if e != nil {
+		slog.ErrorContext(ctx, "Invalid Mail Transport Configuration", slog.String("error", e.Error()))
+		return nil, e
+	}
+
+	subject := settings.Subject
+	if subject == "" {
+		subject = Lookup(Locale(ctx)).Catalog["subject"]
+	}
 
+	metadata := Metadata{Expiration: 24, Duration: "hours", URL: link, Code: code}
+
+	return render(ctx, settings, recipient, subject, metadata, map[string]string{"Type": VerificationType})
+}
+
+// render executes the context locale's template set against the metadata, composing a message to the recipient. The
+// tags are extended with the composition's timestamp. A suppressed recipient returns [ErrSuppressed].
+func render(ctx context.Context, settings Settings, recipient string, subject string, metadata Metadata, tags map[string]string) (*Message, error) {
 	var html, text bytes.Buffer
 
-	settings := configuration.Region(ctx, "us-east-2")
+	if e := suppressed(ctx, recipient); e != nil {
+		return nil, e
+	}
 
 	timestamp := strconv.FormatInt(time.Now().UTC().Unix(), 10)
 
+	set := Lookup(Locale(ctx))
+
 	log := slog.Group("input",
-		slog.String("sender", sender),
+		slog.String("sender", settings.Sender),
 		slog.String("subject", subject),
+		slog.String("locale", set.Locale),
 		slog.String("timestamp", timestamp),
 		slog.String("recipient", recipient),
-		slog.String("region", settings.Region),
 	)
 
-	slog.DebugContext(ctx, "Email Verification Metadata", log)
+	slog.DebugContext(ctx, "Email Metadata", log)
 
-	frontend := os.Getenv("FRONTEND_URL")
-	if frontend == "" {
-		frontend = "http://localhost:3000"
-	}
-
-	metadata := Metadata{24, "hours", fmt.Sprintf("%s/verify/%s", frontend, code)}
-
-	if e := HTML.Execute(&html, metadata); e != nil {
+	if e := set.HTML.Execute(&html, metadata); e != nil {
 		slog.ErrorContext(ctx, "Unable to Generate HTML Email Template", slog.String("error", e.Error()))
-
-		return e
+		return nil, e
 	}
 
-	if e := Text.Execute(&text, metadata); e != nil {
+	if e := set.Text.Execute(&text, metadata); e != nil {
 		slog.ErrorContext(ctx, "Unable to Generate Text Email Template", slog.String("error", e.Error()))
+		return nil, e
+	}
 
-		return e
+	tags["Timestamp"] = timestamp
+
+	message := &Message{
+		Sender:    settings.Sender,
+		Recipient: recipient,
+		Subject:   subject,
+		HTML:      html.String(),
+		Text:      text.String(),
+		Locale:    set.Locale,
+		Set:       settings.Set,
+		Tags:      tags,
 	}
 
-	// Create the send email input
-	input := &ses.SendEmailInput{
-		Source: aws.String(sender),
-		Destination: &types.Destination{
-			ToAddresses: []string{recipient},
-		},
-		ReplyToAddresses:     []string{},
-		ReturnPath:           nil,
-		ConfigurationSetName: aws.String(set),
-		Message: &types.Message{
-			Body: &types.Body{
-				Html: &types.Content{
-					Charset: aws.String("UTF-8"),
-					Data:    aws.String(html.String()),
-				},
-				Text: &types.Content{
-					Charset: aws.String("UTF-8"),
-					Data:    aws.String(text.String()),
-				},
-			},
-			Subject: &types.Content{
-				Charset: aws.String("UTF-8"),
-				Data:    aws.String(subject),
-			},
-		},
-		Tags: []types.MessageTag{
-			{
-				Name:  aws.String("Type"),
-				Value: aws.String("User-Email-Verification"),
-			},
-			{
-				Name:  aws.String("Timestamp"),
-				Value: aws.String(timestamp),
-			},
-		},
+	return message, nil
+}
+
+// Deliver submits the message via the configured [Transport], returning the transport's message identifier. The
+// recipient's suppression is re-evaluated, as it may have changed since the message was composed.
+func Deliver(ctx context.Context, message *Message) (string, error) {
+	if e := suppressed(ctx, message.Recipient); e != nil {
+		return "", e
 	}
 
-	client := ses.NewFromConfig(settings)
+	implementation, settings, e := transport()
+	if e != nil {
+		slog.ErrorContext(ctx, "Invalid Mail Transport Configuration", slog.String("error", e.Error()))
+		return "", e
+	}
 
-	result, e := client.SendEmail(ctx, input)
+	id, e := implementation.Send(ctx, message)
 	if e != nil {
-		var ae smithy.APIError
-		var oe *smithy.OperationError
-
-		switch {
-		case errors.As(e, &ae):
-			slog.ErrorContext(ctx, "Failed Submitting Verification Email (AE)", slog.String("code", ae.ErrorCode()), slog.Any("fault", ae.ErrorFault()), slog.String("message", ae.ErrorMessage()), slog.String("error", ae.Error()))
-			return e
-		case errors.As(e, &oe):
-			slog.ErrorContext(ctx, "Failed Submitting Verification Email (OE)", slog.String("operation", oe.Operation()), slog.String("service", oe.Service()), slog.String("error", oe.Error()), slog.Any("unwrap", oe.Unwrap()))
-			return e
-		default:
-			slog.ErrorContext(ctx, "Failed Submitting Verification Email (Unknown)", slog.String("error", e.Error()))
-			return e
-		}
+		slog.ErrorContext(ctx, "Failed Submitting Email", slog.String("transport", string(settings.Transport)), slog.String("error", e.Error()))
+		return "", e
+	}
+
+	if Development() {
+		envelope := Box.Record(settings.Transport, message)
+
+		slog.DebugContext(ctx, "Recorded Email to Development Mailbox", slog.String("id", envelope.ID))
 	}
 
-	slog.InfoContext(ctx, "Email Successfully Submitted", slog.String("message-id", aws.ToString(result.MessageId)))
+	slog.InfoContext(ctx, "Email Successfully Submitted", slog.String("transport", string(settings.Transport)), slog.String("message-id", id))
 
-	return nil
+	return id, nil
 }
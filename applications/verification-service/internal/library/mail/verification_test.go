@@ -13,7 +13,7 @@ func TestVerification(t *testing.T) {
 	t.Skip("Skipping Integration Tests")
 	t.Run("Email", func(t *testing.T) {
 		t.Run("Successful-Submission", func(t *testing.T) {
-			if e := Verification(ctx, "jsanders4129@gmail.com", random.Verification()); e != nil {
+			if e := Verification(ctx, "jsanders4129@gmail.com", random.Verification(), "https://testing.ethr.gg/verify/token"); e != nil {
 				t.Errorf("Verification Returned non-nil Error: %s", e.Error())
 			}
 		})
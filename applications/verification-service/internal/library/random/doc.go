@@ -1,2 +1,2 @@
-// Package random provides random string utilities and functions.
+// Package random provides cryptographically secure random string utilities and functions.
 package random
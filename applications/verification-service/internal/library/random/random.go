@@ -1,42 +1,98 @@
 package random
 
 import (
-	"math/rand"
-	"time"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"os"
 )
 
-const set = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+// Alphabet represents the set of character(s) a random string is composed of.
+type Alphabet string
 
-var total = len(set)
+const (
+	Alphanumeric Alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	Numeric      Alphabet = "0123456789"
+	Unambiguous  Alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // Unambiguous excludes visually similar character(s) (0/O, 1/I/L).
+)
 
-type random = rand.Rand
+// Mode represents a verification code's format.
+type Mode string
 
-// entropy - random Instance Constructor
-func entropy() *random {
-	// Time-Generated Source
-	src := rand.NewSource(time.Now().UnixNano())
+const (
+	Token Mode = "token" // Token represents a 32-character [Alphanumeric] code; the default.
+	OTP   Mode = "otp"   // OTP represents a 6-digit [Numeric] one-time password.
+)
 
-	// Randomized Instance
-	return rand.New(src)
-}
+// String returns a uniformly distributed random string of the provided length composed of the alphabet's character(s),
+// sourced from [crypto/rand]. An error is returned for an empty alphabet, an alphabet exceeding 256 character(s), or a
+// failure reading from the system's entropy source.
+func String(alphabet Alphabet, length int) (string, error) {
+	total := len(alphabet)
+	if total == 0 || total > 256 {
+		return "", fmt.Errorf("invalid alphabet size: %d", total)
+	}
 
-func generator(length int) string {
-	// Randomized Entropic Seed
-	seed := entropy()
+	// --> reject byte(s) beyond the largest multiple of the alphabet's size to avoid modulo bias
+	ceiling := 256 - (256 % total)
 
-	// Pre-Allocated Bytes-Buffer
 	buffer := make([]byte, length)
+	entropy := make([]byte, length)
+
+	for index := 0; index < length; {
+		if _, e := rand.Read(entropy); e != nil {
+			return "", fmt.Errorf("unable to read random entropy: %w", e)
+		}
+
+		for _, b := range entropy {
+			if int(b) >= ceiling {
+				continue
+			}
+
+			buffer[index] = alphabet[int(b)%total]
+			if index++; index == length {
+				break
+			}
+		}
+	}
+
+	return string(buffer), nil
+}
+
+// Configuration returns the verification code [Mode]. Configured via the VERIFICATION_CODE_MODE environment variable;
+// defaults to [Token].
+func Configuration() Mode {
+	value := Mode(os.Getenv("VERIFICATION_CODE_MODE"))
+
+	switch value {
+	case Token, OTP:
+		return value
+	case "":
+		return Token
+	default:
+		slog.Warn("Invalid VERIFICATION_CODE_MODE Environment Variable - Using Default", slog.String("value", string(value)), slog.String("default", string(Token)))
+
+		return Token
+	}
+}
+
+// Code returns a verification code of the provided [Mode]. Code panics if the system's entropy source fails, as no
+// safe fallback exists.
+func Code(mode Mode) string {
+	alphabet, length := Alphanumeric, 32
+	if mode == OTP {
+		alphabet, length = Numeric, 6
+	}
 
-	for character := range buffer {
-		// Random Selection from Set
-		buffer[character] = set[seed.Intn(total)]
+	value, e := String(alphabet, length)
+	if e != nil {
+		panic(e)
 	}
 
-	// String Typecast
-	return string(buffer)
+	return value
 }
 
-// Verification - random token generator for generated user account verification tokens [http-api/internal/database/models.Verification]
+// Verification - random code generator for user account verification codes, formatted according to [Configuration].
 func Verification() string {
-	return generator(32)
+	return Code(Configuration())
 }
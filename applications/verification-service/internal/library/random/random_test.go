@@ -0,0 +1,60 @@
+package random
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	for _, alphabet := range []Alphabet{Alphanumeric, Numeric, Unambiguous} {
+		value, e := String(alphabet, 64)
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if len(value) != 64 {
+			t.Errorf("Unexpected Length: %d", len(value))
+		}
+
+		for _, character := range value {
+			if !(strings.ContainsRune(string(alphabet), character)) {
+				t.Errorf("Character %q Not in Alphabet %q", character, alphabet)
+			}
+		}
+	}
+
+	if _, e := String("", 8); e == nil {
+		t.Errorf("Expected Error for Empty Alphabet")
+	}
+}
+
+func TestCode(t *testing.T) {
+	if v := Code(OTP); len(v) != 6 || strings.Trim(v, string(Numeric)) != "" {
+		t.Errorf("Unexpected OTP Code: %q", v)
+	}
+
+	if v := Code(Token); len(v) != 32 {
+		t.Errorf("Unexpected Token Code: %q", v)
+	}
+
+	if Code(Token) == Code(Token) {
+		t.Errorf("Unexpected Duplicate Token Code(s)")
+	}
+}
+
+func TestConfiguration(t *testing.T) {
+	t.Setenv("VERIFICATION_CODE_MODE", "")
+	if v := Configuration(); v != Token {
+		t.Errorf("Unexpected Default Mode: %s", v)
+	}
+
+	t.Setenv("VERIFICATION_CODE_MODE", "otp")
+	if v := Configuration(); v != OTP {
+		t.Errorf("Unexpected Configured Mode: %s", v)
+	}
+
+	t.Setenv("VERIFICATION_CODE_MODE", "invalid")
+	if v := Configuration(); v != Token {
+		t.Errorf("Unexpected Fallback Mode: %s", v)
+	}
+}
@@ -0,0 +1,3 @@
+// Package sms delivers verification code(s) to phone number(s) through a pluggable [Provider]: a Twilio gateway
+// adapter, or an in-process fake that records message(s) for local stacks and end-to-end tests.
+package sms
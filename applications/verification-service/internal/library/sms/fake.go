@@ -0,0 +1,76 @@
+package sms
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// capacity represents the maximum number of message(s) retained by the [Outbox]; the oldest are evicted first.
+const capacity = 250
+
+// Record represents a text message recorded by the [Outbox].
+type Record struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Recipient string    `json:"recipient"`
+	Body      string    `json:"body"`
+}
+
+// Outbox is an in-process, bounded recorder of message(s) sent through the [Fake] provider.
+type Outbox struct {
+	mutex    sync.RWMutex
+	messages []Record
+}
+
+// Record stores the message, evicting the oldest once [capacity] is reached.
+func (o *Outbox) Record(recipient string, body string) Record {
+	record := Record{ID: uuid.NewString(), Timestamp: time.Now().UTC(), Recipient: recipient, Body: body}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if len(o.messages) >= capacity {
+		o.messages = slices.Delete(o.messages, 0, len(o.messages)-capacity+1)
+	}
+
+	o.messages = append(o.messages, record)
+
+	return record
+}
+
+// List returns the recorded message(s), newest first, optionally filtered by recipient.
+func (o *Outbox) List(recipient string) []Record {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	messages := make([]Record, 0, len(o.messages))
+	for index := len(o.messages) - 1; index >= 0; index-- {
+		if recipient == "" || o.messages[index].Recipient == recipient {
+			messages = append(messages, o.messages[index])
+		}
+	}
+
+	return messages
+}
+
+// Clear removes all recorded message(s).
+func (o *Outbox) Clear() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.messages = nil
+}
+
+// Box is the process-wide [Outbox] used by the [Fake] provider.
+var Box = &Outbox{}
+
+// fake is a [Provider] recording message(s) to [Box] -- no external delivery occurs.
+type fake struct{}
+
+func (fake) Send(ctx context.Context, recipient string, body string) (string, error) {
+	return Box.Record(recipient, body).ID, nil
+}
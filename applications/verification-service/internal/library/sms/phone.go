@@ -0,0 +1,62 @@
+package sms
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidPhone       = errors.New("invalid phone number")
+	ErrMissingCountryCode = errors.New("phone number must include a country calling code, e.g. +1")
+)
+
+// Normalize returns the phone number in E.164 format -- a "+" followed by 8 to 15 digit(s), the first non-zero.
+// Common formatting character(s) (spaces, dashes, dots, parentheses) are removed, and an international "00" prefix
+// is converted to "+". National numbers without a country calling code are rejected.
+func Normalize(input string) (string, error) {
+	value := strings.TrimSpace(input)
+
+	if strings.HasPrefix(value, "00") {
+		value = "+" + value[2:]
+	}
+
+	var builder strings.Builder
+
+	for index, character := range value {
+		switch {
+		case character == '+' && index == 0:
+			builder.WriteRune(character)
+		case character >= '0' && character <= '9':
+			builder.WriteRune(character)
+		case strings.ContainsRune(" -.()\u00a0", character):
+			continue
+		default:
+			return "", ErrInvalidPhone
+		}
+	}
+
+	normalized := builder.String()
+	if !(strings.HasPrefix(normalized, "+")) {
+		if normalized == "" {
+			return "", ErrInvalidPhone
+		}
+
+		return "", ErrMissingCountryCode
+	}
+
+	digits := normalized[1:]
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", ErrInvalidPhone
+	}
+
+	return normalized, nil
+}
+
+// Mask returns the phone number with all but its last four digit(s) redacted, suitable for logs.
+func Mask(phone string) string {
+	if len(phone) <= 4 {
+		return strings.Repeat("*", len(phone))
+	}
+
+	return strings.Repeat("*", len(phone)-4) + phone[len(phone)-4:]
+}
@@ -0,0 +1,46 @@
+package sms
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		e        error
+	}{
+		{"+1 (555) 010-9999", "+15550109999", nil},
+		{"+52 55 1234 5678", "+525512345678", nil},
+		{"0044 20 7946 0958", "+442079460958", nil},
+		{"+44.20.7946.0958", "+442079460958", nil},
+		{"  +33 6 12 34 56 78  ", "+33612345678", nil},
+		{"555-010-9999", "", ErrMissingCountryCode},
+		{"+0 555 010 9999", "", ErrInvalidPhone},
+		{"+1 555", "", ErrInvalidPhone},
+		{"+1234567890123456", "", ErrInvalidPhone},
+		{"+1 555 010 999x", "", ErrInvalidPhone},
+		{"1+5550109999", "", ErrInvalidPhone},
+		{"", "", ErrInvalidPhone},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			value, e := Normalize(test.input)
+			if !(errors.Is(e, test.e)) {
+				t.Fatalf("Unexpected Error: %v, Expected: %v", e, test.e)
+			}
+
+			if value != test.expected {
+				t.Errorf("Unexpected Value: %q, Expected: %q", value, test.expected)
+			}
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	if v := Mask("+15550109999"); v != "********9999" {
+		t.Errorf("Unexpected Masked Value: %q", v)
+	}
+}
@@ -0,0 +1,84 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"verification-service/internal/library/mail"
+)
+
+// Provider delivers a text message, returning the provider-specific message identifier.
+type Provider interface {
+	Send(ctx context.Context, recipient string, body string) (string, error)
+}
+
+// Kind represents a [Provider] implementation.
+type Kind string
+
+const (
+	Twilio Kind = "twilio"
+	Fake   Kind = "fake" // Fake only records message(s) to the [Outbox]; unavailable outside of development.
+)
+
+// Settings represents the sms package's environment-derived configuration.
+type Settings struct {
+	Provider Kind   // SMS_PROVIDER; defaults to [Fake] in an allowlisted development environment, and is otherwise required.
+	Sender   string // SMS_SENDER; the E.164 sending number.
+
+	Account string // TWILIO_ACCOUNT_SID
+	Token   string // TWILIO_AUTH_TOKEN
+	Service string // TWILIO_MESSAGING_SERVICE_SID; used instead of SMS_SENDER if set.
+}
+
+// Environment returns the sms [Settings] derived from environment variable(s), validating the selected provider's
+// required value(s).
+func Environment() (Settings, error) {
+	settings := Settings{
+		Provider: Kind(strings.ToLower(strings.TrimSpace(os.Getenv("SMS_PROVIDER")))),
+		Sender:   strings.TrimSpace(os.Getenv("SMS_SENDER")),
+		Account:  strings.TrimSpace(os.Getenv("TWILIO_ACCOUNT_SID")),
+		Token:    os.Getenv("TWILIO_AUTH_TOKEN"),
+		Service:  strings.TrimSpace(os.Getenv("TWILIO_MESSAGING_SERVICE_SID")),
+	}
+
+	if settings.Provider == "" {
+		if !(mail.Development()) {
+			return settings, fmt.Errorf("SMS_PROVIDER is required outside of development")
+		}
+
+		settings.Provider = Fake
+	}
+
+	switch settings.Provider {
+	case Fake:
+		if !(mail.Development()) {
+			return settings, fmt.Errorf("the %s sms provider is unavailable outside of development", Fake)
+		}
+	case Twilio:
+		if settings.Account == "" || settings.Token == "" {
+			return settings, fmt.Errorf("TWILIO_ACCOUNT_SID and TWILIO_AUTH_TOKEN are required for the %s provider", Twilio)
+		}
+
+		if settings.Sender == "" && settings.Service == "" {
+			return settings, fmt.Errorf("SMS_SENDER or TWILIO_MESSAGING_SERVICE_SID is required for the %s provider", Twilio)
+		}
+	default:
+		return settings, fmt.Errorf("invalid SMS_PROVIDER: %q", settings.Provider)
+	}
+
+	return settings, nil
+}
+
+// New returns the [Provider] selected by the settings.
+func New(settings Settings) (Provider, error) {
+	switch settings.Provider {
+	case Twilio:
+		return &gateway{endpoint: "https://api.twilio.com", account: settings.Account, token: settings.Token, sender: settings.Sender, service: settings.Service}, nil
+	case Fake:
+		return fake{}, nil
+	default:
+		return nil, fmt.Errorf("invalid sms provider: %q", settings.Provider)
+	}
+}
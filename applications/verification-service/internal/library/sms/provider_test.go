@@ -0,0 +1,121 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGateway(t *testing.T) {
+	instance := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !(ok) || username != "AC123" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code": 20003, "message": "Authenticate"}`))
+			return
+		}
+
+		if r.URL.Path != "/2010-04-01/Accounts/AC123/Messages.json" {
+			t.Errorf("Unexpected Path: %s", r.URL.Path)
+		}
+
+		if e := r.ParseForm(); e != nil || r.PostForm.Get("To") != "+15550109999" || r.PostForm.Get("From") != "+15550100000" || r.PostForm.Get("Body") != "body" {
+			t.Errorf("Unexpected Form: %v (%v)", r.PostForm, e)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"sid": "SM123"}`))
+	}))
+
+	defer instance.Close()
+
+	provider := &gateway{endpoint: instance.URL, account: "AC123", token: "secret", sender: "+15550100000"}
+
+	id, e := provider.Send(context.Background(), "+15550109999", "body")
+	if e != nil || id != "SM123" {
+		t.Fatalf("Unexpected Result: %q (%v)", id, e)
+	}
+
+	provider.token = "invalid"
+	if _, e := provider.Send(context.Background(), "+15550109999", "body"); e == nil || !(strings.Contains(e.Error(), "Authenticate")) {
+		t.Errorf("Expected Authentication Error, Received: %v", e)
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	t.Run("Development-Default", func(t *testing.T) {
+		t.Setenv("ENVIRONMENT", "local")
+		t.Setenv("SMS_PROVIDER", "")
+
+		settings, e := Environment()
+		if e != nil || settings.Provider != Fake {
+			t.Errorf("Unexpected Settings: %+v (%v)", settings, e)
+		}
+	})
+
+	t.Run("Production-Requires-Provider", func(t *testing.T) {
+		for _, environment := range []string{"", "staging", "production"} {
+			t.Setenv("ENVIRONMENT", environment)
+			t.Setenv("SMS_PROVIDER", "")
+
+			if _, e := Environment(); e == nil || !(strings.Contains(e.Error(), "SMS_PROVIDER is required")) {
+				t.Errorf("Expected Error for Unset Provider in Environment %q, Received: %v", environment, e)
+			}
+
+			t.Setenv("SMS_PROVIDER", "fake")
+			if _, e := Environment(); e == nil {
+				t.Errorf("Expected Error for Fake Provider in Environment %q", environment)
+			}
+		}
+	})
+
+	t.Run("Twilio", func(t *testing.T) {
+		t.Setenv("SMS_PROVIDER", "twilio")
+		t.Setenv("TWILIO_ACCOUNT_SID", "AC123")
+		t.Setenv("TWILIO_AUTH_TOKEN", "secret")
+		t.Setenv("SMS_SENDER", "")
+		t.Setenv("TWILIO_MESSAGING_SERVICE_SID", "")
+
+		if _, e := Environment(); e == nil {
+			t.Errorf("Expected Error for Missing Sender")
+		}
+
+		t.Setenv("SMS_SENDER", "+15550100000")
+
+		settings, e := Environment()
+		if e != nil {
+			t.Fatalf("Unexpected Error: %v", e)
+		}
+
+		if provider, e := New(settings); e != nil {
+			t.Errorf("Unable to Construct Provider: %v", e)
+		} else if _, ok := provider.(*gateway); !(ok) {
+			t.Errorf("Unexpected Provider Type: %T", provider)
+		}
+	})
+}
+
+func TestVerification(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "local")
+	t.Setenv("SMS_PROVIDER", "fake")
+
+	Box.Clear()
+
+	if e := Verification(context.Background(), "+15550109999", "123456", 24, "hours"); e != nil {
+		t.Fatalf("Unable to Send Verification: %v", e)
+	}
+
+	messages := Box.List("+15550109999")
+	if len(messages) != 1 || !(strings.Contains(messages[0].Body, "123456")) || !(strings.Contains(messages[0].Body, "24 hours")) {
+		t.Errorf("Unexpected Recorded Message(s): %+v", messages)
+	}
+
+	Box.Clear()
+
+	if messages := Box.List(""); len(messages) != 0 {
+		t.Errorf("Unexpected Message(s) After Clear: %+v", messages)
+	}
+}
@@ -0,0 +1,77 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"verification-service/internal/library/server/telemetry"
+)
+
+// gateway is a [Provider] delivering via Twilio's Programmable Messaging API.
+type gateway struct {
+	endpoint string // endpoint represents the API's base URL; overridden during unit-testing.
+	account  string
+	token    string
+	sender   string
+	service  string
+}
+
+func (g *gateway) Send(ctx context.Context, recipient string, body string) (string, error) {
+	form := url.Values{}
+	form.Set("To", recipient)
+	form.Set("Body", body)
+
+	if g.service != "" {
+		form.Set("MessagingServiceSid", g.service)
+	} else {
+		form.Set("From", g.sender)
+	}
+
+	target := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", strings.TrimSuffix(g.endpoint, "/"), url.PathEscape(g.account))
+
+	request, e := http.NewRequestWithContext(ctx, http.MethodPost, target, strings.NewReader(form.Encode()))
+	if e != nil {
+		return "", e
+	}
+
+	request.SetBasicAuth(g.account, g.token)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	response, e := telemetry.Client(map[string]string{}).Do(request)
+	if e != nil {
+		return "", fmt.Errorf("unable to send twilio request: %w", e)
+	}
+
+	defer response.Body.Close()
+
+	content, _ := io.ReadAll(io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		var exception struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+
+		if json.Unmarshal(content, &exception) == nil && exception.Message != "" {
+			return "", fmt.Errorf("twilio rejected message (%s, code %d): %s", response.Status, exception.Code, exception.Message)
+		}
+
+		return "", fmt.Errorf("twilio returned an unexpected status (%s): %s", response.Status, strings.TrimSpace(string(content)))
+	}
+
+	var result struct {
+		SID string `json:"sid"`
+	}
+
+	if e := json.Unmarshal(content, &result); e != nil {
+		return "", fmt.Errorf("unable to decode twilio response: %w", e)
+	}
+
+	return result.SID, nil
+}
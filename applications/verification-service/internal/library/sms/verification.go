@@ -0,0 +1,51 @@
+package sms
+
+import (
+	"context"
+	"log/slog"
+
+	"verification-service/internal/library/mail"
+)
+
+// provider returns the configured [Provider] and its [Settings]; overridable during unit-testing.
+var provider = func() (Provider, Settings, error) {
+	settings, e := Environment()
+	if e != nil {
+		return nil, settings, e
+	}
+
+	implementation, e := New(settings)
+
+	return implementation, settings, e
+}
+
+// Verification sends the phone verification code, localized according to the context's [mail.Locale].
+func Verification(ctx context.Context, recipient string, code string, expiration int, duration string) error {
+	implementation, settings, e := provider()
+	if e != nil {
+		slog.ErrorContext(ctx, "Invalid SMS Provider Configuration", slog.String("error", e.Error()))
+		return e
+	}
+
+	catalog := mail.Lookup(mail.Locale(ctx)).Catalog
+
+	unit, e := catalog.Translate("duration." + duration)
+	if e != nil {
+		return e
+	}
+
+	body, e := catalog.Translate("sms", code, expiration, unit)
+	if e != nil {
+		return e
+	}
+
+	id, e := implementation.Send(ctx, recipient, body)
+	if e != nil {
+		slog.ErrorContext(ctx, "Failed Submitting Verification SMS", slog.String("provider", string(settings.Provider)), slog.String("recipient", Mask(recipient)), slog.String("error", e.Error()))
+		return e
+	}
+
+	slog.InfoContext(ctx, "SMS Successfully Submitted", slog.String("provider", string(settings.Provider)), slog.String("recipient", Mask(recipient)), slog.String("message-id", id))
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package link builds the public, session-less verification link(s) emailed to user(s), and the front-end redirect(s)
+// following a link's resolution.
+package link
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"verification-service/internal/token"
+)
+
+// State represents the outcome of resolving a verification link, as reported to the front-end.
+type State string
+
+const (
+	Verified State = "verified" // Verified represents a link that verified -- or had previously verified -- the user.
+	Expired  State = "expired"  // Expired represents a link, or verification, whose validity had elapsed.
+	Revoked  State = "revoked"  // Revoked represents a link issued prior to an administrator revoking the verification.
+	Invalid  State = "invalid"  // Invalid represents a malformed, forged, or otherwise unusable link.
+	Failed   State = "error"    // Failed represents a link that couldn't be resolved due to a server-side error.
+)
+
+// Base returns the publicly reachable base URL of the service's API, which link(s) are rooted at. Configured via the
+// VERIFICATION_LINK_URL environment variable; defaults to the local cluster's gateway.
+func Base() string {
+	value := os.Getenv("VERIFICATION_LINK_URL")
+	if value == "" {
+		value = "http://localhost:8080/v1/verification-service"
+	}
+
+	return strings.TrimSuffix(value, "/")
+}
+
+// Frontend returns the front-end URL link resolution redirects to. Configured via the FRONTEND_URL environment variable.
+func Frontend() string {
+	value := os.Getenv("FRONTEND_URL")
+	if value == "" {
+		value = "http://localhost:3000"
+	}
+
+	return strings.TrimSuffix(value, "/")
+}
+
+// URL signs an email verification link for the email, valid until the expiration.
+func URL(ctx context.Context, email string, expiration time.Time) (string, error) {
+	signature, e := token.Sign(ctx, email, token.Email, expiration)
+	if e != nil {
+		return "", e
+	}
+
+	return Base() + "/verify/" + url.PathEscape(signature), nil
+}
+
+// Redirect returns the front-end URL reporting the link's resolved state.
+func Redirect(ctx context.Context, state State) string {
+	target := Frontend() + "/verification?" + url.Values{"state": {string(state)}}.Encode()
+
+	slog.DebugContext(ctx, "Verification Link Redirect", slog.String("state", string(state)), slog.String("target", target))
+
+	return target
+}
@@ -0,0 +1,40 @@
+package link
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"verification-service/internal/library/middleware/keystore"
+	"verification-service/internal/token"
+)
+
+func TestURL(t *testing.T) {
+	t.Setenv("VERIFICATION_LINK_URL", "https://api.example.com/v1/verification-service/")
+
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	address, e := URL(ctx, "user@example.com", time.Now().Add(time.Hour))
+	if e != nil {
+		t.Fatalf("Unable to Generate Link: %v", e)
+	}
+
+	const prefix = "https://api.example.com/v1/verification-service/verify/"
+	if !(strings.HasPrefix(address, prefix)) {
+		t.Fatalf("Unexpected Link: %s", address)
+	}
+
+	claims, e := token.Linked(ctx, strings.TrimPrefix(address, prefix), token.Email)
+	if e != nil || claims.Subject != "user@example.com" {
+		t.Errorf("Unable to Verify Generated Link: %v", e)
+	}
+}
+
+func TestRedirect(t *testing.T) {
+	t.Setenv("FRONTEND_URL", "")
+
+	if v := Redirect(context.Background(), Verified); v != "http://localhost:3000/verification?state=verified" {
+		t.Errorf("Unexpected Redirect: %s", v)
+	}
+}
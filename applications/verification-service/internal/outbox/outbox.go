@@ -0,0 +1,284 @@
+// Package outbox durably queues outbound email(s) in Postgres, within the transaction that produced them, and delivers
+// them from a worker pool: failed attempt(s) are retried with exponential backoff until a maximum attempt count, after
+// which the email is dead-lettered.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"verification-service/internal/database"
+	"verification-service/internal/library/mail"
+	"verification-service/models/emails"
+)
+
+const (
+	base     = 30 * time.Second // base represents the delay following an email's first failed attempt.
+	ceiling  = time.Hour        // ceiling represents the maximum delay between an email's attempt(s).
+	lease    = 2 * time.Minute  // lease represents the duration a claimed email is reserved for its claimant.
+	interval = 15 * time.Second // interval represents the delay between polling for due email(s).
+)
+
+// Attempts returns the maximum number of delivery attempt(s) before an email is dead-lettered. Configured via the
+// MAIL_MAX_ATTEMPTS environment variable; defaults to 8.
+func Attempts() int32 {
+	const fallback = 8
+
+	value := os.Getenv("MAIL_MAX_ATTEMPTS")
+	if value == "" {
+		return fallback
+	}
+
+	attempts, e := strconv.ParseInt(value, 10, 32)
+	if e != nil || attempts < 1 {
+		slog.Warn("Invalid MAIL_MAX_ATTEMPTS Environment Variable - Using Default", slog.String("value", value), slog.Int("default", fallback))
+
+		return fallback
+	}
+
+	return int32(attempts)
+}
+
+// Workers returns the size of the delivery worker pool. Configured via the MAIL_WORKERS environment variable; defaults
+// to 4.
+func Workers() int {
+	const fallback = 4
+
+	value := os.Getenv("MAIL_WORKERS")
+	if value == "" {
+		return fallback
+	}
+
+	workers, e := strconv.Atoi(value)
+	if e != nil || workers < 1 {
+		slog.Warn("Invalid MAIL_WORKERS Environment Variable - Using Default", slog.String("value", value), slog.Int("default", fallback))
+
+		return fallback
+	}
+
+	return workers
+}
+
+// Backoff returns the delay preceding an email's next attempt, doubling from [base] with each failed attempt up to
+// [ceiling].
+func Backoff(failures int) time.Duration {
+	if failures < 1 {
+		return base
+	}
+
+	delay := base
+	for range failures - 1 {
+		delay *= 2
+		if delay >= ceiling {
+			return ceiling
+		}
+	}
+
+	return delay
+}
+
+// Outcome determines a failed attempt's consequence: whether the email is dead-lettered, else the delay preceding its
+// next attempt. A suppressed recipient is dead-lettered immediately, as retrying can't succeed.
+func Outcome(attempts int32, maximum int32, failure error) (dead bool, delay time.Duration) {
+	if errors.Is(failure, mail.ErrSuppressed) || attempts >= maximum {
+		return true, 0
+	}
+
+	return false, Backoff(int(attempts))
+}
+
+// wake signals an idle worker to poll for due email(s) ahead of its interval.
+var wake = make(chan struct{}, 1)
+
+// Wake signals the worker pool that an email is due, without blocking. Callers should wake the pool once the enqueuing
+// transaction commits.
+func Wake() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Enqueue queues the message within the caller's transaction, such that it's only delivered if the transaction
+// commits.
+func Enqueue(ctx context.Context, db emails.DBTX, message *mail.Message) (int64, error) {
+	tags, e := json.Marshal(message.Tags)
+	if e != nil {
+		return 0, fmt.Errorf("unable to encode email tag(s): %w", e)
+	}
+
+	var set *string
+	if message.Set != "" {
+		set = &message.Set
+	}
+
+	id, e := emails.New().Enqueue(ctx, db, &emails.EnqueueParams{
+		Kind:      message.Tags["Type"],
+		Sender:    message.Sender,
+		Recipient: message.Recipient,
+		Subject:   message.Subject,
+		Html:      &message.HTML,
+		Text:      &message.Text,
+		Locale:    message.Locale,
+		Set:       set,
+		Tags:      tags,
+	})
+
+	if e != nil {
+		return 0, fmt.Errorf("unable to enqueue email: %w", e)
+	}
+
+	return id, nil
+}
+
+// Message reconstructs the queued email's [mail.Message].
+func Message(email emails.Email) (*mail.Message, error) {
+	message := &mail.Message{
+		Sender:    email.Sender,
+		Recipient: email.Recipient,
+		Subject:   email.Subject,
+		Locale:    email.Locale,
+		Tags:      map[string]string{},
+	}
+
+	if email.Html == nil || email.Text == nil {
+		return nil, errors.New("queued email content is missing")
+	}
+
+	message.HTML, message.Text = *email.Html, *email.Text
+
+	if email.Set != nil {
+		message.Set = *email.Set
+	}
+
+	if len(email.Tags) > 0 {
+		if e := json.Unmarshal(email.Tags, &message.Tags); e != nil {
+			return nil, fmt.Errorf("unable to decode email tag(s): %w", e)
+		}
+	}
+
+	return message, nil
+}
+
+// deliver submits the message; overridable during unit-testing.
+var deliver = mail.Deliver
+
+// Work runs the delivery worker pool until the context is cancelled. Because claims are leased, any number of replicas
+// may work the queue concurrently, and email(s) interrupted by a restart resume once their lease expires.
+func Work(ctx context.Context) {
+	workers := Workers()
+
+	slog.InfoContext(ctx, "Starting Email Outbox Worker Pool", slog.Int("workers", workers))
+
+	done := make(chan struct{})
+	for range workers {
+		go func() {
+			defer func() { done <- struct{}{} }()
+
+			work(ctx)
+		}()
+	}
+
+	for range workers {
+		<-done
+	}
+
+	slog.InfoContext(ctx, "Stopping Email Outbox Worker Pool")
+}
+
+// work is a single worker's loop: it drains due email(s), then idles until woken or the interval elapses.
+func work(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-wake:
+		}
+	}
+}
+
+// drain claims and delivers due email(s), one at a time, until none are due. Each successful claim wakes a peer, such
+// that a backlog is worked by the whole pool.
+func drain(ctx context.Context) {
+	maximum := Attempts()
+
+	for ctx.Err() == nil {
+		var claimed []emails.Email
+		if e := update(ctx, func(db emails.DBTX) (e error) {
+			claimed, e = emails.New().Claim(ctx, db, &emails.ClaimParams{Lease: lease.Seconds(), Size: 1})
+			return e
+		}); e != nil {
+			slog.ErrorContext(ctx, "Unable to Claim Queued Email(s)", slog.String("error", e.Error()))
+			return
+		}
+
+		if len(claimed) == 0 {
+			return
+		}
+
+		Wake()
+
+		for _, email := range claimed {
+			if e := attempt(ctx, email, maximum); e != nil {
+				slog.ErrorContext(ctx, "Unable to Record Email Delivery Attempt", slog.Int64("email", email.ID), slog.String("error", e.Error()))
+			}
+		}
+	}
+}
+
+// attempt delivers the claimed email, recording the outcome. An error is only returned when the outcome can't be
+// recorded; the email is then retried once its lease expires.
+func attempt(ctx context.Context, email emails.Email, maximum int32) error {
+	message, e := Message(email)
+	if e == nil {
+		var reference string
+		if reference, e = deliver(ctx, message); e == nil {
+			slog.InfoContext(ctx, "Delivered Queued Email", slog.Int64("email", email.ID), slog.Int("attempts", int(email.Attempts)))
+
+			return update(ctx, func(db emails.DBTX) error {
+				return emails.New().Sent(ctx, db, &emails.SentParams{ID: email.ID, Reference: &reference})
+			})
+		}
+	}
+
+	failure := e.Error()
+
+	dead, delay := Outcome(email.Attempts, maximum, e)
+	if dead || email.Html == nil {
+		slog.ErrorContext(ctx, "Dead-Lettering Queued Email", slog.Int64("email", email.ID), slog.Int("attempts", int(email.Attempts)), slog.String("error", failure))
+
+		return update(ctx, func(db emails.DBTX) error {
+			return emails.New().Fail(ctx, db, &emails.FailParams{ID: email.ID, Error: &failure})
+		})
+	}
+
+	slog.WarnContext(ctx, "Retrying Queued Email", slog.Int64("email", email.ID), slog.Int("attempts", int(email.Attempts)), slog.Duration("delay", delay), slog.String("error", failure))
+
+	return update(ctx, func(db emails.DBTX) error {
+		return emails.New().Retry(ctx, db, &emails.RetryParams{ID: email.ID, Error: &failure, Delay: delay.Seconds()})
+	})
+}
+
+// update executes the function against a pooled database connection.
+func update(ctx context.Context, fn func(db emails.DBTX) error) error {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	return fn(connection)
+}
@@ -0,0 +1,123 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"verification-service/internal/library/mail"
+	"verification-service/models/emails"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		0:  base,
+		1:  base,
+		2:  2 * base,
+		3:  4 * base,
+		7:  64 * base,
+		8:  ceiling,
+		50: ceiling,
+	}
+
+	for failures, expected := range tests {
+		if v := Backoff(failures); v != expected {
+			t.Errorf("Backoff(%d) = %s, expected %s", failures, v, expected)
+		}
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	transient := errors.New("throttled")
+
+	if dead, delay := Outcome(1, 8, transient); dead || delay != base {
+		t.Errorf("Unexpected First Attempt Outcome: %v, %s", dead, delay)
+	}
+
+	if dead, delay := Outcome(3, 8, transient); dead || delay != 4*base {
+		t.Errorf("Unexpected Third Attempt Outcome: %v, %s", dead, delay)
+	}
+
+	if dead, _ := Outcome(8, 8, transient); !(dead) {
+		t.Errorf("Expected Final Attempt to Dead-Letter")
+	}
+
+	if dead, _ := Outcome(1, 8, fmt.Errorf("wrapped: %w", mail.ErrSuppressed)); !(dead) {
+		t.Errorf("Expected Suppressed Recipient to Dead-Letter Immediately")
+	}
+}
+
+func TestConfiguration(t *testing.T) {
+	t.Setenv("MAIL_MAX_ATTEMPTS", "")
+	t.Setenv("MAIL_WORKERS", "")
+
+	if v := Attempts(); v != 8 {
+		t.Errorf("Unexpected Default Attempts: %d", v)
+	}
+
+	if v := Workers(); v != 4 {
+		t.Errorf("Unexpected Default Workers: %d", v)
+	}
+
+	t.Setenv("MAIL_MAX_ATTEMPTS", "3")
+	t.Setenv("MAIL_WORKERS", "16")
+
+	if v := Attempts(); v != 3 {
+		t.Errorf("Unexpected Attempts: %d", v)
+	}
+
+	if v := Workers(); v != 16 {
+		t.Errorf("Unexpected Workers: %d", v)
+	}
+
+	t.Setenv("MAIL_MAX_ATTEMPTS", "0")
+	t.Setenv("MAIL_WORKERS", "many")
+
+	if v := Attempts(); v != 8 {
+		t.Errorf("Expected Invalid Attempts to Use Default: %d", v)
+	}
+
+	if v := Workers(); v != 4 {
+		t.Errorf("Expected Invalid Workers to Use Default: %d", v)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	html, text, set := "<p>Hello</p>", "Hello", "verification"
+
+	message, e := Message(emails.Email{
+		Sender:    "no-reply@ethr.gg",
+		Recipient: "user@example.com",
+		Subject:   "Verify",
+		Html:      &html,
+		Text:      &text,
+		Locale:    "fr",
+		Set:       &set,
+		Tags:      []byte(`{"Type":"User-Email-Verification","Timestamp":"1"}`),
+	})
+
+	if e != nil {
+		t.Fatalf("Unable to Reconstruct Message: %v", e)
+	}
+
+	if message.HTML != html || message.Text != text || message.Set != set || message.Locale != "fr" || message.Tags["Type"] != mail.VerificationType {
+		t.Errorf("Unexpected Message: %+v", message)
+	}
+
+	if _, e := Message(emails.Email{Recipient: "user@example.com"}); e == nil {
+		t.Errorf("Expected Cleared Content to be Rejected")
+	}
+}
+
+func TestWake(t *testing.T) {
+	for range 3 {
+		Wake() // --> must never block
+	}
+
+	select {
+	case <-wake:
+	default:
+		t.Errorf("Expected Pending Wake Signal")
+	}
+}
@@ -0,0 +1,99 @@
+// Package policy defines the verification code lifecycle's limits, shared by every verification channel: the resend
+// cooldown, the daily delivery cap, and the invalid attempt limit.
+package policy
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"verification-service/models/verifications"
+)
+
+// Cooldown returns the minimum duration between deliveries to the same user. Configured via the
+// VERIFICATION_RESEND_COOLDOWN environment variable (a [time.ParseDuration] string); defaults to one minute.
+func Cooldown() time.Duration {
+	const fallback = time.Minute
+
+	value := os.Getenv("VERIFICATION_RESEND_COOLDOWN")
+	if value == "" {
+		return fallback
+	}
+
+	duration, e := time.ParseDuration(value)
+	if e != nil || duration < 0 {
+		slog.Warn("Invalid VERIFICATION_RESEND_COOLDOWN Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", fallback))
+
+		return fallback
+	}
+
+	return duration
+}
+
+// Limit returns the maximum number of deliveries to the same user within a [verifications.Window], including the
+// initial delivery. Configured via the VERIFICATION_RESEND_LIMIT environment variable; defaults to 5.
+func Limit() int32 {
+	const fallback = 5
+
+	value := os.Getenv("VERIFICATION_RESEND_LIMIT")
+	if value == "" {
+		return fallback
+	}
+
+	limit, e := strconv.ParseInt(value, 10, 32)
+	if e != nil || limit < 1 {
+		slog.Warn("Invalid VERIFICATION_RESEND_LIMIT Environment Variable - Using Default", slog.String("value", value), slog.Int("default", fallback))
+
+		return fallback
+	}
+
+	return int32(limit)
+}
+
+// Throttled evaluates a channel's most recent delivery and current daily window against the cooldown and limit,
+// returning the duration the caller must wait before another delivery is permitted. A zero duration permits delivery.
+func Throttled(delivery, window pgtype.Timestamptz, deliveries int32, now time.Time, cooldown time.Duration, limit int32) time.Duration {
+	var wait time.Duration
+
+	if delivery.Valid {
+		if remaining := delivery.Time.Add(cooldown).Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+
+	if window.Valid && deliveries >= limit {
+		if remaining := window.Time.Add(verifications.Window).Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+
+	return wait
+}
+
+// Attempts returns the number of invalid code submission(s) after which verification locks until a new code is
+// requested. Configured via the VERIFICATION_ATTEMPT_LIMIT environment variable; defaults to 5.
+func Attempts() int32 {
+	const fallback = 5
+
+	value := os.Getenv("VERIFICATION_ATTEMPT_LIMIT")
+	if value == "" {
+		return fallback
+	}
+
+	limit, e := strconv.ParseInt(value, 10, 32)
+	if e != nil || limit < 1 {
+		slog.Warn("Invalid VERIFICATION_ATTEMPT_LIMIT Environment Variable - Using Default", slog.String("value", value), slog.Int("default", fallback))
+
+		return fallback
+	}
+
+	return int32(limit)
+}
+
+// Seconds rounds the duration up to whole seconds for use in a Retry-After header.
+func Seconds(duration time.Duration) int64 {
+	return int64((duration + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,72 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestThrottled(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	timestamp := func(offset time.Duration) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: now.Add(offset), Valid: true}
+	}
+
+	tests := []struct {
+		name       string
+		delivery   pgtype.Timestamptz
+		window     pgtype.Timestamptz
+		deliveries int32
+		expected   time.Duration
+	}{
+		{"Permitted", timestamp(-2 * time.Minute), timestamp(-2 * time.Minute), 1, 0},
+		{"Cooldown", timestamp(-15 * time.Second), timestamp(-15 * time.Second), 1, 45 * time.Second},
+		{"Daily-Limit", timestamp(-time.Hour), timestamp(-20 * time.Hour), 5, 4 * time.Hour},
+		{"Elapsed-Window", timestamp(-25 * time.Hour), timestamp(-25 * time.Hour), 5, 0},
+		{"Never-Delivered", pgtype.Timestamptz{}, pgtype.Timestamptz{}, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if wait := Throttled(test.delivery, test.window, test.deliveries, now, time.Minute, 5); wait != test.expected {
+				t.Errorf("Unexpected Wait: %s, Expected: %s", wait, test.expected)
+			}
+		})
+	}
+}
+
+func TestSeconds(t *testing.T) {
+	if v := Seconds(1500 * time.Millisecond); v != 2 {
+		t.Errorf("Unexpected Retry-After Seconds: %d", v)
+	}
+
+	if v := Seconds(time.Minute); v != 60 {
+		t.Errorf("Unexpected Retry-After Seconds: %d", v)
+	}
+}
+
+func TestLimit(t *testing.T) {
+	t.Setenv("VERIFICATION_RESEND_LIMIT", "")
+	if v := Limit(); v != 5 {
+		t.Errorf("Unexpected Default Limit: %d", v)
+	}
+
+	t.Setenv("VERIFICATION_RESEND_LIMIT", "3")
+	if v := Limit(); v != 3 {
+		t.Errorf("Unexpected Configured Limit: %d", v)
+	}
+}
+
+func TestAttempts(t *testing.T) {
+	t.Setenv("VERIFICATION_ATTEMPT_LIMIT", "")
+	if v := Attempts(); v != 5 {
+		t.Errorf("Unexpected Default Attempt Limit: %d", v)
+	}
+
+	t.Setenv("VERIFICATION_ATTEMPT_LIMIT", "0")
+	if v := Attempts(); v != 5 {
+		t.Errorf("Unexpected Fallback Attempt Limit: %d", v)
+	}
+}
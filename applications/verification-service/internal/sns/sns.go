@@ -0,0 +1,250 @@
+// Package sns decodes, authenticates, and confirms Amazon SNS HTTP(S) subscription message(s).
+//
+// Message(s) are authenticated by their signature: the signing certificate is fetched from -- and only from -- an SNS
+// endpoint, and the signature is checked against the message's canonical string. See
+// https://docs.aws.amazon.com/sns/latest/dg/sns-verify-signature-of-message.html.
+package sns
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Type represents an SNS message's type, as reported by both its "Type" attribute and the x-amz-sns-message-type header.
+type Type string
+
+const (
+	Notification             Type = "Notification"             // Notification represents a message published to the topic.
+	SubscriptionConfirmation Type = "SubscriptionConfirmation" // SubscriptionConfirmation represents a request to confirm the endpoint's subscription.
+	UnsubscribeConfirmation  Type = "UnsubscribeConfirmation"  // UnsubscribeConfirmation represents the endpoint's removal from the topic.
+)
+
+var (
+	ErrUntrustedURL     = errors.New("sns url is not an amazon sns endpoint")
+	ErrInvalidSignature = errors.New("invalid sns message signature")
+	ErrUnsupportedType  = errors.New("unsupported sns message type")
+)
+
+// Message represents an SNS HTTP(S) message. Field names match the message's JSON attribute(s).
+type Message struct {
+	Type             Type   `json:"Type"`
+	MessageID        string `json:"MessageId"`
+	Token            string `json:"Token,omitempty"`
+	TopicArn         string `json:"TopicArn"`
+	Subject          string `json:"Subject,omitempty"`
+	Message          string `json:"Message"`
+	Timestamp        string `json:"Timestamp"`
+	SignatureVersion string `json:"SignatureVersion"`
+	Signature        string `json:"Signature"`
+	SigningCertURL   string `json:"SigningCertURL"`
+	SubscribeURL     string `json:"SubscribeURL,omitempty"`
+	UnsubscribeURL   string `json:"UnsubscribeURL,omitempty"`
+}
+
+// Decode reads a single [Message] from the reader.
+func Decode(reader io.Reader) (*Message, error) {
+	var message Message
+	if e := json.NewDecoder(reader).Decode(&message); e != nil {
+		return nil, fmt.Errorf("unable to decode sns message: %w", e)
+	}
+
+	return &message, nil
+}
+
+// Canonical returns the string the message's signature was computed over: select attribute name(s) and value(s), each
+// followed by a newline, in byte-order of the name(s). The attribute(s) depend on the message's [Type].
+func (m *Message) Canonical() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	write := func(name, value string) {
+		buffer.WriteString(name)
+		buffer.WriteByte('\n')
+		buffer.WriteString(value)
+		buffer.WriteByte('\n')
+	}
+
+	switch m.Type {
+	case Notification:
+		write("Message", m.Message)
+		write("MessageId", m.MessageID)
+		if m.Subject != "" {
+			write("Subject", m.Subject)
+		}
+		write("Timestamp", m.Timestamp)
+		write("TopicArn", m.TopicArn)
+		write("Type", string(m.Type))
+	case SubscriptionConfirmation, UnsubscribeConfirmation:
+		write("Message", m.Message)
+		write("MessageId", m.MessageID)
+		write("SubscribeURL", m.SubscribeURL)
+		write("Timestamp", m.Timestamp)
+		write("Token", m.Token)
+		write("TopicArn", m.TopicArn)
+		write("Type", string(m.Type))
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, m.Type)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// hosts matches the host(s) of SNS endpoint(s) across every partition, e.g. "sns.us-east-1.amazonaws.com".
+var hosts = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// Trusted reports whether the URL addresses an SNS endpoint over HTTPS. Certificate(s) and subscription confirmation(s)
+// are only ever requested from trusted URL(s), such that a forged message can't direct the service elsewhere.
+func Trusted(address string) bool {
+	location, e := url.Parse(address)
+	if e != nil {
+		return false
+	}
+
+	return location.Scheme == "https" && location.User == nil && location.Port() == "" && hosts.MatchString(location.Hostname())
+}
+
+// Client represents the HTTP client used to fetch signing certificate(s) and confirm subscription(s); overridable during
+// testing.
+var Client = &http.Client{Timeout: 10 * time.Second}
+
+// certificates caches parsed signing certificate(s) by URL; SNS rotates certificate(s) by publishing new URL(s).
+var certificates = new(sync.Map)
+
+// certificate returns the signing certificate found at the trusted URL.
+func certificate(ctx context.Context, address string) (*x509.Certificate, error) {
+	if value, ok := certificates.Load(address); ok {
+		return value.(*x509.Certificate), nil
+	}
+
+	if !(Trusted(address)) {
+		return nil, fmt.Errorf("%w: %s", ErrUntrustedURL, address)
+	}
+
+	request, e := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if e != nil {
+		return nil, e
+	}
+
+	response, e := Client.Do(request)
+	if e != nil {
+		return nil, fmt.Errorf("unable to fetch sns signing certificate: %w", e)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch sns signing certificate: unexpected status %d", response.StatusCode)
+	}
+
+	content, e := io.ReadAll(io.LimitReader(response.Body, 64<<10))
+	if e != nil {
+		return nil, fmt.Errorf("unable to read sns signing certificate: %w", e)
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.New("unable to decode sns signing certificate pem")
+	}
+
+	parsed, e := x509.ParseCertificate(block.Bytes)
+	if e != nil {
+		return nil, fmt.Errorf("unable to parse sns signing certificate: %w", e)
+	}
+
+	certificates.Store(address, parsed)
+
+	return parsed, nil
+}
+
+// Verify authenticates the message's signature; signature version(s) 1 (SHA1) and 2 (SHA256) are supported.
+func (m *Message) Verify(ctx context.Context) error {
+	var hash crypto.Hash
+	var sum []byte
+
+	canonical, e := m.Canonical()
+	if e != nil {
+		return e
+	}
+
+	switch m.SignatureVersion {
+	case "1":
+		digest := sha1.Sum(canonical)
+		hash, sum = crypto.SHA1, digest[:]
+	case "2":
+		digest := sha256.Sum256(canonical)
+		hash, sum = crypto.SHA256, digest[:]
+	default:
+		return fmt.Errorf("%w: unsupported signature version %q", ErrInvalidSignature, m.SignatureVersion)
+	}
+
+	signature, e := base64.StdEncoding.DecodeString(m.Signature)
+	if e != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, e)
+	}
+
+	signer, e := certificate(ctx, m.SigningCertURL)
+	if e != nil {
+		return e
+	}
+
+	key, ok := signer.PublicKey.(*rsa.PublicKey)
+	if !(ok) {
+		return fmt.Errorf("%w: unsupported certificate public key", ErrInvalidSignature)
+	}
+
+	if e := rsa.VerifyPKCS1v15(key, hash, sum, signature); e != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, e)
+	}
+
+	return nil
+}
+
+// Confirm confirms a [SubscriptionConfirmation] message's subscription by visiting its trusted SubscribeURL. The
+// message should be verified beforehand.
+func (m *Message) Confirm(ctx context.Context) error {
+	if m.Type != SubscriptionConfirmation {
+		return fmt.Errorf("%w: %q isn't a subscription confirmation", ErrUnsupportedType, m.Type)
+	}
+
+	if !(Trusted(m.SubscribeURL)) {
+		return fmt.Errorf("%w: %s", ErrUntrustedURL, m.SubscribeURL)
+	}
+
+	request, e := http.NewRequestWithContext(ctx, http.MethodGet, m.SubscribeURL, nil)
+	if e != nil {
+		return e
+	}
+
+	response, e := Client.Do(request)
+	if e != nil {
+		return fmt.Errorf("unable to confirm sns subscription: %w", e)
+	}
+
+	defer response.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to confirm sns subscription: unexpected status %d", response.StatusCode)
+	}
+
+	slog.InfoContext(ctx, "Confirmed SNS Subscription", slog.String("topic", m.TopicArn))
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package sns
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// stub serves the testdata signing certificate in place of SNS, recording every requested URL.
+type stub struct {
+	requests []string
+}
+
+func (s *stub) RoundTrip(request *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, request.URL.String())
+
+	body := []byte("<ConfirmSubscriptionResponse/>")
+	if strings.HasSuffix(request.URL.Path, ".pem") {
+		content, e := os.ReadFile(filepath.Join("testdata", "certificate.pem"))
+		if e != nil {
+			return nil, e
+		}
+
+		body = content
+	}
+
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header), Request: request}, nil
+}
+
+// replay configures the package to fetch certificate(s) from the stub, returning it.
+func replay(t *testing.T) *stub {
+	t.Helper()
+
+	transport := &stub{}
+
+	original := Client
+	Client = &http.Client{Transport: transport}
+
+	certificates = new(sync.Map)
+
+	t.Cleanup(func() {
+		Client = original
+
+		certificates = new(sync.Map)
+	})
+
+	return transport
+}
+
+func fixture(t *testing.T, name string) *Message {
+	t.Helper()
+
+	file, e := os.Open(filepath.Join("testdata", name+".json"))
+	if e != nil {
+		t.Fatalf("Unable to Open Fixture: %v", e)
+	}
+
+	defer file.Close()
+
+	message, e := Decode(file)
+	if e != nil {
+		t.Fatalf("Unable to Decode Fixture: %v", e)
+	}
+
+	return message
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+
+	for _, name := range []string{"subscription-confirmation", "bounce-permanent", "bounce-transient", "complaint", "event-bounce", "delivery"} {
+		t.Run(name, func(t *testing.T) {
+			replay(t)
+
+			if e := fixture(t, name).Verify(ctx); e != nil {
+				t.Errorf("Unable to Verify Fixture: %v", e)
+			}
+		})
+	}
+
+	t.Run("Tampered-Message", func(t *testing.T) {
+		replay(t)
+
+		message := fixture(t, "complaint")
+		message.Message = strings.Replace(message.Message, "complainer@example.com", "someone@example.com", 1)
+
+		if e := message.Verify(ctx); !(errors.Is(e, ErrInvalidSignature)) {
+			t.Errorf("Expected Invalid Signature, Received: %v", e)
+		}
+	})
+
+	t.Run("Tampered-Subject", func(t *testing.T) {
+		replay(t)
+
+		message := fixture(t, "bounce-transient")
+		message.Subject = ""
+
+		if e := message.Verify(ctx); !(errors.Is(e, ErrInvalidSignature)) {
+			t.Errorf("Expected Invalid Signature, Received: %v", e)
+		}
+	})
+
+	t.Run("Untrusted-Certificate", func(t *testing.T) {
+		transport := replay(t)
+
+		message := fixture(t, "bounce-permanent")
+		message.SigningCertURL = "https://attacker.example.com/SimpleNotificationService.pem"
+
+		if e := message.Verify(ctx); !(errors.Is(e, ErrUntrustedURL)) {
+			t.Errorf("Expected Untrusted URL, Received: %v", e)
+		}
+
+		if len(transport.requests) != 0 {
+			t.Errorf("Untrusted Certificate URL was Requested: %v", transport.requests)
+		}
+	})
+
+	t.Run("Unsupported-Signature-Version", func(t *testing.T) {
+		replay(t)
+
+		message := fixture(t, "bounce-permanent")
+		message.SignatureVersion = "3"
+
+		if e := message.Verify(ctx); !(errors.Is(e, ErrInvalidSignature)) {
+			t.Errorf("Expected Invalid Signature, Received: %v", e)
+		}
+	})
+
+	t.Run("Certificate-Cache", func(t *testing.T) {
+		transport := replay(t)
+
+		for range 3 {
+			if e := fixture(t, "complaint").Verify(ctx); e != nil {
+				t.Fatalf("Unable to Verify Fixture: %v", e)
+			}
+		}
+
+		if len(transport.requests) != 1 {
+			t.Errorf("Expected a Single Certificate Request, Received: %d", len(transport.requests))
+		}
+	})
+}
+
+func TestConfirm(t *testing.T) {
+	ctx := context.Background()
+
+	transport := replay(t)
+
+	message := fixture(t, "subscription-confirmation")
+	if e := message.Confirm(ctx); e != nil {
+		t.Fatalf("Unable to Confirm Subscription: %v", e)
+	}
+
+	if len(transport.requests) != 1 || transport.requests[0] != message.SubscribeURL {
+		t.Errorf("Unexpected Confirmation Request(s): %v", transport.requests)
+	}
+
+	message.SubscribeURL = "https://sns.us-east-1.amazonaws.com.example.com/?Action=ConfirmSubscription"
+	if e := message.Confirm(ctx); !(errors.Is(e, ErrUntrustedURL)) {
+		t.Errorf("Expected Untrusted URL, Received: %v", e)
+	}
+
+	if e := fixture(t, "complaint").Confirm(ctx); !(errors.Is(e, ErrUnsupportedType)) {
+		t.Errorf("Expected Unsupported Type, Received: %v", e)
+	}
+}
+
+func TestTrusted(t *testing.T) {
+	tests := map[string]bool{
+		"https://sns.us-east-1.amazonaws.com/SimpleNotificationService-abc.pem":     true,
+		"https://sns.cn-north-1.amazonaws.com.cn/SimpleNotificationService-abc.pem": true,
+		"http://sns.us-east-1.amazonaws.com/SimpleNotificationService-abc.pem":      false,
+		"https://sns.us-east-1.amazonaws.com:8443/SimpleNotificationService.pem":    false,
+		"https://user@sns.us-east-1.amazonaws.com/SimpleNotificationService.pem":    false,
+		"https://sns.us-east-1.amazonaws.com.example.com/cert.pem":                  false,
+		"https://example.com/sns.us-east-1.amazonaws.com/cert.pem":                  false,
+		"not a url": false,
+	}
+
+	for address, expected := range tests {
+		if v := Trusted(address); v != expected {
+			t.Errorf("Trusted(%q) = %v, expected %v", address, v, expected)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package suppression
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"verification-service/models/verifications"
+)
+
+// Notification type(s) relevant to suppression. SES reports other type(s), e.g. "Delivery", which are ignored.
+const (
+	Bounce    = "Bounce"    // Bounce represents an SES bounce notification.
+	Complaint = "Complaint" // Complaint represents an SES complaint notification.
+)
+
+// Permanent represents a hard bounce's type; transient and undetermined bounce(s) don't suppress an address.
+const Permanent = "Permanent"
+
+type recipient struct {
+	EmailAddress string `json:"emailAddress"`
+}
+
+// Notification represents an SES bounce or complaint notification -- an SNS message's "Message" attribute. Both the
+// identity notification ("notificationType") and configuration set event ("eventType") format(s) are supported.
+type Notification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+
+	Bounce *struct {
+		BounceType        string      `json:"bounceType"`
+		BounceSubType     string      `json:"bounceSubType"`
+		BouncedRecipients []recipient `json:"bouncedRecipients"`
+		Timestamp         time.Time   `json:"timestamp"`
+		FeedbackID        string      `json:"feedbackId"`
+	} `json:"bounce"`
+
+	Complaint *struct {
+		ComplaintFeedbackType string      `json:"complaintFeedbackType"`
+		ComplainedRecipients  []recipient `json:"complainedRecipients"`
+		Timestamp             time.Time   `json:"timestamp"`
+		FeedbackID            string      `json:"feedbackId"`
+	} `json:"complaint"`
+}
+
+// Kind returns the notification's type, regardless of format.
+func (n *Notification) Kind() string {
+	if n.NotificationType != "" {
+		return n.NotificationType
+	}
+
+	return n.EventType
+}
+
+// Entry represents a single address to suppress.
+type Entry struct {
+	Email      string                          // Email represents the lower-cased address.
+	Reason     verifications.SuppressionReason // Reason represents the feedback's kind.
+	Type       string                          // Type represents the bounce type & sub-type, or the complaint feedback type.
+	Feedback   string                          // Feedback represents the SES feedback identifier.
+	Occurrence time.Time                       // Occurrence represents when SES reported the feedback.
+}
+
+// Parse decodes an SES notification, returning the address(es) it suppresses: every recipient of a permanent bounce or
+// complaint. Any other notification suppresses nothing.
+func Parse(message string) ([]Entry, error) {
+	var notification Notification
+	if e := json.Unmarshal([]byte(message), &notification); e != nil {
+		return nil, fmt.Errorf("unable to decode ses notification: %w", e)
+	}
+
+	var entries []Entry
+
+	switch notification.Kind() {
+	case Bounce:
+		bounce := notification.Bounce
+		if bounce == nil {
+			return nil, fmt.Errorf("ses bounce notification missing bounce object")
+		} else if bounce.BounceType != Permanent {
+			return nil, nil
+		}
+
+		kind := bounce.BounceType
+		if bounce.BounceSubType != "" {
+			kind += "/" + bounce.BounceSubType
+		}
+
+		for _, r := range bounce.BouncedRecipients {
+			if email := address(r.EmailAddress); email != "" {
+				entries = append(entries, Entry{Email: email, Reason: verifications.SuppressionReasonBOUNCE, Type: kind, Feedback: bounce.FeedbackID, Occurrence: bounce.Timestamp})
+			}
+		}
+	case Complaint:
+		complaint := notification.Complaint
+		if complaint == nil {
+			return nil, fmt.Errorf("ses complaint notification missing complaint object")
+		}
+
+		for _, r := range complaint.ComplainedRecipients {
+			if email := address(r.EmailAddress); email != "" {
+				entries = append(entries, Entry{Email: email, Reason: verifications.SuppressionReasonCOMPLAINT, Type: complaint.ComplaintFeedbackType, Feedback: complaint.FeedbackID, Occurrence: complaint.Timestamp})
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// address extracts the lower-cased address from a recipient, which SES may report including a display name.
+func address(value string) string {
+	if parsed, e := mail.ParseAddress(value); e == nil {
+		return strings.ToLower(parsed.Address)
+	}
+
+	return strings.ToLower(strings.TrimSpace(value))
+}
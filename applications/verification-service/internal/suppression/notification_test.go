@@ -0,0 +1,100 @@
+package suppression
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"verification-service/internal/sns"
+	"verification-service/models/verifications"
+)
+
+// notification returns the SES notification carried by the recorded SNS fixture.
+func notification(t *testing.T, name string) string {
+	t.Helper()
+
+	file, e := os.Open(filepath.Join("..", "sns", "testdata", name+".json"))
+	if e != nil {
+		t.Fatalf("Unable to Open Fixture: %v", e)
+	}
+
+	defer file.Close()
+
+	message, e := sns.Decode(file)
+	if e != nil {
+		t.Fatalf("Unable to Decode Fixture: %v", e)
+	}
+
+	return message.Message
+}
+
+func TestParse(t *testing.T) {
+	t.Run("Permanent-Bounce", func(t *testing.T) {
+		entries, e := Parse(notification(t, "bounce-permanent"))
+		if e != nil {
+			t.Fatalf("Unable to Parse Notification: %v", e)
+		}
+
+		if len(entries) != 2 {
+			t.Fatalf("Unexpected Entries: %+v", entries)
+		}
+
+		if entries[0].Email != "bounced@example.com" || entries[1].Email != "second@example.com" {
+			t.Errorf("Unexpected Address(es): %q, %q", entries[0].Email, entries[1].Email)
+		}
+
+		expected := time.Date(2026, 10, 12, 14, 5, 31, 0, time.UTC)
+		for _, entry := range entries {
+			if entry.Reason != verifications.SuppressionReasonBOUNCE || entry.Type != "Permanent/General" || entry.Feedback == "" || !(entry.Occurrence.Equal(expected)) {
+				t.Errorf("Unexpected Entry: %+v", entry)
+			}
+		}
+	})
+
+	t.Run("Transient-Bounce", func(t *testing.T) {
+		entries, e := Parse(notification(t, "bounce-transient"))
+		if e != nil || len(entries) != 0 {
+			t.Errorf("Expected No Entries, Received %+v (%v)", entries, e)
+		}
+	})
+
+	t.Run("Complaint", func(t *testing.T) {
+		entries, e := Parse(notification(t, "complaint"))
+		if e != nil {
+			t.Fatalf("Unable to Parse Notification: %v", e)
+		}
+
+		if len(entries) != 1 || entries[0].Email != "complainer@example.com" || entries[0].Reason != verifications.SuppressionReasonCOMPLAINT || entries[0].Type != "abuse" {
+			t.Errorf("Unexpected Entries: %+v", entries)
+		}
+	})
+
+	t.Run("Event-Publishing-Bounce", func(t *testing.T) {
+		entries, e := Parse(notification(t, "event-bounce"))
+		if e != nil {
+			t.Fatalf("Unable to Parse Notification: %v", e)
+		}
+
+		if len(entries) != 1 || entries[0].Email != "suppressed@example.com" || entries[0].Type != "Permanent/Suppressed" {
+			t.Errorf("Unexpected Entries: %+v", entries)
+		}
+	})
+
+	t.Run("Delivery", func(t *testing.T) {
+		entries, e := Parse(notification(t, "delivery"))
+		if e != nil || len(entries) != 0 {
+			t.Errorf("Expected No Entries, Received %+v (%v)", entries, e)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		if _, e := Parse("not json"); e == nil {
+			t.Errorf("Expected Malformed Notification Error")
+		}
+
+		if _, e := Parse(`{"notificationType": "Bounce"}`); e == nil {
+			t.Errorf("Expected Missing Bounce Object Error")
+		}
+	})
+}
@@ -0,0 +1,58 @@
+// Package suppression maintains the list of email address(es) that must no longer be mailed, fed by SES bounce and
+// complaint notification(s).
+package suppression
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"verification-service/internal/database"
+	"verification-service/models/verifications"
+)
+
+// Suppressed reports whether the email address is suppressed. It satisfies [mail.Suppressor].
+func Suppressed(ctx context.Context, email string) (bool, error) {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return false, e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	return verifications.New().Suppressed(ctx, connection, email)
+}
+
+// Record suppresses the entries' address(es) on behalf of the SNS message. Redelivery of the same message is a no-op.
+func Record(ctx context.Context, db verifications.DBTX, message string, entries []Entry) error {
+	for _, entry := range entries {
+		occurrence := entry.Occurrence
+		if occurrence.IsZero() {
+			occurrence = time.Now()
+		}
+
+		parameters := &verifications.SuppressParams{
+			Email:      entry.Email,
+			Reason:     entry.Reason,
+			Type:       optional(entry.Type),
+			Feedback:   optional(entry.Feedback),
+			Message:    optional(message),
+			Occurrence: pgtype.Timestamptz{Time: occurrence.UTC(), Valid: true},
+		}
+
+		if e := verifications.New().Suppress(ctx, db, parameters); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,103 @@
+// Package sweeper transitions stale PENDING verification(s) -- those whose code expired without confirmation -- to
+// TIMEOUT in the background, alongside stale PENDING challenge(s) to EXPIRED.
+package sweeper
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"verification-service/internal/database"
+	"verification-service/models/challenges"
+	"verification-service/models/verifications"
+)
+
+// batch represents the maximum number of record(s) timed out per statement.
+const batch = 100
+
+// Interval returns the delay between sweeps. Configured via the VERIFICATION_SWEEP_INTERVAL environment variable (a
+// [time.ParseDuration] string); defaults to one minute.
+func Interval() time.Duration {
+	const fallback = time.Minute
+
+	value := os.Getenv("VERIFICATION_SWEEP_INTERVAL")
+	if value == "" {
+		return fallback
+	}
+
+	duration, e := time.ParseDuration(value)
+	if e != nil || duration <= 0 {
+		slog.Warn("Invalid VERIFICATION_SWEEP_INTERVAL Environment Variable - Using Default", slog.String("value", value), slog.Duration("default", fallback))
+
+		return fallback
+	}
+
+	return duration
+}
+
+// Sweep times out stale verification(s) every [Interval] until the context is cancelled. Because locked record(s) are
+// skipped, any number of replicas may sweep concurrently.
+func Sweep(ctx context.Context) {
+	ticker := time.NewTicker(Interval())
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "Starting Verification Sweeper")
+
+	for {
+		drain(ctx, "Verification", func(ctx context.Context, connection *pgxpool.Conn) (int64, error) {
+			return verifications.New().Sweep(ctx, connection, batch)
+		})
+
+		drain(ctx, "Challenge", func(ctx context.Context, connection *pgxpool.Conn) (int64, error) {
+			return challenges.New().Sweep(ctx, connection, batch)
+		})
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Stopping Verification Sweeper")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// statement times out a single batch of stale record(s).
+type statement func(ctx context.Context, connection *pgxpool.Conn) (int64, error)
+
+// drain times out stale record(s) of the noun (e.g. "Verification") in batches until none remain.
+func drain(ctx context.Context, noun string, fn statement) {
+	var total int64
+
+	for ctx.Err() == nil {
+		rows, e := sweep(ctx, fn)
+		if e != nil {
+			slog.ErrorContext(ctx, "Unable to Sweep "+noun+" Record(s)", slog.String("error", e.Error()))
+			break
+		}
+
+		total += rows
+
+		if rows < batch {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.InfoContext(ctx, "Timed Out Stale "+noun+" Record(s)", slog.Int64("count", total))
+	}
+}
+
+// sweep times out a single batch against a pooled database connection.
+func sweep(ctx context.Context, fn statement) (int64, error) {
+	connection, e := database.Connection(ctx)
+	if e != nil {
+		return 0, e
+	}
+
+	defer database.Disconnect(ctx, connection, nil)
+
+	return fn(ctx, connection)
+}
@@ -0,0 +1,25 @@
+package sweeper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":        time.Minute,
+		"30s":     30 * time.Second,
+		"5m":      5 * time.Minute,
+		"0s":      time.Minute,
+		"-1m":     time.Minute,
+		"invalid": time.Minute,
+	}
+
+	for value, expected := range tests {
+		t.Setenv("VERIFICATION_SWEEP_INTERVAL", value)
+
+		if v := Interval(); v != expected {
+			t.Errorf("Interval() with %q = %s, expected %s", value, v, expected)
+		}
+	}
+}
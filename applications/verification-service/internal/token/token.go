@@ -8,8 +8,10 @@ import (
 	"log/slog"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"verification-service/internal/library/middleware"
 )
@@ -87,3 +89,79 @@ func Verify(ctx context.Context, t string) (*jwt.Token, error) {
 
 	return nil, e
 }
+
+// link represents the audience of a verification link token. Because the audience excludes every service name, [Verify]
+// rejects link tokens as session credentials.
+const link = "verification-service/link"
+
+// Purpose distinguishes the action a link token authorizes, such that a token issued for one purpose can't be replayed
+// for another.
+type Purpose string
+
+const (
+	Email Purpose = "email-verification" // Email represents a link verifying the subject's email address.
+)
+
+// Link represents a link token's claims: the subject's email address, the link's [Purpose], and its expiry.
+type Link struct {
+	Purpose Purpose `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// Sign generates a signed link JWT authorizing the purpose for the specified email until the expiration. The token
+// carries its entire payload, such that it's verifiable without a session; see [Linked].
+func Sign(ctx context.Context, email string, purpose Purpose, expiration time.Time) (string, error) {
+	now := time.Now()
+
+	issuer := middleware.New().Service().Value(ctx)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, Link{
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   email,
+			Audience:  jwt.ClaimStrings{link},
+			ExpiresAt: jwt.NewNumericDate(expiration),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
+		},
+	})
+
+	jwt, e := token.SignedString(signer)
+	if e != nil {
+		slog.WarnContext(ctx, "Error Signing Link JWT Token", slog.String("email", email), slog.String("error", e.Error()))
+
+		return "", e
+	}
+
+	return jwt, nil
+}
+
+// ErrPurposeMismatch is returned by [Linked] when a valid link token was issued for a different [Purpose].
+var ErrPurposeMismatch = errors.New("link token purpose mismatch")
+
+// Linked verifies a link JWT generated by [Sign] for the purpose, returning its claims. An expired token's error wraps
+// [jwt.ErrTokenExpired].
+func Linked(ctx context.Context, t string, purpose Purpose) (*Link, error) {
+	var claims Link
+
+	_, e := jwt.ParseWithClaims(t, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrTokenSignatureInvalid
+		}
+
+		return signer, nil
+	}, jwt.WithAudience(link), jwt.WithExpirationRequired(), jwt.WithIssuedAt())
+
+	if e != nil {
+		slog.WarnContext(ctx, "Invalid Link JWT Token", slog.String("error", e.Error()))
+		return nil, e
+	}
+
+	if claims.Purpose != purpose {
+		slog.WarnContext(ctx, "Link JWT Token Purpose Mismatch", slog.String("purpose", string(claims.Purpose)), slog.String("expected", string(purpose)))
+		return nil, ErrPurposeMismatch
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,76 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"verification-service/internal/library/middleware/keystore"
+)
+
+func TestLink(t *testing.T) {
+	ctx := context.WithValue(context.Background(), keystore.Keys().Service(), "verification-service")
+
+	t.Run("Round-Trip", func(t *testing.T) {
+		signature, e := Sign(ctx, "user@example.com", Email, time.Now().Add(time.Hour))
+		if e != nil {
+			t.Fatalf("Unable to Sign Link: %v", e)
+		}
+
+		claims, e := Linked(ctx, signature, Email)
+		if e != nil {
+			t.Fatalf("Unable to Verify Link: %v", e)
+		}
+
+		if claims.Subject != "user@example.com" || claims.Purpose != Email || claims.IssuedAt == nil {
+			t.Errorf("Unexpected Link Claims: %+v", claims)
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		signature, e := Sign(ctx, "user@example.com", Email, time.Now().Add(-time.Minute))
+		if e != nil {
+			t.Fatalf("Unable to Sign Link: %v", e)
+		}
+
+		if _, e := Linked(ctx, signature, Email); !(errors.Is(e, jwt.ErrTokenExpired)) {
+			t.Errorf("Expected Expired Link Error, Received: %v", e)
+		}
+	})
+
+	t.Run("Purpose-Mismatch", func(t *testing.T) {
+		signature, e := Sign(ctx, "user@example.com", Purpose("password-reset"), time.Now().Add(time.Hour))
+		if e != nil {
+			t.Fatalf("Unable to Sign Link: %v", e)
+		}
+
+		if _, e := Linked(ctx, signature, Email); !(errors.Is(e, ErrPurposeMismatch)) {
+			t.Errorf("Expected Purpose Mismatch Error, Received: %v", e)
+		}
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		signature, e := Sign(ctx, "user@example.com", Email, time.Now().Add(time.Hour))
+		if e != nil {
+			t.Fatalf("Unable to Sign Link: %v", e)
+		}
+
+		if _, e := Linked(ctx, signature[:len(signature)-2]+"xx", Email); e == nil {
+			t.Errorf("Expected Tampered Link to be Rejected")
+		}
+	})
+
+	t.Run("Session-Rejection", func(t *testing.T) {
+		signature, e := Sign(ctx, "user@example.com", Email, time.Now().Add(time.Hour))
+		if e != nil {
+			t.Fatalf("Unable to Sign Link: %v", e)
+		}
+
+		if _, e := Verify(ctx, signature); !(errors.Is(e, jwt.ErrTokenInvalidAudience)) {
+			t.Errorf("Expected Link to be Rejected as a Session Token, Received: %v", e)
+		}
+	})
+}
@@ -16,6 +16,8 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"verification-service/internal/api"
+	"verification-service/internal/library/events"
+	"verification-service/internal/library/mail"
 	"verification-service/internal/library/middleware"
 	"verification-service/internal/library/middleware/logs"
 	"verification-service/internal/library/middleware/name"
@@ -25,6 +27,9 @@ import (
 	"verification-service/internal/library/middleware/versioning"
 	"verification-service/internal/library/server"
 	"verification-service/internal/library/server/telemetry"
+	"verification-service/internal/outbox"
+	"verification-service/internal/suppression"
+	"verification-service/internal/sweeper"
 
 	"verification-service/internal/library/server/logging"
 
@@ -100,6 +105,19 @@ func main() {
 
 	api.Router(mux)
 
+	// --> Event Publisher; a missing or unsafe EVENTS_BACKEND is fatal rather than silently discarding event(s)
+	if _, e := events.Default(ctx); e != nil {
+		slog.ErrorContext(ctx, "Unable to Establish Event Publisher", slog.String("error", e.Error()))
+		panic(e)
+	}
+
+	// --> Background Worker(s)
+	go sweeper.Sweep(ctx)
+	go outbox.Work(ctx)
+
+	// --> Refuse to mail address(es) suppressed by SES bounce & complaint feedback
+	mail.Suppression(suppression.Suppressed)
+
 	// --> Start the HTTP server
 	slog.Info("Starting Server ...", slog.String("port", *(port)))
 
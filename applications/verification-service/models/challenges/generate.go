@@ -0,0 +1,3 @@
+package challenges
+
+//go:generate sqlc generate
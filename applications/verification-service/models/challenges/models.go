@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package challenges
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Challenge represents a code issued to a user for a single purpose (e.g. a password reset); see internal/challenge for each purpose's policy.
+type Challenge struct {
+	ID uuid.UUID `db:"id" json:"id"`
+	// Subject represents the email address of the user the challenge was issued to.
+	Subject string `db:"subject" json:"subject"`
+	// Purpose represents the action the challenge authorizes once confirmed; a code confirms only the purpose it was issued for.
+	Purpose string `db:"purpose" json:"purpose"`
+	// Channel represents how the code was delivered.
+	Channel string `db:"channel" json:"channel"`
+	// Destination represents the email address or E.164 phone number the code was delivered to; for an email change, the new address.
+	Destination string `db:"destination" json:"destination"`
+	// Digest represents the keyed hash (HMAC-SHA256) of the code, keyed by the challenge's id; the code itself is never stored.
+	Digest string `db:"digest" json:"-"`
+	// Status represents the challenge's lifecycle state; see models/challenges/status.go.
+	Status string `db:"status" json:"status"`
+	// Attempts represents the number of invalid code submission(s).
+	Attempts int32 `db:"attempts" json:"attempts"`
+	// Expiration represents when the code expires, per the purpose's lifetime.
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+	// Confirmation represents when the code was confirmed.
+	Confirmation pgtype.Timestamptz `db:"confirmation" json:"confirmation"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+}
@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package challenges
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Querier interface {
+	// Attempt records an invalid code submission against the [Challenge], returning the updated attempt count.
+	Attempt(ctx context.Context, db DBTX, id uuid.UUID) (int32, error)
+	// Confirm transitions a PENDING, unexpired [Challenge] to CONFIRMED.
+	Confirm(ctx context.Context, db DBTX, id uuid.UUID) (Challenge, error)
+	// Create establishes a PENDING [Challenge], expiring after the provided lifetime. The id is generated by the caller, as
+	// the code's digest is keyed by it.
+	Create(ctx context.Context, db DBTX, arg *CreateParams) (Challenge, error)
+	// DeleteBySubject performs a hard database delete on the subject's [Challenge] record(s).
+	DeleteBySubject(ctx context.Context, db DBTX, subject string) error
+	// Deliveries summarizes the subject's [Challenge](s) of the purpose issued within the trailing 24 hours -- their count,
+	// and the most recent & earliest issuance -- for evaluation against the resend cooldown and daily cap.
+	Deliveries(ctx context.Context, db DBTX, arg *DeliveriesParams) (DeliveriesRow, error)
+	// Export returns the subject's [Challenge] database record(s) -- excluding code digest(s) -- for a data-subject access request.
+	Export(ctx context.Context, db DBTX, subject string) ([]ExportRow, error)
+	// Latest returns the subject's most recently issued [Challenge] of the purpose -- regardless of its status, such that a
+	// LOCKED or EXPIRED challenge is reported as such -- and acquires a row-level lock for the remainder of the transaction.
+	Latest(ctx context.Context, db DBTX, arg *LatestParams) (Challenge, error)
+	// Lock returns a fully hydrated [Challenge] database record and acquires a row-level lock for the remainder of the transaction.
+	Lock(ctx context.Context, db DBTX, id uuid.UUID) (Challenge, error)
+	// Supersede transitions the subject's PENDING [Challenge](s) of the purpose to SUPERSEDED, such that only the most
+	// recently issued code is confirmable.
+	Supersede(ctx context.Context, db DBTX, arg *SupersedeParams) (int64, error)
+	// Sweep transitions up to the provided number of PENDING, expired [Challenge](s) to EXPIRED. Locked record(s) are
+	// skipped, such that any number of replicas may sweep concurrently.
+	Sweep(ctx context.Context, db DBTX, size int32) (int64, error)
+	// Terminate transitions a PENDING [Challenge] to the provided terminal status -- EXPIRED or LOCKED.
+	Terminate(ctx context.Context, db DBTX, arg *TerminateParams) error
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,81 @@
+-- name: Create :one
+-- Create establishes a PENDING [Challenge], expiring after the provided lifetime. The id is generated by the caller, as
+-- the code's digest is keyed by it.
+INSERT INTO "Challenge" (id, subject, purpose, channel, destination, digest, expiration)
+VALUES (sqlc.arg(id), sqlc.arg(subject), sqlc.arg(purpose), sqlc.arg(channel), sqlc.arg(destination), sqlc.arg(digest), now() + make_interval(secs => sqlc.arg(lifetime)::float8))
+RETURNING *;
+
+-- name: Supersede :execrows
+-- Supersede transitions the subject's PENDING [Challenge](s) of the purpose to SUPERSEDED, such that only the most
+-- recently issued code is confirmable.
+UPDATE "Challenge"
+SET status       = 'SUPERSEDED',
+    modification = now()
+WHERE (subject) = sqlc.arg(subject)::text AND (purpose) = sqlc.arg(purpose)::text AND status = 'PENDING';
+
+-- name: Lock :one
+-- Lock returns a fully hydrated [Challenge] database record and acquires a row-level lock for the remainder of the transaction.
+SELECT * FROM "Challenge" WHERE (id) = sqlc.arg(id) FOR UPDATE;
+
+-- name: Latest :one
+-- Latest returns the subject's most recently issued [Challenge] of the purpose -- regardless of its status, such that a
+-- LOCKED or EXPIRED challenge is reported as such -- and acquires a row-level lock for the remainder of the transaction.
+SELECT * FROM "Challenge"
+WHERE (subject) = sqlc.arg(subject)::text AND (purpose) = sqlc.arg(purpose)::text
+ORDER BY creation DESC
+LIMIT 1
+FOR UPDATE;
+
+-- name: Deliveries :one
+-- Deliveries summarizes the subject's [Challenge](s) of the purpose issued within the trailing 24 hours -- their count,
+-- and the most recent & earliest issuance -- for evaluation against the resend cooldown and daily cap.
+SELECT count(*)::integer        AS deliveries,
+       max(creation)::timestamptz AS delivery,
+       min(creation)::timestamptz AS "window"
+FROM "Challenge"
+WHERE (subject) = sqlc.arg(subject)::text AND (purpose) = sqlc.arg(purpose)::text AND creation > now() - interval '24 hours';
+
+-- name: Attempt :one
+-- Attempt records an invalid code submission against the [Challenge], returning the updated attempt count.
+UPDATE "Challenge" SET attempts = attempts + 1, modification = now() WHERE (id) = sqlc.arg(id) RETURNING attempts;
+
+-- name: Confirm :one
+-- Confirm transitions a PENDING, unexpired [Challenge] to CONFIRMED.
+UPDATE "Challenge"
+SET status       = 'CONFIRMED',
+    confirmation = now(),
+    modification = now()
+WHERE (id) = sqlc.arg(id) AND status = 'PENDING' AND expiration > now()
+RETURNING *;
+
+-- name: Terminate :exec
+-- Terminate transitions a PENDING [Challenge] to the provided terminal status -- EXPIRED or LOCKED.
+UPDATE "Challenge"
+SET status       = sqlc.arg(status)::text,
+    modification = now()
+WHERE (id) = sqlc.arg(id) AND status = 'PENDING';
+
+-- name: Sweep :execrows
+-- Sweep transitions up to the provided number of PENDING, expired [Challenge](s) to EXPIRED. Locked record(s) are
+-- skipped, such that any number of replicas may sweep concurrently.
+UPDATE "Challenge"
+SET status       = 'EXPIRED',
+    modification = now()
+WHERE id IN (
+    SELECT id FROM "Challenge"
+    WHERE status = 'PENDING' AND expiration <= now()
+    ORDER BY expiration
+    LIMIT sqlc.arg(size)::integer
+    FOR UPDATE SKIP LOCKED
+);
+
+-- name: Export :many
+-- Export returns the subject's [Challenge] database record(s) -- excluding code digest(s) -- for a data-subject access request.
+SELECT id, purpose, channel, destination, status, attempts, expiration, confirmation, creation, modification
+FROM "Challenge"
+WHERE (subject) = sqlc.arg(subject)::text
+ORDER BY creation;
+
+-- name: DeleteBySubject :exec
+-- DeleteBySubject performs a hard database delete on the subject's [Challenge] record(s).
+DELETE FROM "Challenge" WHERE (subject) = sqlc.arg(subject)::text;
@@ -0,0 +1,314 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package challenges
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const attempt = `-- name: Attempt :one
+UPDATE "Challenge" SET attempts = attempts + 1, modification = now() WHERE (id) = $1 RETURNING attempts
+`
+
+// Attempt records an invalid code submission against the [Challenge], returning the updated attempt count.
+func (q *Queries) Attempt(ctx context.Context, db DBTX, id uuid.UUID) (int32, error) {
+	row := db.QueryRow(ctx, attempt, id)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const confirm = `-- name: Confirm :one
+UPDATE "Challenge"
+SET status       = 'CONFIRMED',
+    confirmation = now(),
+    modification = now()
+WHERE (id) = $1 AND status = 'PENDING' AND expiration > now()
+RETURNING id, subject, purpose, channel, destination, digest, status, attempts, expiration, confirmation, creation, modification
+`
+
+// Confirm transitions a PENDING, unexpired [Challenge] to CONFIRMED.
+func (q *Queries) Confirm(ctx context.Context, db DBTX, id uuid.UUID) (Challenge, error) {
+	row := db.QueryRow(ctx, confirm, id)
+	var i Challenge
+	err := row.Scan(
+		&i.ID,
+		&i.Subject,
+		&i.Purpose,
+		&i.Channel,
+		&i.Destination,
+		&i.Digest,
+		&i.Status,
+		&i.Attempts,
+		&i.Expiration,
+		&i.Confirmation,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const create = `-- name: Create :one
+INSERT INTO "Challenge" (id, subject, purpose, channel, destination, digest, expiration)
+VALUES ($1, $2, $3, $4, $5, $6, now() + make_interval(secs => $7::float8))
+RETURNING id, subject, purpose, channel, destination, digest, status, attempts, expiration, confirmation, creation, modification
+`
+
+type CreateParams struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	Subject     string    `db:"subject" json:"subject"`
+	Purpose     string    `db:"purpose" json:"purpose"`
+	Channel     string    `db:"channel" json:"channel"`
+	Destination string    `db:"destination" json:"destination"`
+	Digest      string    `db:"digest" json:"-"`
+	Lifetime    float64   `db:"lifetime" json:"lifetime"`
+}
+
+// Create establishes a PENDING [Challenge], expiring after the provided lifetime. The id is generated by the caller, as
+// the code's digest is keyed by it.
+func (q *Queries) Create(ctx context.Context, db DBTX, arg *CreateParams) (Challenge, error) {
+	row := db.QueryRow(ctx, create,
+		arg.ID,
+		arg.Subject,
+		arg.Purpose,
+		arg.Channel,
+		arg.Destination,
+		arg.Digest,
+		arg.Lifetime,
+	)
+	var i Challenge
+	err := row.Scan(
+		&i.ID,
+		&i.Subject,
+		&i.Purpose,
+		&i.Channel,
+		&i.Destination,
+		&i.Digest,
+		&i.Status,
+		&i.Attempts,
+		&i.Expiration,
+		&i.Confirmation,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const deleteBySubject = `-- name: DeleteBySubject :exec
+DELETE FROM "Challenge" WHERE (subject) = $1::text
+`
+
+// DeleteBySubject performs a hard database delete on the subject's [Challenge] record(s).
+func (q *Queries) DeleteBySubject(ctx context.Context, db DBTX, subject string) error {
+	_, err := db.Exec(ctx, deleteBySubject, subject)
+	return err
+}
+
+const deliveries = `-- name: Deliveries :one
+SELECT count(*)::integer        AS deliveries,
+       max(creation)::timestamptz AS delivery,
+       min(creation)::timestamptz AS "window"
+FROM "Challenge"
+WHERE (subject) = $1::text AND (purpose) = $2::text AND creation > now() - interval '24 hours'
+`
+
+type DeliveriesParams struct {
+	Subject string `db:"subject" json:"subject"`
+	Purpose string `db:"purpose" json:"purpose"`
+}
+
+type DeliveriesRow struct {
+	Deliveries int32              `db:"deliveries" json:"deliveries"`
+	Delivery   pgtype.Timestamptz `db:"delivery" json:"delivery"`
+	Window     pgtype.Timestamptz `db:"window" json:"window"`
+}
+
+// Deliveries summarizes the subject's [Challenge](s) of the purpose issued within the trailing 24 hours -- their count,
+// and the most recent & earliest issuance -- for evaluation against the resend cooldown and daily cap.
+func (q *Queries) Deliveries(ctx context.Context, db DBTX, arg *DeliveriesParams) (DeliveriesRow, error) {
+	row := db.QueryRow(ctx, deliveries, arg.Subject, arg.Purpose)
+	var i DeliveriesRow
+	err := row.Scan(&i.Deliveries, &i.Delivery, &i.Window)
+	return i, err
+}
+
+const export = `-- name: Export :many
+SELECT id, purpose, channel, destination, status, attempts, expiration, confirmation, creation, modification
+FROM "Challenge"
+WHERE (subject) = $1::text
+ORDER BY creation
+`
+
+type ExportRow struct {
+	ID           uuid.UUID          `db:"id" json:"id"`
+	Purpose      string             `db:"purpose" json:"purpose"`
+	Channel      string             `db:"channel" json:"channel"`
+	Destination  string             `db:"destination" json:"destination"`
+	Status       string             `db:"status" json:"status"`
+	Attempts     int32              `db:"attempts" json:"attempts"`
+	Expiration   pgtype.Timestamptz `db:"expiration" json:"expiration"`
+	Confirmation pgtype.Timestamptz `db:"confirmation" json:"confirmation"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+}
+
+// Export returns the subject's [Challenge] database record(s) -- excluding code digest(s) -- for a data-subject access request.
+func (q *Queries) Export(ctx context.Context, db DBTX, subject string) ([]ExportRow, error) {
+	rows, err := db.Query(ctx, export, subject)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportRow{}
+	for rows.Next() {
+		var i ExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Purpose,
+			&i.Channel,
+			&i.Destination,
+			&i.Status,
+			&i.Attempts,
+			&i.Expiration,
+			&i.Confirmation,
+			&i.Creation,
+			&i.Modification,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const latest = `-- name: Latest :one
+SELECT id, subject, purpose, channel, destination, digest, status, attempts, expiration, confirmation, creation, modification FROM "Challenge"
+WHERE (subject) = $1::text AND (purpose) = $2::text
+ORDER BY creation DESC
+LIMIT 1
+FOR UPDATE
+`
+
+type LatestParams struct {
+	Subject string `db:"subject" json:"subject"`
+	Purpose string `db:"purpose" json:"purpose"`
+}
+
+// Latest returns the subject's most recently issued [Challenge] of the purpose -- regardless of its status, such that a
+// LOCKED or EXPIRED challenge is reported as such -- and acquires a row-level lock for the remainder of the transaction.
+func (q *Queries) Latest(ctx context.Context, db DBTX, arg *LatestParams) (Challenge, error) {
+	row := db.QueryRow(ctx, latest, arg.Subject, arg.Purpose)
+	var i Challenge
+	err := row.Scan(
+		&i.ID,
+		&i.Subject,
+		&i.Purpose,
+		&i.Channel,
+		&i.Destination,
+		&i.Digest,
+		&i.Status,
+		&i.Attempts,
+		&i.Expiration,
+		&i.Confirmation,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const lock = `-- name: Lock :one
+SELECT id, subject, purpose, channel, destination, digest, status, attempts, expiration, confirmation, creation, modification FROM "Challenge" WHERE (id) = $1 FOR UPDATE
+`
+
+// Lock returns a fully hydrated [Challenge] database record and acquires a row-level lock for the remainder of the transaction.
+func (q *Queries) Lock(ctx context.Context, db DBTX, id uuid.UUID) (Challenge, error) {
+	row := db.QueryRow(ctx, lock, id)
+	var i Challenge
+	err := row.Scan(
+		&i.ID,
+		&i.Subject,
+		&i.Purpose,
+		&i.Channel,
+		&i.Destination,
+		&i.Digest,
+		&i.Status,
+		&i.Attempts,
+		&i.Expiration,
+		&i.Confirmation,
+		&i.Creation,
+		&i.Modification,
+	)
+	return i, err
+}
+
+const supersede = `-- name: Supersede :execrows
+UPDATE "Challenge"
+SET status       = 'SUPERSEDED',
+    modification = now()
+WHERE (subject) = $1::text AND (purpose) = $2::text AND status = 'PENDING'
+`
+
+type SupersedeParams struct {
+	Subject string `db:"subject" json:"subject"`
+	Purpose string `db:"purpose" json:"purpose"`
+}
+
+// Supersede transitions the subject's PENDING [Challenge](s) of the purpose to SUPERSEDED, such that only the most
+// recently issued code is confirmable.
+func (q *Queries) Supersede(ctx context.Context, db DBTX, arg *SupersedeParams) (int64, error) {
+	result, err := db.Exec(ctx, supersede, arg.Subject, arg.Purpose)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sweep = `-- name: Sweep :execrows
+UPDATE "Challenge"
+SET status       = 'EXPIRED',
+    modification = now()
+WHERE id IN (
+    SELECT id FROM "Challenge"
+    WHERE status = 'PENDING' AND expiration <= now()
+    ORDER BY expiration
+    LIMIT $1::integer
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+// Sweep transitions up to the provided number of PENDING, expired [Challenge](s) to EXPIRED. Locked record(s) are
+// skipped, such that any number of replicas may sweep concurrently.
+func (q *Queries) Sweep(ctx context.Context, db DBTX, size int32) (int64, error) {
+	result, err := db.Exec(ctx, sweep, size)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const terminate = `-- name: Terminate :exec
+UPDATE "Challenge"
+SET status       = $1::text,
+    modification = now()
+WHERE (id) = $2 AND status = 'PENDING'
+`
+
+type TerminateParams struct {
+	Status string    `db:"status" json:"status"`
+	ID     uuid.UUID `db:"id" json:"id"`
+}
+
+// Terminate transitions a PENDING [Challenge] to the provided terminal status -- EXPIRED or LOCKED.
+func (q *Queries) Terminate(ctx context.Context, db DBTX, arg *TerminateParams) error {
+	_, err := db.Exec(ctx, terminate, arg.Status, arg.ID)
+	return err
+}
@@ -0,0 +1,41 @@
+--
+-- Challenge
+--
+
+CREATE TABLE "Challenge"
+(
+    "id"           uuid
+        CONSTRAINT "challenge-id-primary-key" primary key,
+
+    "subject"      varchar(255)                               not null,
+    "purpose"      varchar(32)                                not null
+        CONSTRAINT "challenge-purpose-constraint" CHECK ("Challenge"."purpose" IN ('email-verification', 'password-reset', 'email-change', 'account-deletion', 'step-up')),
+    "channel"      varchar(8)                                 not null
+        CONSTRAINT "challenge-channel-constraint" CHECK ("Challenge"."channel" IN ('EMAIL', 'SMS')),
+    "destination"  varchar(255)                               not null,
+    "digest"       char(64)                                   not null,
+
+    "status"       varchar(16)              default 'PENDING' not null
+        CONSTRAINT "challenge-status-constraint" CHECK ("Challenge"."status" IN ('PENDING', 'CONFIRMED', 'EXPIRED', 'LOCKED', 'SUPERSEDED')),
+
+    "attempts"     integer                  default 0         not null,
+    "expiration"   timestamp with time zone                   not null,
+    "confirmation" timestamp with time zone,
+
+    "creation"     timestamp with time zone default now()     not null,
+    "modification" timestamp with time zone
+);
+
+COMMENT ON TABLE "Challenge" IS 'Challenge represents a code issued to a user for a single purpose (e.g. a password reset); see internal/challenge for each purpose''s policy.';
+COMMENT ON COLUMN "Challenge"."subject" IS 'Subject represents the email address of the user the challenge was issued to.';
+COMMENT ON COLUMN "Challenge"."purpose" IS 'Purpose represents the action the challenge authorizes once confirmed; a code confirms only the purpose it was issued for.';
+COMMENT ON COLUMN "Challenge"."channel" IS 'Channel represents how the code was delivered.';
+COMMENT ON COLUMN "Challenge"."destination" IS 'Destination represents the email address or E.164 phone number the code was delivered to; for an email change, the new address.';
+COMMENT ON COLUMN "Challenge"."digest" IS 'Digest represents the keyed hash (HMAC-SHA256) of the code, keyed by the challenge''s id; the code itself is never stored.';
+COMMENT ON COLUMN "Challenge"."status" IS 'Status represents the challenge''s lifecycle state; see models/challenges/status.go.';
+COMMENT ON COLUMN "Challenge"."attempts" IS 'Attempts represents the number of invalid code submission(s).';
+COMMENT ON COLUMN "Challenge"."expiration" IS 'Expiration represents when the code expires, per the purpose''s lifetime.';
+COMMENT ON COLUMN "Challenge"."confirmation" IS 'Confirmation represents when the code was confirmed.';
+
+CREATE INDEX IF NOT EXISTS "challenge-subject-purpose-index" on "Challenge" (subject, purpose, creation);
+CREATE INDEX IF NOT EXISTS "challenge-pending-expiration-index" on "Challenge" (expiration) WHERE status = 'PENDING';
@@ -0,0 +1,16 @@
+package challenges
+
+// [Challenge.Status] value(s), as constrained by the "challenge-status-constraint" check.
+const (
+	Pending    = "PENDING"    // Pending represents a challenge awaiting confirmation of an unexpired code.
+	Confirmed  = "CONFIRMED"  // Confirmed represents a challenge whose code was successfully confirmed.
+	Expired    = "EXPIRED"    // Expired represents a challenge whose code expired before confirmation.
+	Locked     = "LOCKED"     // Locked represents a challenge that exhausted its invalid attempt(s).
+	Superseded = "SUPERSEDED" // Superseded represents a pending challenge replaced by a newer one of the same purpose.
+)
+
+// [Challenge.Channel] value(s), as constrained by the "challenge-channel-constraint" check.
+const (
+	Email = "EMAIL" // Email represents a code delivered via the outbox.
+	SMS   = "SMS"   // SMS represents a code delivered via the configured SMS provider.
+)
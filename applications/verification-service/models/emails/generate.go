@@ -0,0 +1,3 @@
+package emails
+
+//go:generate sqlc generate
@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package emails
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Email represents an outbound email, enqueued within the transaction that produced it and delivered by the outbox worker pool.
+type Email struct {
+	ID int64 `db:"id" json:"id"`
+	// Kind represents the email's purpose (e.g. "User-Email-Verification").
+	Kind      string `db:"kind" json:"kind"`
+	Sender    string `db:"sender" json:"sender"`
+	Recipient string `db:"recipient" json:"recipient"`
+	Subject   string `db:"subject" json:"subject"`
+	// HTML represents the rendered HTML body; cleared once the email is sent or dead-lettered, such that verification code(s) don't persist.
+	Html *string `db:"html" json:"-"`
+	// Text represents the rendered plain-text body; cleared alongside HTML.
+	Text   *string `db:"text" json:"-"`
+	Locale string  `db:"locale" json:"locale"`
+	// Set represents the SES configuration set, if any.
+	Set  *string         `db:"set" json:"set"`
+	Tags json.RawMessage `db:"tags" json:"tags"`
+	// Status represents the delivery state; see models/emails/status.go.
+	Status string `db:"status" json:"status"`
+	// Attempts represents the number of delivery attempt(s) made.
+	Attempts int32 `db:"attempts" json:"attempts"`
+	// Error represents the most recent delivery attempt's failure.
+	Error *string `db:"error" json:"error"`
+	// Reference represents the transport's message identifier once sent.
+	Reference *string `db:"reference" json:"reference"`
+	// Schedule represents when the email is next due; a claim moves it forward by the lease duration.
+	Schedule     pgtype.Timestamptz `db:"schedule" json:"schedule"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	// Delivery represents when the email was sent.
+	Delivery pgtype.Timestamptz `db:"delivery" json:"delivery"`
+}
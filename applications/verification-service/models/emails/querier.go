@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package emails
+
+import (
+	"context"
+)
+
+type Querier interface {
+	// Claim leases up to size due, QUEUED [Email](s) by moving each schedule forward by the lease duration, counting the
+	// attempt. Concurrent claimants skip locked row(s), and an email whose claimant exits mid-delivery becomes due once the
+	// lease expires.
+	Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Email, error)
+	// DeleteByRecipient performs a hard database delete on the recipient's [Email](s), regardless of status, such that a
+	// deleted account's address & any undelivered code don't persist in the outbox.
+	DeleteByRecipient(ctx context.Context, db DBTX, recipient string) (int64, error)
+	// Enqueue establishes a QUEUED [Email], due immediately.
+	Enqueue(ctx context.Context, db DBTX, arg *EnqueueParams) (int64, error)
+	// Fail dead-letters the [Email]: it transitions to FAILED, recording the final attempt's error and clearing its content.
+	Fail(ctx context.Context, db DBTX, arg *FailParams) error
+	// Get returns the [Email] database record.
+	Get(ctx context.Context, db DBTX, id int64) (Email, error)
+	// List returns up to size [Email](s) -- newest first -- optionally filtered by status, older than the cursor.
+	List(ctx context.Context, db DBTX, arg *ListParams) ([]Email, error)
+	// Retry records the failed attempt and reschedules the QUEUED [Email] after the backoff delay.
+	Retry(ctx context.Context, db DBTX, arg *RetryParams) error
+	// Sent transitions the [Email] to SENT, recording the transport's reference and clearing its content.
+	Sent(ctx context.Context, db DBTX, arg *SentParams) error
+	// Summary returns the number of [Email](s) per status.
+	Summary(ctx context.Context, db DBTX) ([]SummaryRow, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,73 @@
+-- name: Enqueue :one
+-- Enqueue establishes a QUEUED [Email], due immediately.
+INSERT INTO "Email" (kind, sender, recipient, subject, html, text, locale, set, tags)
+VALUES (sqlc.arg(kind), sqlc.arg(sender), sqlc.arg(recipient), sqlc.arg(subject), sqlc.arg(html), sqlc.arg(text), sqlc.arg(locale), sqlc.narg(set), sqlc.arg(tags))
+RETURNING id;
+
+-- name: Claim :many
+-- Claim leases up to size due, QUEUED [Email](s) by moving each schedule forward by the lease duration, counting the
+-- attempt. Concurrent claimants skip locked row(s), and an email whose claimant exits mid-delivery becomes due once the
+-- lease expires.
+UPDATE "Email"
+SET schedule     = now() + make_interval(secs => sqlc.arg(lease)::float8),
+    attempts     = attempts + 1,
+    modification = now()
+WHERE (id) IN (SELECT e.id
+               FROM "Email" e
+               WHERE (e.status) = 'QUEUED'
+                 AND (e.schedule) <= now()
+               ORDER BY e.schedule
+               LIMIT sqlc.arg(size)::int FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: Sent :exec
+-- Sent transitions the [Email] to SENT, recording the transport's reference and clearing its content.
+UPDATE "Email"
+SET status       = 'SENT',
+    reference    = sqlc.narg(reference),
+    error        = NULL,
+    html         = NULL,
+    text         = NULL,
+    delivery     = now(),
+    modification = now()
+WHERE (id) = sqlc.arg(id);
+
+-- name: Retry :exec
+-- Retry records the failed attempt and reschedules the QUEUED [Email] after the backoff delay.
+UPDATE "Email"
+SET error        = sqlc.arg(error),
+    schedule     = now() + make_interval(secs => sqlc.arg(delay)::float8),
+    modification = now()
+WHERE (id) = sqlc.arg(id);
+
+-- name: Fail :exec
+-- Fail dead-letters the [Email]: it transitions to FAILED, recording the final attempt's error and clearing its content.
+UPDATE "Email"
+SET status       = 'FAILED',
+    error        = sqlc.arg(error),
+    html         = NULL,
+    text         = NULL,
+    modification = now()
+WHERE (id) = sqlc.arg(id);
+
+-- name: Get :one
+-- Get returns the [Email] database record.
+SELECT * FROM "Email" WHERE (id) = $1;
+
+-- name: List :many
+-- List returns up to size [Email](s) -- newest first -- optionally filtered by status, older than the cursor.
+SELECT *
+FROM "Email"
+WHERE (sqlc.narg(status)::text IS NULL OR (status) = sqlc.narg(status)::text)
+  AND (sqlc.narg(cursor)::bigint IS NULL OR (id) < sqlc.narg(cursor)::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg(size)::int;
+
+-- name: Summary :many
+-- Summary returns the number of [Email](s) per status.
+SELECT status, count(*) AS count FROM "Email" GROUP BY status ORDER BY status;
+
+-- name: DeleteByRecipient :execrows
+-- DeleteByRecipient performs a hard database delete on the recipient's [Email](s), regardless of status, such that a
+-- deleted account's address & any undelivered code don't persist in the outbox.
+DELETE FROM "Email" WHERE (recipient) = sqlc.arg(recipient)::text;
@@ -0,0 +1,302 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: queries.sql
+
+package emails
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const claim = `-- name: Claim :many
+UPDATE "Email"
+SET schedule     = now() + make_interval(secs => $1::float8),
+    attempts     = attempts + 1,
+    modification = now()
+WHERE (id) IN (SELECT e.id
+               FROM "Email" e
+               WHERE (e.status) = 'QUEUED'
+                 AND (e.schedule) <= now()
+               ORDER BY e.schedule
+               LIMIT $2::int FOR UPDATE SKIP LOCKED)
+RETURNING id, kind, sender, recipient, subject, html, text, locale, set, tags, status, attempts, error, reference, schedule, creation, modification, delivery
+`
+
+type ClaimParams struct {
+	Lease float64 `db:"lease" json:"lease"`
+	Size  int32   `db:"size" json:"size"`
+}
+
+// Claim leases up to size due, QUEUED [Email](s) by moving each schedule forward by the lease duration, counting the
+// attempt. Concurrent claimants skip locked row(s), and an email whose claimant exits mid-delivery becomes due once the
+// lease expires.
+func (q *Queries) Claim(ctx context.Context, db DBTX, arg *ClaimParams) ([]Email, error) {
+	rows, err := db.Query(ctx, claim, arg.Lease, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Email{}
+	for rows.Next() {
+		var i Email
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Sender,
+			&i.Recipient,
+			&i.Subject,
+			&i.Html,
+			&i.Text,
+			&i.Locale,
+			&i.Set,
+			&i.Tags,
+			&i.Status,
+			&i.Attempts,
+			&i.Error,
+			&i.Reference,
+			&i.Schedule,
+			&i.Creation,
+			&i.Modification,
+			&i.Delivery,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteByRecipient = `-- name: DeleteByRecipient :execrows
+DELETE FROM "Email" WHERE (recipient) = $1::text
+`
+
+// DeleteByRecipient performs a hard database delete on the recipient's [Email](s), regardless of status, such that a
+// deleted account's address & any undelivered code don't persist in the outbox.
+func (q *Queries) DeleteByRecipient(ctx context.Context, db DBTX, recipient string) (int64, error) {
+	result, err := db.Exec(ctx, deleteByRecipient, recipient)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueue = `-- name: Enqueue :one
+INSERT INTO "Email" (kind, sender, recipient, subject, html, text, locale, set, tags)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id
+`
+
+type EnqueueParams struct {
+	Kind      string          `db:"kind" json:"kind"`
+	Sender    string          `db:"sender" json:"sender"`
+	Recipient string          `db:"recipient" json:"recipient"`
+	Subject   string          `db:"subject" json:"subject"`
+	Html      *string         `db:"html" json:"-"`
+	Text      *string         `db:"text" json:"-"`
+	Locale    string          `db:"locale" json:"locale"`
+	Set       *string         `db:"set" json:"set"`
+	Tags      json.RawMessage `db:"tags" json:"tags"`
+}
+
+// Enqueue establishes a QUEUED [Email], due immediately.
+func (q *Queries) Enqueue(ctx context.Context, db DBTX, arg *EnqueueParams) (int64, error) {
+	row := db.QueryRow(ctx, enqueue,
+		arg.Kind,
+		arg.Sender,
+		arg.Recipient,
+		arg.Subject,
+		arg.Html,
+		arg.Text,
+		arg.Locale,
+		arg.Set,
+		arg.Tags,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const fail = `-- name: Fail :exec
+UPDATE "Email"
+SET status       = 'FAILED',
+    error        = $1,
+    html         = NULL,
+    text         = NULL,
+    modification = now()
+WHERE (id) = $2
+`
+
+type FailParams struct {
+	Error *string `db:"error" json:"error"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Fail dead-letters the [Email]: it transitions to FAILED, recording the final attempt's error and clearing its content.
+func (q *Queries) Fail(ctx context.Context, db DBTX, arg *FailParams) error {
+	_, err := db.Exec(ctx, fail, arg.Error, arg.ID)
+	return err
+}
+
+const get = `-- name: Get :one
+SELECT id, kind, sender, recipient, subject, html, text, locale, set, tags, status, attempts, error, reference, schedule, creation, modification, delivery FROM "Email" WHERE (id) = $1
+`
+
+// Get returns the [Email] database record.
+func (q *Queries) Get(ctx context.Context, db DBTX, id int64) (Email, error) {
+	row := db.QueryRow(ctx, get, id)
+	var i Email
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Sender,
+		&i.Recipient,
+		&i.Subject,
+		&i.Html,
+		&i.Text,
+		&i.Locale,
+		&i.Set,
+		&i.Tags,
+		&i.Status,
+		&i.Attempts,
+		&i.Error,
+		&i.Reference,
+		&i.Schedule,
+		&i.Creation,
+		&i.Modification,
+		&i.Delivery,
+	)
+	return i, err
+}
+
+const list = `-- name: List :many
+SELECT id, kind, sender, recipient, subject, html, text, locale, set, tags, status, attempts, error, reference, schedule, creation, modification, delivery
+FROM "Email"
+WHERE ($1::text IS NULL OR (status) = $1::text)
+  AND ($2::bigint IS NULL OR (id) < $2::bigint)
+ORDER BY id DESC
+LIMIT $3::int
+`
+
+type ListParams struct {
+	Status *string `db:"status" json:"status"`
+	Cursor *int64  `db:"cursor" json:"cursor"`
+	Size   int32   `db:"size" json:"size"`
+}
+
+// List returns up to size [Email](s) -- newest first -- optionally filtered by status, older than the cursor.
+func (q *Queries) List(ctx context.Context, db DBTX, arg *ListParams) ([]Email, error) {
+	rows, err := db.Query(ctx, list, arg.Status, arg.Cursor, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Email{}
+	for rows.Next() {
+		var i Email
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Sender,
+			&i.Recipient,
+			&i.Subject,
+			&i.Html,
+			&i.Text,
+			&i.Locale,
+			&i.Set,
+			&i.Tags,
+			&i.Status,
+			&i.Attempts,
+			&i.Error,
+			&i.Reference,
+			&i.Schedule,
+			&i.Creation,
+			&i.Modification,
+			&i.Delivery,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retry = `-- name: Retry :exec
+UPDATE "Email"
+SET error        = $1,
+    schedule     = now() + make_interval(secs => $2::float8),
+    modification = now()
+WHERE (id) = $3
+`
+
+type RetryParams struct {
+	Error *string `db:"error" json:"error"`
+	Delay float64 `db:"delay" json:"delay"`
+	ID    int64   `db:"id" json:"id"`
+}
+
+// Retry records the failed attempt and reschedules the QUEUED [Email] after the backoff delay.
+func (q *Queries) Retry(ctx context.Context, db DBTX, arg *RetryParams) error {
+	_, err := db.Exec(ctx, retry, arg.Error, arg.Delay, arg.ID)
+	return err
+}
+
+const sent = `-- name: Sent :exec
+UPDATE "Email"
+SET status       = 'SENT',
+    reference    = $1,
+    error        = NULL,
+    html         = NULL,
+    text         = NULL,
+    delivery     = now(),
+    modification = now()
+WHERE (id) = $2
+`
+
+type SentParams struct {
+	Reference *string `db:"reference" json:"reference"`
+	ID        int64   `db:"id" json:"id"`
+}
+
+// Sent transitions the [Email] to SENT, recording the transport's reference and clearing its content.
+func (q *Queries) Sent(ctx context.Context, db DBTX, arg *SentParams) error {
+	_, err := db.Exec(ctx, sent, arg.Reference, arg.ID)
+	return err
+}
+
+const summary = `-- name: Summary :many
+SELECT status, count(*) AS count FROM "Email" GROUP BY status ORDER BY status
+`
+
+type SummaryRow struct {
+	Status string `db:"status" json:"status"`
+	Count  int64  `db:"count" json:"count"`
+}
+
+// Summary returns the number of [Email](s) per status.
+func (q *Queries) Summary(ctx context.Context, db DBTX) ([]SummaryRow, error) {
+	rows, err := db.Query(ctx, summary)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SummaryRow{}
+	for rows.Next() {
+		var i SummaryRow
+		if err := rows.Scan(&i.Status, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
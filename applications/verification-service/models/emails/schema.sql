@@ -0,0 +1,48 @@
+--
+-- Email
+--
+
+CREATE TABLE "Email"
+(
+    "id"           bigserial
+        CONSTRAINT "email-id-primary-key" primary key,
+
+    "kind"         varchar(64)                                not null,
+    "sender"       varchar(255)                               not null,
+    "recipient"    varchar(255)                               not null,
+    "subject"      varchar(998)                               not null,
+    "html"         text,
+    "text"         text,
+    "locale"       varchar(35)                                not null,
+    "set"          varchar(64),
+    "tags"         jsonb                    default '{}'      not null,
+
+    "status"       varchar(16)              default 'QUEUED'  not null
+        CONSTRAINT "email-status-constraint" CHECK ("Email"."status" IN ('QUEUED', 'SENT', 'FAILED')),
+
+    "attempts"     integer                  default 0         not null,
+    "error"        text                     default NULL,
+    "reference"    varchar(255)             default NULL,
+
+    "schedule"     timestamp with time zone default now()     not null,
+
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone,
+    "delivery"     timestamp with time zone
+);
+
+COMMENT ON TABLE "Email" IS 'Email represents an outbound email, enqueued within the transaction that produced it and delivered by the outbox worker pool.';
+COMMENT ON COLUMN "Email"."kind" IS 'Kind represents the email''s purpose (e.g. "User-Email-Verification").';
+COMMENT ON COLUMN "Email"."html" IS 'HTML represents the rendered HTML body; cleared once the email is sent or dead-lettered, such that verification code(s) don''t persist.';
+COMMENT ON COLUMN "Email"."text" IS 'Text represents the rendered plain-text body; cleared alongside HTML.';
+COMMENT ON COLUMN "Email"."set" IS 'Set represents the SES configuration set, if any.';
+COMMENT ON COLUMN "Email"."status" IS 'Status represents the delivery state; see models/emails/status.go.';
+COMMENT ON COLUMN "Email"."attempts" IS 'Attempts represents the number of delivery attempt(s) made.';
+COMMENT ON COLUMN "Email"."error" IS 'Error represents the most recent delivery attempt''s failure.';
+COMMENT ON COLUMN "Email"."reference" IS 'Reference represents the transport''s message identifier once sent.';
+COMMENT ON COLUMN "Email"."schedule" IS 'Schedule represents when the email is next due; a claim moves it forward by the lease duration.';
+COMMENT ON COLUMN "Email"."delivery" IS 'Delivery represents when the email was sent.';
+
+CREATE INDEX IF NOT EXISTS "email-due-index" on "Email" (schedule) WHERE status = 'QUEUED';
+CREATE INDEX IF NOT EXISTS "email-status-index" on "Email" (status, id);
+CREATE INDEX IF NOT EXISTS "email-recipient-index" on "Email" (recipient);
@@ -0,0 +1,8 @@
+package emails
+
+// [Email.Status] value(s), as constrained by the "email-status-constraint" check.
+const (
+	Queued = "QUEUED" // Queued represents an email awaiting delivery, including one awaiting retry.
+	Sent   = "SENT"   // Sent represents an email the transport accepted.
+	Failed = "FAILED" // Failed represents a dead-lettered email: one that exhausted its attempt(s), or can't be delivered.
+)
@@ -0,0 +1,10 @@
+package verifications
+
+import "time"
+
+// Lifetime represents the duration a verification code remains valid after delivery. The "expiration" column's default
+// must match.
+const Lifetime = 24 * time.Hour
+
+// Window represents the duration of the daily delivery window.
+const Window = 24 * time.Hour
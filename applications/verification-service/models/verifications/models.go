@@ -5,14 +5,194 @@
 package verifications
 
 import (
+	"database/sql/driver"
+	"fmt"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Verification struct {
-	ID           int64              `db:"id" json:"id"`
-	Email        string             `db:"email" json:"email"`
-	Code         string             `db:"code" json:"code"`
+type SuppressionReason string
+
+const (
+	SuppressionReasonBOUNCE    SuppressionReason = "BOUNCE"
+	SuppressionReasonCOMPLAINT SuppressionReason = "COMPLAINT"
+)
+
+func (e *SuppressionReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SuppressionReason(s)
+	case string:
+		*e = SuppressionReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SuppressionReason: %T", src)
+	}
+	return nil
+}
+
+type NullSuppressionReason struct {
+	SuppressionReason SuppressionReason `json:"Suppression-Reason"`
+	Valid             bool              `json:"valid"` // Valid is true if SuppressionReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSuppressionReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.SuppressionReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SuppressionReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSuppressionReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SuppressionReason), nil
+}
+
+func (e SuppressionReason) Valid() bool {
+	switch e {
+	case SuppressionReasonBOUNCE,
+		SuppressionReasonCOMPLAINT:
+		return true
+	}
+	return false
+}
+
+func AllSuppressionReasonValues() []SuppressionReason {
+	return []SuppressionReason{
+		SuppressionReasonBOUNCE,
+		SuppressionReasonCOMPLAINT,
+	}
+}
+
+type UserVerificationStatus string
+
+const (
+	UserVerificationStatusVERIFIED UserVerificationStatus = "VERIFIED"
+	UserVerificationStatusPENDING  UserVerificationStatus = "PENDING"
+	UserVerificationStatusTIMEOUT  UserVerificationStatus = "TIMEOUT"
+	UserVerificationStatusREVOKED  UserVerificationStatus = "REVOKED"
+)
+
+func (e *UserVerificationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserVerificationStatus(s)
+	case string:
+		*e = UserVerificationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserVerificationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUserVerificationStatus struct {
+	UserVerificationStatus UserVerificationStatus `json:"User-Verification-Status"`
+	Valid                  bool                   `json:"valid"` // Valid is true if UserVerificationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserVerificationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserVerificationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserVerificationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserVerificationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserVerificationStatus), nil
+}
+
+func (e UserVerificationStatus) Valid() bool {
+	switch e {
+	case UserVerificationStatusVERIFIED,
+		UserVerificationStatusPENDING,
+		UserVerificationStatusTIMEOUT,
+		UserVerificationStatusREVOKED:
+		return true
+	}
+	return false
+}
+
+func AllUserVerificationStatusValues() []UserVerificationStatus {
+	return []UserVerificationStatus{
+		UserVerificationStatusVERIFIED,
+		UserVerificationStatusPENDING,
+		UserVerificationStatusTIMEOUT,
+		UserVerificationStatusREVOKED,
+	}
+}
+
+// Phone-Verification represents a user's phone number verification; it shares the email verification code lifecycle (expiration, attempts, resend cooldown & daily cap).
+type PhoneVerification struct {
+	ID int64 `db:"id" json:"id"`
+	// Email represents the owning user; a user has at most one phone number.
+	Email string `db:"email" json:"email"`
+	// Phone represents the E.164-normalized phone number.
+	Phone string `db:"phone" json:"phone"`
+	// Digest represents the keyed hash (HMAC-SHA256) of the current verification code; the code itself is never stored.
+	Digest       string             `db:"digest" json:"-"`
 	Verified     bool               `db:"verified" json:"verified"`
+	Expiration   pgtype.Timestamptz `db:"expiration" json:"expiration"`
+	Delivery     pgtype.Timestamptz `db:"delivery" json:"delivery"`
+	Deliveries   int32              `db:"deliveries" json:"deliveries"`
+	Window       pgtype.Timestamptz `db:"window" json:"window"`
+	Attempts     int32              `db:"attempts" json:"attempts"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	Deletion     pgtype.Timestamptz `db:"deletion" json:"deletion"`
+}
+
+// Suppression represents an email address that must no longer be mailed, as reported by SES bounce & complaint feedback.
+type Suppression struct {
+	ID int64 `db:"id" json:"id"`
+	// Email represents the suppressed, lower-cased email address.
+	Email string `db:"email" json:"email"`
+	// Reason represents the feedback that most recently suppressed the address.
+	Reason SuppressionReason `db:"reason" json:"reason"`
+	// Type represents the bounce type & sub-type (e.g. "Permanent/General"), or the complaint feedback type (e.g. "abuse").
+	Type *string `db:"type" json:"type"`
+	// Feedback represents the SES feedback identifier of the most recent bounce or complaint.
+	Feedback *string `db:"feedback" json:"feedback"`
+	// Message represents the SNS message identifier of the most recent bounce or complaint; redelivered message(s) are ignored.
+	Message *string `db:"message" json:"message"`
+	// Occurrences represents the number of distinct bounce(s) and complaint(s) reported for the address.
+	Occurrences int32 `db:"occurrences" json:"occurrences"`
+	// Occurrence represents when SES most recently reported a bounce or complaint for the address.
+	Occurrence   pgtype.Timestamptz `db:"occurrence" json:"occurrence"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+}
+
+type Verification struct {
+	ID    int64  `db:"id" json:"id"`
+	Email string `db:"email" json:"email"`
+	// Status represents the verification's lifecycle state; see models/verifications/status.go for permitted transition(s).
+	Status UserVerificationStatus `db:"status" json:"status"`
+	// Expiration represents when the current code expires; rotating the code resets it. The code itself is an "email-verification" challenge; see models/challenges.
+	Expiration pgtype.Timestamptz `db:"expiration" json:"expiration"`
+	// Delivery represents when the current code was most recently emailed; used to enforce the resend cooldown.
+	Delivery pgtype.Timestamptz `db:"delivery" json:"delivery"`
+	// Deliveries represents the number of email(s) sent within the current daily window; used to enforce the daily resend cap.
+	Deliveries int32 `db:"deliveries" json:"deliveries"`
+	// Window represents the start of the current 24-hour delivery window.
+	Window pgtype.Timestamptz `db:"window" json:"window"`
+	// Verification represents when the email address was most recently verified.
+	Verification pgtype.Timestamptz `db:"verification" json:"verification"`
+	// Revocation represents when an administrator most recently revoked the verification.
+	Revocation pgtype.Timestamptz `db:"revocation" json:"revocation"`
+	// Reason represents the administrator-supplied reason for the most recent revocation.
+	Reason       *string            `db:"reason" json:"reason"`
 	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
 	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
 	Deletion     pgtype.Timestamptz `db:"deletion" json:"deletion"`
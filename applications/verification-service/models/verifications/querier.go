@@ -9,20 +9,55 @@ import (
 )
 
 type Querier interface {
+	// AttemptPhone records an invalid code submission against the [PhoneVerification] record, returning the updated attempt count.
+	AttemptPhone(ctx context.Context, db DBTX, email string) (int32, error)
+	// CapturePhone establishes or replaces the user's [PhoneVerification] record with a new phone number and code digest, resetting its verified state, expiration & attempts. Deliveries count against the existing daily window, such that changing phone number(s) doesn't bypass the daily cap.
+	CapturePhone(ctx context.Context, db DBTX, arg *CapturePhoneParams) (PhoneVerification, error)
+	// Claimed returns whether the phone number is verified by a user other than the provided one.
+	Claimed(ctx context.Context, db DBTX, arg *ClaimedParams) (bool, error)
 	// Count returns 0 or 1 depending on if a Verification record matching the provided email exists.
 	Count(ctx context.Context, db DBTX, email string) (int64, error)
-	// Create establishes a new [Verification] database record.
-	Create(ctx context.Context, db DBTX, arg *CreateParams) (Verification, error)
+	// Create establishes a new [Verification] database record. Its code is issued separately, as an "email-verification" challenge.
+	Create(ctx context.Context, db DBTX, email string) (Verification, error)
 	// Delete performs a hard database delete on a [Verification] record.
 	Delete(ctx context.Context, db DBTX, id int64) error
 	// DeleteByEmail performs a hard database delete on a [Verification] record.
 	DeleteByEmail(ctx context.Context, db DBTX, email string) error
+	// DeletePhoneByEmail performs a hard database delete on a [PhoneVerification] record.
+	DeletePhoneByEmail(ctx context.Context, db DBTX, email string) error
+	// Expire transitions the user's PENDING, expired [Verification] database record to TIMEOUT -- ahead of the sweeper.
+	Expire(ctx context.Context, db DBTX, email string) (int64, error)
+	// Export returns a [Verification] database record's personal data -- excluding the verification code -- for a data-subject access request.
+	Export(ctx context.Context, db DBTX, email string) (ExportRow, error)
+	// ExportPhone returns a [PhoneVerification] database record's personal data -- excluding the verification code -- for a data-subject access request.
+	ExportPhone(ctx context.Context, db DBTX, email string) (ExportPhoneRow, error)
 	// Get returns a fully hydrated [Verification] database record if a match is found via email.
 	Get(ctx context.Context, db DBTX, email string) (Verification, error)
-	// Status returns a partially hydrated [Verification] database record only including the user's email and verified attribute(s).
+	// Lock returns a fully hydrated [Verification] database record and acquires a row-level lock for the remainder of the transaction.
+	Lock(ctx context.Context, db DBTX, email string) (Verification, error)
+	// LockPhone returns the user's [PhoneVerification] database record and acquires a row-level lock for the remainder of the transaction.
+	LockPhone(ctx context.Context, db DBTX, email string) (PhoneVerification, error)
+	// Revoke transitions a non-REVOKED [Verification] database record to REVOKED.
+	Revoke(ctx context.Context, db DBTX, arg *RevokeParams) (Verification, error)
+	// Rotate transitions a non-VERIFIED [Verification] record to PENDING, resets its expiration, and records the delivery against the daily window -- starting a new window once the current one has elapsed. The rotated code is issued separately, as an "email-verification" challenge.
+	Rotate(ctx context.Context, db DBTX, arg *RotateParams) (Verification, error)
+	// RotatePhone replaces the [PhoneVerification] record's code digest, resets its expiration & attempts, and records the delivery against the daily window.
+	RotatePhone(ctx context.Context, db DBTX, arg *RotatePhoneParams) (PhoneVerification, error)
+	// Status returns a partially hydrated [Verification] database record only including the user's email, status, and lifecycle timestamp(s).
 	Status(ctx context.Context, db DBTX, email string) (StatusRow, error)
-	// Verify updates the [Verification] database record with a verified state.
-	Verify(ctx context.Context, db DBTX, arg *VerifyParams) error
+	// Suppress records a bounce or complaint against the email address, establishing its [Suppression] database record if
+	// necessary. A redelivered SNS message -- one matching the record's most recent message -- is ignored.
+	Suppress(ctx context.Context, db DBTX, arg *SuppressParams) error
+	// Suppressed reports whether the email address has a [Suppression] database record.
+	Suppressed(ctx context.Context, db DBTX, email string) (bool, error)
+	// Sweep transitions up to the provided number of PENDING, expired [Verification] database record(s) to TIMEOUT. Locked record(s) are skipped, such that any number of replicas may sweep concurrently.
+	Sweep(ctx context.Context, db DBTX, size int32) (int64, error)
+	// VerifiedPhone returns the user's verified phone number.
+	VerifiedPhone(ctx context.Context, db DBTX, email string) (string, error)
+	// Verify transitions a PENDING, unexpired [Verification] database record to VERIFIED.
+	Verify(ctx context.Context, db DBTX, email string) (int64, error)
+	// VerifyPhone updates the [PhoneVerification] database record with a verified state.
+	VerifyPhone(ctx context.Context, db DBTX, email string) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,6 +1,6 @@
 -- name: Create :one
--- Create establishes a new [Verification] database record.
-INSERT INTO "Verification" (email, code) VALUES ($1, $2) RETURNING *;
+-- Create establishes a new [Verification] database record. Its code is issued separately, as an "email-verification" challenge.
+INSERT INTO "Verification" (email) VALUES (sqlc.arg(email)::text) RETURNING *;
 
 -- name: Count :one
 -- Count returns 0 or 1 depending on if a Verification record matching the provided email exists.
@@ -11,12 +11,46 @@ SELECT count(*) FROM "Verification" WHERE (email) = sqlc.arg(email)::text AND (d
 SELECT * FROM "Verification" WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL;
 
 -- name: Status :one
--- Status returns a partially hydrated [Verification] database record only including the user's email and verified attribute(s).
-SELECT email, verified FROM "Verification" WHERE (email) = $1 AND (deletion) IS NULL;
+-- Status returns a partially hydrated [Verification] database record only including the user's email, status, and lifecycle timestamp(s).
+SELECT email, status, creation, expiration, verification, revocation FROM "Verification" WHERE (email) = $1 AND (deletion) IS NULL;
 
--- name: Verify :exec
--- Verify updates the [Verification] database record with a verified state.
-UPDATE "Verification" SET verified = true, modification = $2 WHERE (email) = $1 AND (deletion) IS NULL;
+-- name: Verify :execrows
+-- Verify transitions a PENDING, unexpired [Verification] database record to VERIFIED.
+UPDATE "Verification"
+SET status       = 'VERIFIED',
+    verification = now(),
+    modification = now()
+WHERE (email) = sqlc.arg(email)::text AND status = 'PENDING' AND expiration > now() AND (deletion) IS NULL;
+
+-- name: Expire :execrows
+-- Expire transitions the user's PENDING, expired [Verification] database record to TIMEOUT -- ahead of the sweeper.
+UPDATE "Verification"
+SET status       = 'TIMEOUT',
+    modification = now()
+WHERE (email) = sqlc.arg(email)::text AND status = 'PENDING' AND expiration <= now() AND (deletion) IS NULL;
+
+-- name: Sweep :execrows
+-- Sweep transitions up to the provided number of PENDING, expired [Verification] database record(s) to TIMEOUT. Locked record(s) are skipped, such that any number of replicas may sweep concurrently.
+UPDATE "Verification"
+SET status       = 'TIMEOUT',
+    modification = now()
+WHERE id IN (
+    SELECT id FROM "Verification"
+    WHERE status = 'PENDING' AND expiration <= now() AND (deletion) IS NULL
+    ORDER BY expiration
+    LIMIT sqlc.arg(size)::integer
+    FOR UPDATE SKIP LOCKED
+);
+
+-- name: Revoke :one
+-- Revoke transitions a non-REVOKED [Verification] database record to REVOKED.
+UPDATE "Verification"
+SET status       = 'REVOKED',
+    revocation   = now(),
+    reason       = sqlc.narg(reason),
+    modification = now()
+WHERE (email) = sqlc.arg(email)::text AND status <> 'REVOKED' AND (deletion) IS NULL
+RETURNING *;
 
 -- name: Delete :exec
 -- Delete performs a hard database delete on a [Verification] record.
@@ -25,3 +59,100 @@ DELETE FROM "Verification" WHERE id = $1;
 -- name: DeleteByEmail :exec
 -- DeleteByEmail performs a hard database delete on a [Verification] record.
 DELETE FROM "Verification" WHERE email = $1;
+
+-- name: Export :one
+-- Export returns a [Verification] database record's personal data -- excluding the verification code -- for a data-subject access request.
+SELECT id, email, status, verification, revocation, creation, modification, deletion FROM "Verification" WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL;
+
+-- name: Lock :one
+-- Lock returns a fully hydrated [Verification] database record and acquires a row-level lock for the remainder of the transaction.
+SELECT * FROM "Verification" WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL FOR UPDATE;
+
+-- name: Rotate :one
+-- Rotate transitions a non-VERIFIED [Verification] record to PENDING, resets its expiration, and records the delivery against the daily window -- starting a new window once the current one has elapsed. The rotated code is issued separately, as an "email-verification" challenge.
+UPDATE "Verification"
+SET status       = 'PENDING',
+    expiration   = now() + make_interval(secs => sqlc.arg(lifetime)::float8),
+    delivery     = now(),
+    deliveries   = CASE WHEN "window" <= now() - interval '24 hours' THEN 1 ELSE deliveries + 1 END,
+    "window"     = CASE WHEN "window" <= now() - interval '24 hours' THEN now() ELSE "window" END,
+    modification = now()
+WHERE (email) = sqlc.arg(email)::text AND status <> 'VERIFIED' AND (deletion) IS NULL
+RETURNING *;
+
+-- name: LockPhone :one
+-- LockPhone returns the user's [PhoneVerification] database record and acquires a row-level lock for the remainder of the transaction.
+SELECT * FROM "Phone-Verification" WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL FOR UPDATE;
+
+-- name: Claimed :one
+-- Claimed returns whether the phone number is verified by a user other than the provided one.
+SELECT EXISTS(SELECT 1 FROM "Phone-Verification" WHERE (phone) = sqlc.arg(phone)::text AND (email) <> sqlc.arg(email)::text AND verified AND (deletion) IS NULL)::bool AS claimed;
+
+-- name: CapturePhone :one
+-- CapturePhone establishes or replaces the user's [PhoneVerification] record with a new phone number and code digest, resetting its verified state, expiration & attempts. Deliveries count against the existing daily window, such that changing phone number(s) doesn't bypass the daily cap.
+INSERT INTO "Phone-Verification" (email, phone, digest, expiration)
+VALUES (sqlc.arg(email)::text, sqlc.arg(phone)::text, sqlc.arg(digest), now() + make_interval(secs => sqlc.arg(lifetime)::float8))
+ON CONFLICT (email) DO UPDATE
+SET phone        = excluded.phone,
+    digest       = excluded.digest,
+    verified     = false,
+    expiration   = excluded.expiration,
+    attempts     = 0,
+    delivery     = now(),
+    deliveries   = CASE WHEN "Phone-Verification"."window" <= now() - interval '24 hours' THEN 1 ELSE "Phone-Verification".deliveries + 1 END,
+    "window"     = CASE WHEN "Phone-Verification"."window" <= now() - interval '24 hours' THEN now() ELSE "Phone-Verification"."window" END,
+    modification = now(),
+    deletion     = NULL
+RETURNING *;
+
+-- name: RotatePhone :one
+-- RotatePhone replaces the [PhoneVerification] record's code digest, resets its expiration & attempts, and records the delivery against the daily window.
+UPDATE "Phone-Verification"
+SET digest       = sqlc.arg(digest),
+    attempts     = 0,
+    expiration   = now() + make_interval(secs => sqlc.arg(lifetime)::float8),
+    delivery     = now(),
+    deliveries   = CASE WHEN "window" <= now() - interval '24 hours' THEN 1 ELSE deliveries + 1 END,
+    "window"     = CASE WHEN "window" <= now() - interval '24 hours' THEN now() ELSE "window" END,
+    modification = now()
+WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL
+RETURNING *;
+
+-- name: AttemptPhone :one
+-- AttemptPhone records an invalid code submission against the [PhoneVerification] record, returning the updated attempt count.
+UPDATE "Phone-Verification" SET attempts = attempts + 1 WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL RETURNING attempts;
+
+-- name: VerifyPhone :exec
+-- VerifyPhone updates the [PhoneVerification] database record with a verified state.
+UPDATE "Phone-Verification" SET verified = true, modification = now() WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL;
+
+-- name: VerifiedPhone :one
+-- VerifiedPhone returns the user's verified phone number.
+SELECT phone FROM "Phone-Verification" WHERE (email) = sqlc.arg(email)::text AND verified AND (deletion) IS NULL;
+
+-- name: ExportPhone :one
+-- ExportPhone returns a [PhoneVerification] database record's personal data -- excluding the verification code -- for a data-subject access request.
+SELECT id, email, phone, verified, creation, modification, deletion FROM "Phone-Verification" WHERE (email) = sqlc.arg(email)::text AND (deletion) IS NULL;
+
+-- name: DeletePhoneByEmail :exec
+-- DeletePhoneByEmail performs a hard database delete on a [PhoneVerification] record.
+DELETE FROM "Phone-Verification" WHERE email = $1;
+
+-- name: Suppress :exec
+-- Suppress records a bounce or complaint against the email address, establishing its [Suppression] database record if
+-- necessary. A redelivered SNS message -- one matching the record's most recent message -- is ignored.
+INSERT INTO "Suppression" (email, reason, type, feedback, message, occurrence)
+VALUES (lower(sqlc.arg(email)::text), sqlc.arg(reason), sqlc.narg(type), sqlc.narg(feedback), sqlc.narg(message), sqlc.arg(occurrence))
+ON CONFLICT (email) DO UPDATE
+    SET reason       = excluded.reason,
+        type         = excluded.type,
+        feedback     = excluded.feedback,
+        message      = excluded.message,
+        occurrences  = "Suppression".occurrences + 1,
+        occurrence   = greatest("Suppression".occurrence, excluded.occurrence),
+        modification = now()
+WHERE "Suppression".message IS DISTINCT FROM excluded.message;
+
+-- name: Suppressed :one
+-- Suppressed reports whether the email address has a [Suppression] database record.
+SELECT EXISTS (SELECT 1 FROM "Suppression" WHERE (email) = lower(sqlc.arg(email)::text))::bool AS suppressed;
@@ -11,6 +11,86 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const attemptPhone = `-- name: AttemptPhone :one
+UPDATE "Phone-Verification" SET attempts = attempts + 1 WHERE (email) = $1::text AND (deletion) IS NULL RETURNING attempts
+`
+
+// AttemptPhone records an invalid code submission against the [PhoneVerification] record, returning the updated attempt count.
+func (q *Queries) AttemptPhone(ctx context.Context, db DBTX, email string) (int32, error) {
+	row := db.QueryRow(ctx, attemptPhone, email)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const capturePhone = `-- name: CapturePhone :one
+INSERT INTO "Phone-Verification" (email, phone, digest, expiration)
+VALUES ($1::text, $2::text, $3, now() + make_interval(secs => $4::float8))
+ON CONFLICT (email) DO UPDATE
+SET phone        = excluded.phone,
+    digest       = excluded.digest,
+    verified     = false,
+    expiration   = excluded.expiration,
+    attempts     = 0,
+    delivery     = now(),
+    deliveries   = CASE WHEN "Phone-Verification"."window" <= now() - interval '24 hours' THEN 1 ELSE "Phone-Verification".deliveries + 1 END,
+    "window"     = CASE WHEN "Phone-Verification"."window" <= now() - interval '24 hours' THEN now() ELSE "Phone-Verification"."window" END,
+    modification = now(),
+    deletion     = NULL
+RETURNING id, email, phone, digest, verified, expiration, delivery, deliveries, "window", attempts, creation, modification, deletion
+`
+
+type CapturePhoneParams struct {
+	Email    string  `db:"email" json:"email"`
+	Phone    string  `db:"phone" json:"phone"`
+	Digest   string  `db:"digest" json:"-"`
+	Lifetime float64 `db:"lifetime" json:"lifetime"`
+}
+
+// CapturePhone establishes or replaces the user's [PhoneVerification] record with a new phone number and code digest, resetting its verified state, expiration & attempts. Deliveries count against the existing daily window, such that changing phone number(s) doesn't bypass the daily cap.
+func (q *Queries) CapturePhone(ctx context.Context, db DBTX, arg *CapturePhoneParams) (PhoneVerification, error) {
+	row := db.QueryRow(ctx, capturePhone,
+		arg.Email,
+		arg.Phone,
+		arg.Digest,
+		arg.Lifetime,
+	)
+	var i PhoneVerification
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.Digest,
+		&i.Verified,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Attempts,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const claimed = `-- name: Claimed :one
+SELECT EXISTS(SELECT 1 FROM "Phone-Verification" WHERE (phone) = $1::text AND (email) <> $2::text AND verified AND (deletion) IS NULL)::bool AS claimed
+`
+
+type ClaimedParams struct {
+	Phone string `db:"phone" json:"phone"`
+	Email string `db:"email" json:"email"`
+}
+
+// Claimed returns whether the phone number is verified by a user other than the provided one.
+func (q *Queries) Claimed(ctx context.Context, db DBTX, arg *ClaimedParams) (bool, error) {
+	row := db.QueryRow(ctx, claimed, arg.Phone, arg.Email)
+	var claimed bool
+	err := row.Scan(&claimed)
+	return claimed, err
+}
+
 const count = `-- name: Count :one
 SELECT count(*) FROM "Verification" WHERE (email) = $1::text AND (deletion) IS NULL
 `
@@ -24,23 +104,24 @@ func (q *Queries) Count(ctx context.Context, db DBTX, email string) (int64, erro
 }
 
 const create = `-- name: Create :one
-INSERT INTO "Verification" (email, code) VALUES ($1, $2) RETURNING id, email, code, verified, creation, modification, deletion
+INSERT INTO "Verification" (email) VALUES ($1::text) RETURNING id, email, status, expiration, delivery, deliveries, "window", verification, revocation, reason, creation, modification, deletion
 `
 
-type CreateParams struct {
-	Email string `db:"email" json:"email"`
-	Code  string `db:"code" json:"code"`
-}
-
-// Create establishes a new [Verification] database record.
-func (q *Queries) Create(ctx context.Context, db DBTX, arg *CreateParams) (Verification, error) {
-	row := db.QueryRow(ctx, create, arg.Email, arg.Code)
+// Create establishes a new [Verification] database record. Its code is issued separately, as an "email-verification" challenge.
+func (q *Queries) Create(ctx context.Context, db DBTX, email string) (Verification, error) {
+	row := db.QueryRow(ctx, create, email)
 	var i Verification
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
-		&i.Code,
-		&i.Verified,
+		&i.Status,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Verification,
+		&i.Revocation,
+		&i.Reason,
 		&i.Creation,
 		&i.Modification,
 		&i.Deletion,
@@ -68,8 +149,96 @@ func (q *Queries) DeleteByEmail(ctx context.Context, db DBTX, email string) erro
 	return err
 }
 
+const deletePhoneByEmail = `-- name: DeletePhoneByEmail :exec
+DELETE FROM "Phone-Verification" WHERE email = $1
+`
+
+// DeletePhoneByEmail performs a hard database delete on a [PhoneVerification] record.
+func (q *Queries) DeletePhoneByEmail(ctx context.Context, db DBTX, email string) error {
+	_, err := db.Exec(ctx, deletePhoneByEmail, email)
+	return err
+}
+
+const expire = `-- name: Expire :execrows
+UPDATE "Verification"
+SET status       = 'TIMEOUT',
+    modification = now()
+WHERE (email) = $1::text AND status = 'PENDING' AND expiration <= now() AND (deletion) IS NULL
+`
+
+// Expire transitions the user's PENDING, expired [Verification] database record to TIMEOUT -- ahead of the sweeper.
+func (q *Queries) Expire(ctx context.Context, db DBTX, email string) (int64, error) {
+	result, err := db.Exec(ctx, expire, email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const export = `-- name: Export :one
+SELECT id, email, status, verification, revocation, creation, modification, deletion FROM "Verification" WHERE (email) = $1::text AND (deletion) IS NULL
+`
+
+type ExportRow struct {
+	ID           int64                  `db:"id" json:"id"`
+	Email        string                 `db:"email" json:"email"`
+	Status       UserVerificationStatus `db:"status" json:"status"`
+	Verification pgtype.Timestamptz     `db:"verification" json:"verification"`
+	Revocation   pgtype.Timestamptz     `db:"revocation" json:"revocation"`
+	Creation     pgtype.Timestamptz     `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz     `db:"modification" json:"modification"`
+	Deletion     pgtype.Timestamptz     `db:"deletion" json:"deletion"`
+}
+
+// Export returns a [Verification] database record's personal data -- excluding the verification code -- for a data-subject access request.
+func (q *Queries) Export(ctx context.Context, db DBTX, email string) (ExportRow, error) {
+	row := db.QueryRow(ctx, export, email)
+	var i ExportRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Status,
+		&i.Verification,
+		&i.Revocation,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const exportPhone = `-- name: ExportPhone :one
+SELECT id, email, phone, verified, creation, modification, deletion FROM "Phone-Verification" WHERE (email) = $1::text AND (deletion) IS NULL
+`
+
+type ExportPhoneRow struct {
+	ID           int64              `db:"id" json:"id"`
+	Email        string             `db:"email" json:"email"`
+	Phone        string             `db:"phone" json:"phone"`
+	Verified     bool               `db:"verified" json:"verified"`
+	Creation     pgtype.Timestamptz `db:"creation" json:"creation"`
+	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+	Deletion     pgtype.Timestamptz `db:"deletion" json:"deletion"`
+}
+
+// ExportPhone returns a [PhoneVerification] database record's personal data -- excluding the verification code -- for a data-subject access request.
+func (q *Queries) ExportPhone(ctx context.Context, db DBTX, email string) (ExportPhoneRow, error) {
+	row := db.QueryRow(ctx, exportPhone, email)
+	var i ExportPhoneRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.Verified,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
 const get = `-- name: Get :one
-SELECT id, email, code, verified, creation, modification, deletion FROM "Verification" WHERE (email) = $1::text AND (deletion) IS NULL
+SELECT id, email, status, expiration, delivery, deliveries, "window", verification, revocation, reason, creation, modification, deletion FROM "Verification" WHERE (email) = $1::text AND (deletion) IS NULL
 `
 
 // Get returns a fully hydrated [Verification] database record if a match is found via email.
@@ -79,8 +248,183 @@ func (q *Queries) Get(ctx context.Context, db DBTX, email string) (Verification,
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
-		&i.Code,
+		&i.Status,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Verification,
+		&i.Revocation,
+		&i.Reason,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const lock = `-- name: Lock :one
+SELECT id, email, status, expiration, delivery, deliveries, "window", verification, revocation, reason, creation, modification, deletion FROM "Verification" WHERE (email) = $1::text AND (deletion) IS NULL FOR UPDATE
+`
+
+// Lock returns a fully hydrated [Verification] database record and acquires a row-level lock for the remainder of the transaction.
+func (q *Queries) Lock(ctx context.Context, db DBTX, email string) (Verification, error) {
+	row := db.QueryRow(ctx, lock, email)
+	var i Verification
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Status,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Verification,
+		&i.Revocation,
+		&i.Reason,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const lockPhone = `-- name: LockPhone :one
+SELECT id, email, phone, digest, verified, expiration, delivery, deliveries, "window", attempts, creation, modification, deletion FROM "Phone-Verification" WHERE (email) = $1::text AND (deletion) IS NULL FOR UPDATE
+`
+
+// LockPhone returns the user's [PhoneVerification] database record and acquires a row-level lock for the remainder of the transaction.
+func (q *Queries) LockPhone(ctx context.Context, db DBTX, email string) (PhoneVerification, error) {
+	row := db.QueryRow(ctx, lockPhone, email)
+	var i PhoneVerification
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.Digest,
+		&i.Verified,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Attempts,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const revoke = `-- name: Revoke :one
+UPDATE "Verification"
+SET status       = 'REVOKED',
+    revocation   = now(),
+    reason       = $1,
+    modification = now()
+WHERE (email) = $2::text AND status <> 'REVOKED' AND (deletion) IS NULL
+RETURNING id, email, status, expiration, delivery, deliveries, "window", verification, revocation, reason, creation, modification, deletion
+`
+
+type RevokeParams struct {
+	Reason *string `db:"reason" json:"reason"`
+	Email  string  `db:"email" json:"email"`
+}
+
+// Revoke transitions a non-REVOKED [Verification] database record to REVOKED.
+func (q *Queries) Revoke(ctx context.Context, db DBTX, arg *RevokeParams) (Verification, error) {
+	row := db.QueryRow(ctx, revoke, arg.Reason, arg.Email)
+	var i Verification
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Status,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Verification,
+		&i.Revocation,
+		&i.Reason,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const rotate = `-- name: Rotate :one
+UPDATE "Verification"
+SET status       = 'PENDING',
+    expiration   = now() + make_interval(secs => $1::float8),
+    delivery     = now(),
+    deliveries   = CASE WHEN "window" <= now() - interval '24 hours' THEN 1 ELSE deliveries + 1 END,
+    "window"     = CASE WHEN "window" <= now() - interval '24 hours' THEN now() ELSE "window" END,
+    modification = now()
+WHERE (email) = $2::text AND status <> 'VERIFIED' AND (deletion) IS NULL
+RETURNING id, email, status, expiration, delivery, deliveries, "window", verification, revocation, reason, creation, modification, deletion
+`
+
+type RotateParams struct {
+	Lifetime float64 `db:"lifetime" json:"lifetime"`
+	Email    string  `db:"email" json:"email"`
+}
+
+// Rotate transitions a non-VERIFIED [Verification] record to PENDING, resets its expiration, and records the delivery against the daily window -- starting a new window once the current one has elapsed. The rotated code is issued separately, as an "email-verification" challenge.
+func (q *Queries) Rotate(ctx context.Context, db DBTX, arg *RotateParams) (Verification, error) {
+	row := db.QueryRow(ctx, rotate, arg.Lifetime, arg.Email)
+	var i Verification
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Status,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Verification,
+		&i.Revocation,
+		&i.Reason,
+		&i.Creation,
+		&i.Modification,
+		&i.Deletion,
+	)
+	return i, err
+}
+
+const rotatePhone = `-- name: RotatePhone :one
+UPDATE "Phone-Verification"
+SET digest       = $1,
+    attempts     = 0,
+    expiration   = now() + make_interval(secs => $2::float8),
+    delivery     = now(),
+    deliveries   = CASE WHEN "window" <= now() - interval '24 hours' THEN 1 ELSE deliveries + 1 END,
+    "window"     = CASE WHEN "window" <= now() - interval '24 hours' THEN now() ELSE "window" END,
+    modification = now()
+WHERE (email) = $3::text AND (deletion) IS NULL
+RETURNING id, email, phone, digest, verified, expiration, delivery, deliveries, "window", attempts, creation, modification, deletion
+`
+
+type RotatePhoneParams struct {
+	Digest   string  `db:"digest" json:"-"`
+	Lifetime float64 `db:"lifetime" json:"lifetime"`
+	Email    string  `db:"email" json:"email"`
+}
+
+// RotatePhone replaces the [PhoneVerification] record's code digest, resets its expiration & attempts, and records the delivery against the daily window.
+func (q *Queries) RotatePhone(ctx context.Context, db DBTX, arg *RotatePhoneParams) (PhoneVerification, error) {
+	row := db.QueryRow(ctx, rotatePhone, arg.Digest, arg.Lifetime, arg.Email)
+	var i PhoneVerification
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.Digest,
 		&i.Verified,
+		&i.Expiration,
+		&i.Delivery,
+		&i.Deliveries,
+		&i.Window,
+		&i.Attempts,
 		&i.Creation,
 		&i.Modification,
 		&i.Deletion,
@@ -89,33 +433,139 @@ func (q *Queries) Get(ctx context.Context, db DBTX, email string) (Verification,
 }
 
 const status = `-- name: Status :one
-SELECT email, verified FROM "Verification" WHERE (email) = $1 AND (deletion) IS NULL
+SELECT email, status, creation, expiration, verification, revocation FROM "Verification" WHERE (email) = $1 AND (deletion) IS NULL
 `
 
 type StatusRow struct {
-	Email    string `db:"email" json:"email"`
-	Verified bool   `db:"verified" json:"verified"`
+	Email        string                 `db:"email" json:"email"`
+	Status       UserVerificationStatus `db:"status" json:"status"`
+	Creation     pgtype.Timestamptz     `db:"creation" json:"creation"`
+	Expiration   pgtype.Timestamptz     `db:"expiration" json:"expiration"`
+	Verification pgtype.Timestamptz     `db:"verification" json:"verification"`
+	Revocation   pgtype.Timestamptz     `db:"revocation" json:"revocation"`
 }
 
-// Status returns a partially hydrated [Verification] database record only including the user's email and verified attribute(s).
+// Status returns a partially hydrated [Verification] database record only including the user's email, status, and lifecycle timestamp(s).
 func (q *Queries) Status(ctx context.Context, db DBTX, email string) (StatusRow, error) {
 	row := db.QueryRow(ctx, status, email)
 	var i StatusRow
-	err := row.Scan(&i.Email, &i.Verified)
+	err := row.Scan(
+		&i.Email,
+		&i.Status,
+		&i.Creation,
+		&i.Expiration,
+		&i.Verification,
+		&i.Revocation,
+	)
 	return i, err
 }
 
-const verify = `-- name: Verify :exec
-UPDATE "Verification" SET verified = true, modification = $2 WHERE (email) = $1 AND (deletion) IS NULL
+const suppress = `-- name: Suppress :exec
+INSERT INTO "Suppression" (email, reason, type, feedback, message, occurrence)
+VALUES (lower($1::text), $2, $3, $4, $5, $6)
+ON CONFLICT (email) DO UPDATE
+    SET reason       = excluded.reason,
+        type         = excluded.type,
+        feedback     = excluded.feedback,
+        message      = excluded.message,
+        occurrences  = "Suppression".occurrences + 1,
+        occurrence   = greatest("Suppression".occurrence, excluded.occurrence),
+        modification = now()
+WHERE "Suppression".message IS DISTINCT FROM excluded.message
 `
 
-type VerifyParams struct {
-	Email        string             `db:"email" json:"email"`
-	Modification pgtype.Timestamptz `db:"modification" json:"modification"`
+type SuppressParams struct {
+	Email      string             `db:"email" json:"email"`
+	Reason     SuppressionReason  `db:"reason" json:"reason"`
+	Type       *string            `db:"type" json:"type"`
+	Feedback   *string            `db:"feedback" json:"feedback"`
+	Message    *string            `db:"message" json:"message"`
+	Occurrence pgtype.Timestamptz `db:"occurrence" json:"occurrence"`
+}
+
+// Suppress records a bounce or complaint against the email address, establishing its [Suppression] database record if
+// necessary. A redelivered SNS message -- one matching the record's most recent message -- is ignored.
+func (q *Queries) Suppress(ctx context.Context, db DBTX, arg *SuppressParams) error {
+	_, err := db.Exec(ctx, suppress,
+		arg.Email,
+		arg.Reason,
+		arg.Type,
+		arg.Feedback,
+		arg.Message,
+		arg.Occurrence,
+	)
+	return err
+}
+
+const suppressed = `-- name: Suppressed :one
+SELECT EXISTS (SELECT 1 FROM "Suppression" WHERE (email) = lower($1::text))::bool AS suppressed
+`
+
+// Suppressed reports whether the email address has a [Suppression] database record.
+func (q *Queries) Suppressed(ctx context.Context, db DBTX, email string) (bool, error) {
+	row := db.QueryRow(ctx, suppressed, email)
+	var suppressed bool
+	err := row.Scan(&suppressed)
+	return suppressed, err
+}
+
+const sweep = `-- name: Sweep :execrows
+UPDATE "Verification"
+SET status       = 'TIMEOUT',
+    modification = now()
+WHERE id IN (
+    SELECT id FROM "Verification"
+    WHERE status = 'PENDING' AND expiration <= now() AND (deletion) IS NULL
+    ORDER BY expiration
+    LIMIT $1::integer
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+// Sweep transitions up to the provided number of PENDING, expired [Verification] database record(s) to TIMEOUT. Locked record(s) are skipped, such that any number of replicas may sweep concurrently.
+func (q *Queries) Sweep(ctx context.Context, db DBTX, size int32) (int64, error) {
+	result, err := db.Exec(ctx, sweep, size)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-// Verify updates the [Verification] database record with a verified state.
-func (q *Queries) Verify(ctx context.Context, db DBTX, arg *VerifyParams) error {
-	_, err := db.Exec(ctx, verify, arg.Email, arg.Modification)
+const verifiedPhone = `-- name: VerifiedPhone :one
+SELECT phone FROM "Phone-Verification" WHERE (email) = $1::text AND verified AND (deletion) IS NULL
+`
+
+// VerifiedPhone returns the user's verified phone number.
+func (q *Queries) VerifiedPhone(ctx context.Context, db DBTX, email string) (string, error) {
+	row := db.QueryRow(ctx, verifiedPhone, email)
+	var phone string
+	err := row.Scan(&phone)
+	return phone, err
+}
+
+const verify = `-- name: Verify :execrows
+UPDATE "Verification"
+SET status       = 'VERIFIED',
+    verification = now(),
+    modification = now()
+WHERE (email) = $1::text AND status = 'PENDING' AND expiration > now() AND (deletion) IS NULL
+`
+
+// Verify transitions a PENDING, unexpired [Verification] database record to VERIFIED.
+func (q *Queries) Verify(ctx context.Context, db DBTX, email string) (int64, error) {
+	result, err := db.Exec(ctx, verify, email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const verifyPhone = `-- name: VerifyPhone :exec
+UPDATE "Phone-Verification" SET verified = true, modification = now() WHERE (email) = $1::text AND (deletion) IS NULL
+`
+
+// VerifyPhone updates the [PhoneVerification] database record with a verified state.
+func (q *Queries) VerifyPhone(ctx context.Context, db DBTX, email string) error {
+	_, err := db.Exec(ctx, verifyPhone, email)
 	return err
 }
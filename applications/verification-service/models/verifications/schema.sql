@@ -1,15 +1,91 @@
+CREATE TYPE "User-Verification-Status" AS ENUM (
+    'VERIFIED',
+    'PENDING',
+    'TIMEOUT',
+    'REVOKED'
+    );
+
 CREATE TABLE "Verification"
 (
     "id"           bigserial CONSTRAINT "verification-id-primary-key" primary key,
     "email"        varchar(255) not null CONSTRAINT "verification-email-validation-constraint" CHECK ("Verification"."email" ~* '^[A-Za-z0-9._+%-]+@[A-Za-z0-9.-]+[.][A-Za-z]+$') CONSTRAINT "verification-email-unique-constraint" unique,
-    "code"         varchar(32)  not null CONSTRAINT "verification-code-unique-constraint" unique,
-    "verified"     bool NOT NULL default false,
+    "status"       "User-Verification-Status" NOT NULL default 'PENDING',
+    "expiration"   timestamp with time zone NOT NULL default now() + interval '24 hours',
+    "delivery"     timestamp with time zone NOT NULL default now(),
+    "deliveries"   integer NOT NULL default 1,
+    "window"       timestamp with time zone NOT NULL default now(),
+    "verification" timestamp with time zone,
+    "revocation"   timestamp with time zone,
+    "reason"       varchar(255),
     "creation"     timestamp with time zone default now(),
     "modification" timestamp with time zone,
     "deletion"     timestamp with time zone
 );
 
-CREATE INDEX IF NOT EXISTS "verification-code-index" on "Verification" (code);
-CREATE INDEX IF NOT EXISTS "verification-verified-index" on "Verification" (verified);
+COMMENT ON COLUMN "Verification"."status" IS 'Status represents the verification''s lifecycle state; see models/verifications/status.go for permitted transition(s).';
+COMMENT ON COLUMN "Verification"."verification" IS 'Verification represents when the email address was most recently verified.';
+COMMENT ON COLUMN "Verification"."revocation" IS 'Revocation represents when an administrator most recently revoked the verification.';
+COMMENT ON COLUMN "Verification"."reason" IS 'Reason represents the administrator-supplied reason for the most recent revocation.';
+COMMENT ON COLUMN "Verification"."expiration" IS 'Expiration represents when the current code expires; rotating the code resets it. The code itself is an "email-verification" challenge; see models/challenges.';
+COMMENT ON COLUMN "Verification"."delivery" IS 'Delivery represents when the current code was most recently emailed; used to enforce the resend cooldown.';
+COMMENT ON COLUMN "Verification"."deliveries" IS 'Deliveries represents the number of email(s) sent within the current daily window; used to enforce the daily resend cap.';
+COMMENT ON COLUMN "Verification"."window" IS 'Window represents the start of the current 24-hour delivery window.';
+
+CREATE INDEX IF NOT EXISTS "verification-status-index" on "Verification" (status);
+CREATE INDEX IF NOT EXISTS "verification-pending-expiration-index" on "Verification" (expiration) WHERE status = 'PENDING' AND deletion IS NULL;
 CREATE INDEX IF NOT EXISTS "verification-email-index" on "Verification" (email);
 CREATE INDEX IF NOT EXISTS "verification-deletion-index" on "Verification" (deletion);
+
+CREATE TABLE "Phone-Verification"
+(
+    "id"           bigserial CONSTRAINT "phone-verification-id-primary-key" primary key,
+    "email"        varchar(255) not null CONSTRAINT "phone-verification-email-unique-constraint" unique,
+    "phone"        varchar(16)  not null CONSTRAINT "phone-verification-e164-constraint" CHECK ("Phone-Verification"."phone" ~ '^\+[1-9][0-9]{7,14}$'),
+    "digest"       char(64)     not null,
+    "verified"     bool NOT NULL default false,
+    "expiration"   timestamp with time zone NOT NULL default now() + interval '24 hours',
+    "delivery"     timestamp with time zone NOT NULL default now(),
+    "deliveries"   integer NOT NULL default 1,
+    "window"       timestamp with time zone NOT NULL default now(),
+    "attempts"     integer NOT NULL default 0,
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone,
+    "deletion"     timestamp with time zone
+);
+
+COMMENT ON TABLE "Phone-Verification" IS 'Phone-Verification represents a user''s phone number verification; it shares the email verification code lifecycle (expiration, attempts, resend cooldown & daily cap).';
+COMMENT ON COLUMN "Phone-Verification"."email" IS 'Email represents the owning user; a user has at most one phone number.';
+COMMENT ON COLUMN "Phone-Verification"."phone" IS 'Phone represents the E.164-normalized phone number.';
+COMMENT ON COLUMN "Phone-Verification"."digest" IS 'Digest represents the keyed hash (HMAC-SHA256) of the current verification code; the code itself is never stored.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS "phone-verification-verified-phone-unique-index" on "Phone-Verification" (phone) WHERE verified AND deletion IS NULL;
+CREATE INDEX IF NOT EXISTS "phone-verification-phone-index" on "Phone-Verification" (phone);
+CREATE INDEX IF NOT EXISTS "phone-verification-deletion-index" on "Phone-Verification" (deletion);
+
+CREATE TYPE "Suppression-Reason" AS ENUM (
+    'BOUNCE',
+    'COMPLAINT'
+    );
+
+CREATE TABLE "Suppression"
+(
+    "id"           bigserial CONSTRAINT "suppression-id-primary-key" primary key,
+    "email"        varchar(255) not null CONSTRAINT "suppression-email-unique-constraint" unique CONSTRAINT "suppression-email-lowercase-constraint" CHECK ("Suppression"."email" = lower("Suppression"."email")),
+    "reason"       "Suppression-Reason" NOT NULL,
+    "type"         varchar(64),
+    "feedback"     varchar(255),
+    "message"      varchar(255),
+    "occurrences"  integer NOT NULL default 1,
+    "occurrence"   timestamp with time zone NOT NULL,
+    "creation"     timestamp with time zone default now(),
+    "modification" timestamp with time zone
+);
+
+COMMENT ON TABLE "Suppression" IS 'Suppression represents an email address that must no longer be mailed, as reported by SES bounce & complaint feedback.';
+COMMENT ON COLUMN "Suppression"."email" IS 'Email represents the suppressed, lower-cased email address.';
+COMMENT ON COLUMN "Suppression"."reason" IS 'Reason represents the feedback that most recently suppressed the address.';
+COMMENT ON COLUMN "Suppression"."type" IS 'Type represents the bounce type & sub-type (e.g. "Permanent/General"), or the complaint feedback type (e.g. "abuse").';
+COMMENT ON COLUMN "Suppression"."feedback" IS 'Feedback represents the SES feedback identifier of the most recent bounce or complaint.';
+COMMENT ON COLUMN "Suppression"."message" IS 'Message represents the SNS message identifier of the most recent bounce or complaint; redelivered message(s) are ignored.';
+COMMENT ON COLUMN "Suppression"."occurrences" IS 'Occurrences represents the number of distinct bounce(s) and complaint(s) reported for the address.';
+COMMENT ON COLUMN "Suppression"."occurrence" IS 'Occurrence represents when SES most recently reported a bounce or complaint for the address.';
@@ -0,0 +1,30 @@
+package verifications
+
+// [Verification.Status] value(s), as constrained by the "User-Verification-Status" enum.
+const (
+	Verified = UserVerificationStatusVERIFIED // Verified represents a verification whose code was successfully confirmed.
+	Pending  = UserVerificationStatusPENDING  // Pending represents a verification awaiting confirmation of an unexpired code.
+	Timeout  = UserVerificationStatusTIMEOUT  // Timeout represents a pending verification whose code expired before confirmation.
+	Revoked  = UserVerificationStatusREVOKED  // Revoked represents a verification an administrator has revoked.
+)
+
+// transitions maps each [UserVerificationStatus] to the status(es) it may move to. A resend returns [Timeout] and [Revoked]
+// verifications to [Pending]; only an administrator revokes.
+var transitions = map[UserVerificationStatus][]UserVerificationStatus{
+	Pending:  {Verified, Timeout, Pending, Revoked},
+	Verified: {Revoked},
+	Timeout:  {Pending, Revoked},
+	Revoked:  {Pending},
+}
+
+// Permitted reports whether a verification may transition from one [UserVerificationStatus] to another. The queries
+// enforce the same rules in their WHERE clause(s); Permitted exists for handlers to pick a response ahead of a write.
+func Permitted(from, to UserVerificationStatus) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
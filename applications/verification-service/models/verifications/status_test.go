@@ -0,0 +1,30 @@
+package verifications
+
+import "testing"
+
+func TestPermitted(t *testing.T) {
+	tests := []struct {
+		from, to UserVerificationStatus
+		expected bool
+	}{
+		{Pending, Verified, true},
+		{Pending, Timeout, true},
+		{Pending, Pending, true},
+		{Pending, Revoked, true},
+		{Verified, Revoked, true},
+		{Verified, Pending, false},
+		{Verified, Timeout, false},
+		{Timeout, Pending, true},
+		{Timeout, Verified, false},
+		{Timeout, Revoked, true},
+		{Revoked, Pending, true},
+		{Revoked, Verified, false},
+		{Revoked, Revoked, false},
+	}
+
+	for _, test := range tests {
+		if v := Permitted(test.from, test.to); v != test.expected {
+			t.Errorf("Permitted(%s, %s) = %v, expected %v", test.from, test.to, v, test.expected)
+		}
+	}
+}